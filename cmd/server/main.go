@@ -2,20 +2,29 @@ package main
 
 import (
 	"PVZ/internal/config"
+	"PVZ/internal/events"
 	"PVZ/internal/repository"
 	"PVZ/internal/service"
+	grpcserver "PVZ/internal/transport/grpc/server"
 	"PVZ/internal/transport/http/routers"
+	"PVZ/internal/webhook"
+	"PVZ/internal/worker"
 	"PVZ/pkg/database"
 	"PVZ/pkg/logger"
 	"PVZ/pkg/metrics"
 	"context"
 	"errors"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
+
+	// Часовые пояса городов проверяются через time.LoadLocation, а в alpine-образе нет tzdata.
+	_ "time/tzdata"
 )
 
 func main() {
@@ -29,12 +38,12 @@ func main() {
 
 	db, err := database.InitDB(dsn)
 	if err != nil {
-		slog.Error("failed to init db: %v", err)
+		slog.Error("failed to init db", "err", err)
 	}
 
 	defer func() {
 		if err := db.Close(); err != nil {
-			slog.Error("Failed to close database: %v", err)
+			slog.Error("Failed to close database", "err", err)
 		}
 	}()
 
@@ -42,19 +51,53 @@ func main() {
 	pvzRepo := repository.NewPVZRepo(db)
 	receptionRepo := repository.NewReceptionRepo(db)
 	productRepo := repository.NewProductRepo(db)
+	tokenRepo := repository.NewTokenRepo(db)
+	staffRepo := repository.NewStaffRepo(db)
+	productTypeRepo := repository.NewProductTypeRepo(db)
+	cityRepo := repository.NewCityRepo(db)
+	auditRepo := repository.NewAuditRepo(db)
+	outboxRepo := repository.NewOutboxRepo(db)
+	webhookRepo := repository.NewWebhookRepo(db)
+	idempotencyRepo := repository.NewIdempotencyRepo(db)
+	cellRepo := repository.NewCellRepo(db)
+	txManager := database.NewTxManager(db.DB)
 
 	jwtKey := []byte(cfg.JWTSecret)
-	userService := service.NewUserService(userRepo, jwtKey)
-	pvzService := service.NewPVZService(pvzRepo)
-	receptionService := service.NewReceptionService(receptionRepo)
-	productService := service.NewProductService(productRepo, receptionRepo)
+	userService := service.NewUserService(userRepo, tokenRepo, txManager, service.TokenSettings{
+		Key:        jwtKey,
+		AccessTTL:  cfg.AccessTokenTTL,
+		RefreshTTL: cfg.RefreshTokenTTL,
+	})
+	pvzService := service.NewPVZService(pvzRepo, staffRepo, userRepo, cityRepo, outboxRepo, txManager)
+	cityService := service.NewCityService(cityRepo)
+	productTypeService := service.NewProductTypeService(productTypeRepo, cfg.ProductTypeCacheTTL)
+	receptionService := service.NewReceptionService(receptionRepo, pvzRepo, productRepo, staffRepo, productTypeService, auditRepo, outboxRepo, txManager, cfg.ReceptionReopenWindow)
+	webhookService := service.NewWebhookService(webhookRepo, pvzRepo, cfg.WebhookAllowInternal)
+	productService := service.NewProductService(productRepo, receptionRepo, pvzRepo, cellRepo, staffRepo, productTypeService, outboxRepo, txManager)
+
+	issuanceService := service.NewIssuanceService(productRepo, pvzRepo, staffRepo, auditRepo, outboxRepo, txManager)
+	cellService := service.NewCellService(cellRepo, productRepo, receptionRepo, pvzRepo, staffRepo, auditRepo, outboxRepo, txManager)
+
+	hub := events.NewHub(cfg.EventStreamBuffer)
+	hub.OnDrop = func(events.Event) { metrics.EventStreamDropped.Inc() }
+	eventStreamService := service.NewEventStreamService(pvzRepo, staffRepo, hub)
 
 	r := routers.SetupRouter(
 		receptionService,
 		pvzService,
 		productService,
+		productTypeService,
+		cityService,
+		webhookService,
+		eventStreamService,
+		issuanceService,
+		cellService,
 		userService,
 		jwtKey,
+		tokenRepo,
+		idempotencyRepo,
+		cfg.IdempotencyTTL,
+		cfg.IdempotencyLockTTL,
 		log,
 	)
 
@@ -62,14 +105,120 @@ func main() {
 		Addr:    ":" + cfg.ServerPort,
 		Handler: r,
 	}
+	// Открытые SSE-потоки иначе держали бы Shutdown до таймаута.
+	srv.RegisterOnShutdown(hub.Close)
+
+	grpcSrv := grpcserver.New(pvzService, receptionService, productService, jwtKey, tokenRepo)
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+
+	if cfg.AutoCloseEnabled {
+		if cfg.AutoCloseMode != worker.AutoCloseModeClose && cfg.AutoCloseMode != worker.AutoCloseModeFlag {
+			slog.Error("Unknown auto-close mode, worker disabled", "mode", cfg.AutoCloseMode)
+		} else {
+			autoCloser := worker.NewAutoCloser(
+				database.NewLeader(db.DB, "reception-autoclose"),
+				receptionService,
+				worker.AutoCloseSettings{
+					Mode:     cfg.AutoCloseMode,
+					MaxAge:   cfg.AutoCloseMaxAge,
+					Interval: cfg.AutoCloseInterval,
+				},
+			)
+			workers.Add(1)
+			go func() {
+				defer workers.Done()
+				autoCloser.Run(workerCtx)
+			}()
+		}
+	}
+
+	var publisher events.EventPublisher = events.NewLogPublisher()
+	if cfg.OutboxFile != "" {
+		f, err := os.OpenFile(cfg.OutboxFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			slog.Error("Failed to open outbox file, falling back to log", "path", cfg.OutboxFile, "err", err)
+		} else {
+			defer f.Close()
+			publisher = events.NewWriterPublisher(f)
+		}
+	}
+
+	relay := worker.NewOutboxRelay(outboxRepo, txManager, events.MultiPublisher{
+		webhookService,
+		events.NewNotifyPublisher(db, events.NotifyChannel),
+		publisher,
+	}, worker.OutboxRelaySettings{
+		Interval:    cfg.OutboxRelayInterval,
+		BatchSize:   cfg.OutboxBatchSize,
+		MaxAttempts: cfg.OutboxMaxAttempts,
+	})
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		relay.Run(workerCtx)
+	}()
+
+	eventListener := worker.NewEventListener(database.NewListener(db.DB, events.NotifyChannel), hub)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		eventListener.Run(workerCtx)
+	}()
+
+	idempotencyCleaner := worker.NewIdempotencyCleaner(idempotencyRepo, cfg.IdempotencyCleanup)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		idempotencyCleaner.Run(workerCtx)
+	}()
+
+	revocationCleaner := worker.NewRevocationCleaner(tokenRepo, cfg.RevocationCleanup)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		revocationCleaner.Run(workerCtx)
+	}()
+
+	overdueReporter := worker.NewOverdueReporter(issuanceService, cfg.OverdueCheckInterval)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		overdueReporter.Run(workerCtx)
+	}()
+
+	dispatcher := worker.NewWebhookDispatcher(webhookRepo, webhook.NewSender(cfg.WebhookTimeout, cfg.WebhookAllowInternal), worker.WebhookDispatcherSettings{
+		Interval:    cfg.WebhookDispatchInterval,
+		BatchSize:   cfg.WebhookBatchSize,
+		MaxAttempts: cfg.WebhookMaxAttempts,
+		Lease:       time.Duration(cfg.WebhookBatchSize)*cfg.WebhookTimeout + time.Minute,
+	})
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		dispatcher.Run(workerCtx)
+	}()
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
 	go func() {
-		slog.Info("Starting server on :%s\n", cfg.ServerPort)
+		slog.Info("Starting server", "port", cfg.ServerPort)
 		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			slog.Error("server failed: %v", err)
+			slog.Error("server failed", "err", err)
+		}
+	}()
+
+	go func() {
+		lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+		if err != nil {
+			slog.Error("Failed to listen gRPC port", "port", cfg.GRPCPort, "err", err)
+			return
+		}
+		slog.Info("Starting gRPC server", "port", cfg.GRPCPort)
+		if err := grpcSrv.Serve(lis); err != nil {
+			slog.Error("gRPC server failed", "err", err)
 		}
 	}()
 
@@ -79,7 +228,24 @@ func main() {
 	defer cancel()
 
 	if err := srv.Shutdown(ctx); err != nil {
-		slog.Warn("Server forced to shutdown: %v", err)
+		slog.Warn("Server forced to shutdown", "err", err)
+	}
+
+	// GracefulStop ждёт завершения активных RPC; если они не уложились в
+	// общий таймаут, соединения закрываются принудительно.
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(grpcStopped)
+	}()
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		slog.Warn("gRPC server forced to stop")
+		grpcSrv.Stop()
 	}
 
+	stopWorkers()
+	workers.Wait()
+
 }
@@ -51,6 +51,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controllers.ErrorResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
                     }
                 }
             }
@@ -97,13 +103,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controllers.ErrorResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/auth/register": {
+        "/auth/logout": {
             "post": {
-                "description": "Создание нового пользователя в системе",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отзыв текущего access token и переданного refresh token",
                 "consumes": [
                     "application/json"
                 ],
@@ -113,82 +130,97 @@ const docTemplate = `{
                 "tags": [
                     "Auth"
                 ],
-                "summary": "Регистрация пользователя",
+                "summary": "Выход из системы",
                 "parameters": [
                     {
-                        "description": "Данные для регистрации",
+                        "description": "Refresh token",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.RegisterRequest"
+                            "$ref": "#/definitions/controllers.LogoutRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/controllers.RegisterResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/controllers.ErrorResponse"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/health": {
-            "get": {
-                "description": "Проверка доступности сервиса",
+        "/auth/refresh": {
+            "post": {
+                "description": "Обмен refresh token на новую пару токенов; старый refresh token отзывается",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Auth"
                 ],
-                "summary": "Проверка здоровья сервиса",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Обновление токена",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.HealthResponse"
+                            "$ref": "#/definitions/controllers.RefreshRequest"
                         }
                     }
-                }
-            }
-        },
-        "/metrics": {
-            "get": {
-                "description": "Prometheus метрики приложения",
-                "produces": [
-                    "text/plain"
-                ],
-                "tags": [
-                    "Metrics"
                 ],
-                "summary": "Метрики приложения",
                 "responses": {
                     "200": {
-                        "description": "Prometheus metrics",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/controllers.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/products/": {
+        "/auth/register": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Добавление товара в активную приемку ПВЗ (только для employee и moderator)",
+                "description": "Создание нового пользователя в системе",
                 "consumes": [
                     "application/json"
                 ],
@@ -196,17 +228,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Products"
+                    "Auth"
                 ],
-                "summary": "Добавление товара",
+                "summary": "Регистрация пользователя",
                 "parameters": [
                     {
-                        "description": "Данные товара",
+                        "description": "Данные для регистрации",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.AddProductRequest"
+                            "$ref": "#/definitions/controllers.RegisterRequest"
                         }
                     }
                 ],
@@ -214,7 +246,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ProductResponse"
+                            "$ref": "#/definitions/controllers.RegisterResponse"
                         }
                     },
                     "400": {
@@ -222,50 +254,45 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controllers.ErrorResponse"
                         }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/pvz/": {
+        "/cities/": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Получение списка пунктов выдачи заказов с пагинацией и фильтрацией по городу (только для admin и moderator)",
+                "description": "Список городов, в которых можно открывать ПВЗ",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "PVZ"
-                ],
-                "summary": "Получение списка ПВЗ",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Номер страницы",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Количество записей на странице",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Фильтр по городу",
-                        "name": "city",
-                        "in": "query"
-                    }
+                    "Cities"
                 ],
+                "summary": "Справочник городов",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controllers.PVZListResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controllers.CityResponse"
+                            }
                         }
                     },
                     "403": {
@@ -273,6 +300,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controllers.ErrorResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
                     }
                 }
             },
@@ -282,7 +315,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Создание нового пункта выдачи заказов (только для admin и moderator)",
+                "description": "Добавление города в справочник (только для moderator)",
                 "consumes": [
                     "application/json"
                 ],
@@ -290,17 +323,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PVZ"
+                    "Cities"
                 ],
-                "summary": "Создание ПВЗ",
+                "summary": "Добавление города",
                 "parameters": [
                     {
-                        "description": "Данные ПВЗ",
+                        "description": "Город",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.CreatePVZRequest"
+                            "$ref": "#/definitions/controllers.CityRequest"
                         }
                     }
                 ],
@@ -308,7 +341,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/controllers.PVZResponse"
+                            "$ref": "#/definitions/controllers.CityResponse"
                         }
                     },
                     "400": {
@@ -322,48 +355,15 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controllers.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/receptions/": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Создание новой приемки товаров для ПВЗ (только для employee и moderator)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Receptions"
-                ],
-                "summary": "Создание приемки",
-                "parameters": [
-                    {
-                        "description": "Данные приемки",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.ReceptionRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ReceptionResponse"
+                            "$ref": "#/definitions/controllers.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/controllers.ErrorResponse"
                         }
@@ -371,14 +371,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/receptions/close": {
+        "/cities/{id}": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Закрытие активной приемки товаров (только для employee и moderator)",
+                "description": "Изменение города (только для moderator). В выключенном городе нельзя открыть новый ПВЗ, переименование переносится на его ПВЗ",
                 "consumes": [
                     "application/json"
                 ],
@@ -386,17 +386,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Receptions"
+                    "Cities"
                 ],
-                "summary": "Закрытие приемки",
+                "summary": "Изменение города",
                 "parameters": [
                     {
-                        "description": "Данные для закрытия приемки",
+                        "type": "integer",
+                        "description": "ID города",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые значения",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controllers.ReceptionRequest"
+                            "$ref": "#/definitions/controllers.CityRequest"
                         }
                     }
                 ],
@@ -404,7 +411,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controllers.ReceptionResponse"
+                            "$ref": "#/definitions/controllers.CityResponse"
                         }
                     },
                     "400": {
@@ -412,111 +419,3498 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controllers.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/receptions/last-product": {
+            },
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Удаление последнего добавленного товара из приемки (только для employee и moderator)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Удаление города без ПВЗ (только для moderator). Город с ПВЗ можно только выключить",
                 "tags": [
-                    "Receptions"
+                    "Cities"
                 ],
-                "summary": "Удаление последнего товара",
+                "summary": "Удаление города",
                 "parameters": [
                     {
-                        "description": "Данные для удаления товара",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.ReceptionRequest"
-                        }
+                        "type": "integer",
+                        "description": "ID города",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/controllers.ReceptionWithProductsResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/controllers.ErrorResponse"
                         }
-                    }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "controllers.AddProductRequest": {
-            "type": "object",
-            "properties": {
-                "pvzId": {
-                    "type": "string",
-                    "example": "1"
-                },
-                "type": {
-                    "type": "string",
-                    "example": "electronics"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Проверка доступности сервиса",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Проверка здоровья сервиса",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/metrics": {
+            "get": {
+                "description": "Prometheus метрики приложения",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Metrics"
+                ],
+                "summary": "Метрики приложения",
+                "responses": {
+                    "200": {
+                        "description": "Prometheus metrics",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/product-types/": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Список типов товаров: модератор видит весь справочник, employee — только активные типы",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductTypes"
+                ],
+                "summary": "Справочник типов товаров",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controllers.ProductTypeResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавление типа товара в справочник (только для moderator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductTypes"
+                ],
+                "summary": "Добавление типа товара",
+                "parameters": [
+                    {
+                        "description": "Тип товара",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductTypeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/product-types/{code}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Изменение названий, активности и срока хранения типа товара (только для moderator). Поля необязательны: не переданное поле не меняется. Выключенный тип нельзя добавить в приемку",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductTypes"
+                ],
+                "summary": "Изменение типа товара",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Код типа товара",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые значения",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductTypeUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductTypeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаление неиспользуемого типа товара (только для moderator). Тип, который уже есть у товаров, можно только выключить",
+                "tags": [
+                    "ProductTypes"
+                ],
+                "summary": "Удаление типа товара",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Код типа товара",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавление товара в активную приемку ПВЗ (только для employee и moderator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Добавление товара",
+                "parameters": [
+                    {
+                        "description": "Данные товара",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.AddProductRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавление списка товаров в активную приемку ПВЗ одной транзакцией (только для employee). Если хотя бы один товар не прошёл проверку, не сохраняется ни один, а в results указано, какие товары отклонены",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Пакетное добавление товаров",
+                "parameters": [
+                    {
+                        "description": "Товары",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.BatchProductsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.BatchProductsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.BatchProductsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.BatchProductsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/scan": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавление отсканированного товара в активную приемку ПВЗ (только для employee). Штрихкод не может повторяться в открытых приемках",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Products"
+                ],
+                "summary": "Приемка товара по штрихкоду",
+                "parameters": [
+                    {
+                        "description": "Данные скана",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ScanProductRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Перекладывает хранящийся товар в другую ячейку того же ПВЗ (только employee, закреплённый за ПВЗ)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cells"
+                ],
+                "summary": "Перемещение товара между ячейками",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID товара",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Целевая ячейка",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.MoveProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получение списка пунктов выдачи заказов с приёмками и товарами, пагинацией, фильтрацией по городу и дате приёмки (только для admin и moderator)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Получение списка ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество записей на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по городу",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало диапазона даты приёмки (RFC3339)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец диапазона даты приёмки (RFC3339)",
+                        "name": "endDate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.PVZListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создание нового пункта выдачи заказов (только для admin и moderator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Создание ПВЗ",
+                "parameters": [
+                    {
+                        "description": "Данные ПВЗ",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreatePVZRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.PVZResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/capacity": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Задает вместимость ПВЗ в местах хранения и правило при заполнении: reject — товар не принимается, warn — принимается с предупреждением в логе и метрике (только для moderator). Без capacity ограничение снимается",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Вместимость ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Вместимость",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CapacityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CapacityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/cells": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ячейки ПВЗ по коду с числом занятых и свободных мест (moderator или employee, закреплённый за ПВЗ)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cells"
+                ],
+                "summary": "Ячейки хранения ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controllers.CellResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Заводит в ПВЗ ячейку с кодом вида A-01-03 (стеллаж-полка-место) и вместимостью в товарах, по умолчанию 1 (только для moderator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Cells"
+                ],
+                "summary": "Создание ячейки хранения",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ячейка",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CellRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CellResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/cells/{code}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаляет пустую ячейку ПВЗ (только для moderator)",
+                "tags": [
+                    "Cells"
+                ],
+                "summary": "Удаление ячейки хранения",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код ячейки",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Server-sent events об открытии, закрытии, отмене и возобновлении приёмок и о добавлении и удалении товаров (moderator или employee, закреплённый за ПВЗ). Имя события — его тип, data — событие в JSON, id — ID события.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Живая лента событий ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поток событий",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/issue": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отмечает выданными посылки ПВЗ с указанным кодом выдачи (только employee, закреплённый за ПВЗ). Без productIds выдаются все посылки с этим кодом",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Issuance"
+                ],
+                "summary": "Выдача посылок клиенту",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Код выдачи",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.IssueRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.IssueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/occupancy": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Сколько мест занято товарами, которые сейчас хранятся в ПВЗ (приняты или ждут выдачи), и сколько осталось (moderator или employee, закреплённый за ПВЗ)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Заполненность ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.OccupancyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/overdue": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Посылки, ожидающие выдачи дольше срока хранения своего типа, начиная с самых просроченных (moderator или employee, закреплённый за ПВЗ)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Issuance"
+                ],
+                "summary": "Просроченные посылки ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество записей на странице",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/products": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Принятые и ожидающие выдачи товары ПВЗ от новых к старым с курсорной пагинацией (moderator или employee, закреплённый за ПВЗ)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Issuance"
+                ],
+                "summary": "Товары на хранении в ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "received",
+                            "ready_for_pickup"
+                        ],
+                        "type": "string",
+                        "description": "Статус товара",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код выдачи",
+                        "name": "pickupCode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код ячейки хранения",
+                        "name": "cell",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество записей на странице",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/receptions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "История приемок ПВЗ от новых к старым с фильтром по статусу и дате и курсорной пагинацией (moderator или employee, закреплённый за ПВЗ)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receptions"
+                ],
+                "summary": "История приемок ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "in_progress",
+                            "closed",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "Статус приемки",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало диапазона (RFC3339)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец диапазона (RFC3339)",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор следующей страницы из nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество записей на странице",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/return-to-sender": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переводит ожидающие выдачи посылки ПВЗ в статус returned: они покидают ПВЗ и освобождают место (только employee, закреплённый за ПВЗ). Без productIds возвращаются просроченные посылки, не больше 500 за запрос",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Issuance"
+                ],
+                "summary": "Возврат невостребованных посылок отправителю",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Посылки",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReturnToSenderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.IssueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{id}/staff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Список сотрудников, закреплённых за ПВЗ (только для moderator)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Сотрудники ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controllers.StaffResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Назначение сотрудника (employee) на ПВЗ (только для moderator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Закрепление сотрудника за ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Сотрудник",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.StaffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.StaffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Снятие сотрудника с ПВЗ (только для moderator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Открепление сотрудника от ПВЗ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID ПВЗ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Сотрудник",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.StaffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создание новой приемки товаров для ПВЗ (только для employee и moderator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receptions"
+                ],
+                "summary": "Создание приемки",
+                "parameters": [
+                    {
+                        "description": "Данные приемки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/close": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Закрытие активной приемки товаров (только для employee и moderator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receptions"
+                ],
+                "summary": "Закрытие приемки",
+                "parameters": [
+                    {
+                        "description": "Данные для закрытия приемки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/last-product": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаление последнего добавленного товара из приемки (только для employee и moderator)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receptions"
+                ],
+                "summary": "Удаление последнего товара",
+                "parameters": [
+                    {
+                        "description": "Данные для удаления товара",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionWithProductsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Приемка со списком товаров в порядке добавления (moderator или employee, закреплённый за ПВЗ)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receptions"
+                ],
+                "summary": "Получение приемки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID приемки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionWithProducts"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отмена приемки в статусе in_progress (moderator или employee, закреплённый за ПВЗ)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receptions"
+                ],
+                "summary": "Отмена приемки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID приемки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Кто и когда менял статус приемки (moderator или employee, закреплённый за ПВЗ)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receptions"
+                ],
+                "summary": "История статусов приемки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID приемки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controllers.StatusChangeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/{id}/products/{productId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаление конкретного товара из приемки в статусе in_progress (только для employee, закреплённого за ПВЗ). Удаление записывается в журнал",
+                "tags": [
+                    "Receptions"
+                ],
+                "summary": "Удаление товара из приемки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID приемки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID товара",
+                        "name": "productId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/{id}/reopen": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Возврат закрытой приемки в статус in_progress (только для moderator, в течение RECEPTION_REOPEN_WINDOW после закрытия)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Receptions"
+                ],
+                "summary": "Возврат приемки в работу",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID приемки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/returns/": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Открывает приемку товаров, возвращенных покупателями (только для employee). Работает независимо от приемки поставки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Returns"
+                ],
+                "summary": "Создание приемки возвратов",
+                "parameters": [
+                    {
+                        "description": "ПВЗ",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/returns/close": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Закрывает открытую приемку возвратов ПВЗ (только для employee)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Returns"
+                ],
+                "summary": "Закрытие приемки возвратов",
+                "parameters": [
+                    {
+                        "description": "ПВЗ",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReceptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/returns/items": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавляет товар в открытую приемку возвратов ПВЗ (только для employee). Исходный товар берется из originalProductId, иначе ищется среди выданных по штрихкоду, и переводится в статус returned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Returns"
+                ],
+                "summary": "Приемка возвращенного товара",
+                "parameters": [
+                    {
+                        "description": "Возвращенный товар",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ReturnItemRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Список подписок на события (только для moderator)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Список вебхуков",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controllers.WebhookResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Подписка на события приемок (только для moderator). Без pvzId приходят события всех ПВЗ. URL не может указывать на loopback, link-local и адреса метаданных облаков. Если secret не задан, он генерируется; секрет возвращается только в ответе на создание",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Регистрация вебхука",
+                "parameters": [
+                    {
+                        "description": "Подписка",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.WebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Подписка по ID (только для moderator)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Вебхук",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID вебхука",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удаление подписки вместе с журналом доставок (только для moderator)",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Удаление вебхука",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID вебхука",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Последние доставки подписки от новых к старым (только для moderator)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Журнал доставок вебхука",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID вебхука",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество записей (до 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controllers.WebhookDeliveryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controllers.AddProductRequest": {
+            "type": "object",
+            "properties": {
+                "pvzId": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "электроника"
+                }
+            }
+        },
+        "controllers.BatchProductResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "invalid product type"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "product": {
+                    "$ref": "#/definitions/controllers.ProductResponse"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "rejected",
+                        "skipped"
+                    ],
+                    "example": "created"
+                }
+            }
+        },
+        "controllers.BatchProductsRequest": {
+            "type": "object",
+            "properties": {
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.ProductItemRequest"
+                    }
+                },
+                "pvzId": {
+                    "type": "string",
+                    "example": "1"
+                }
+            }
+        },
+        "controllers.BatchProductsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "some products are invalid"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.BatchProductResult"
+                    }
+                },
+                "warning": {
+                    "type": "string",
+                    "example": "PVZ capacity exceeded: 12 of 10 slots occupied"
+                }
+            }
+        },
+        "controllers.CapacityRequest": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "policy": {
+                    "type": "string",
+                    "enum": [
+                        "reject",
+                        "warn"
+                    ],
+                    "example": "reject"
+                }
+            }
+        },
+        "controllers.CapacityResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "policy": {
+                    "type": "string",
+                    "enum": [
+                        "reject",
+                        "warn"
+                    ],
+                    "example": "reject"
+                },
+                "pvzId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "controllers.CellRequest": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "code": {
+                    "type": "string",
+                    "example": "A-01-03"
+                }
+            }
+        },
+        "controllers.CellResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "code": {
+                    "type": "string",
+                    "example": "A-01-03"
+                },
+                "free": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440003"
+                },
+                "occupied": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pvzId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "controllers.CityRequest": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Екатеринбург"
+                },
+                "region": {
+                    "type": "string",
+                    "example": "Свердловская область"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Yekaterinburg"
+                }
+            }
+        },
+        "controllers.CityResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Екатеринбург"
+                },
+                "region": {
+                    "type": "string",
+                    "example": "Свердловская область"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Asia/Yekaterinburg"
+                }
+            }
+        },
+        "controllers.CreatePVZRequest": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "example": "Москва"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "ПВЗ Центральный"
+                }
+            }
+        },
+        "controllers.DummyLoginRequest": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "example": "employee"
+                }
+            }
+        },
+        "controllers.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "error message"
+                }
+            }
+        },
+        "controllers.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "controllers.IssueRequest": {
+            "type": "object",
+            "properties": {
+                "pickupCode": {
+                    "type": "string",
+                    "example": "482913"
+                },
+                "productIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "controllers.IssueResponse": {
+            "type": "object",
+            "properties": {
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.ProductResponse"
+                    }
+                }
+            }
+        },
+        "controllers.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "password123"
+                }
+            }
+        },
+        "controllers.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "expiresIn": {
+                    "type": "integer",
+                    "example": 900
+                },
+                "refreshToken": {
+                    "type": "string",
+                    "example": "q8Vd3l0YyG2vJ6mX3f2c1b0a9Z8y7X6w5V4u3T2s1R0"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                }
+            }
+        },
+        "controllers.LogoutRequest": {
+            "type": "object",
+            "properties": {
+                "refreshToken": {
+                    "type": "string",
+                    "example": "q8Vd3l0YyG2vJ6mX3f2c1b0a9Z8y7X6w5V4u3T2s1R0"
+                }
+            }
+        },
+        "controllers.MoveProductRequest": {
+            "type": "object",
+            "properties": {
+                "cell": {
+                    "type": "string",
+                    "example": "B-02-01"
+                }
+            }
+        },
+        "controllers.OccupancyResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "free": {
+                    "type": "integer",
+                    "example": 63
+                },
+                "full": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "occupied": {
+                    "type": "integer",
+                    "example": 437
+                },
+                "policy": {
+                    "type": "string",
+                    "enum": [
+                        "reject",
+                        "warn"
+                    ],
+                    "example": "reject"
+                },
+                "pvzId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "controllers.PVZListResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "pvzs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.PVZWithReceptionsResponse"
+                    }
+                }
+            }
+        },
+        "controllers.PVZResponse": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "example": "Москва"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "ПВЗ Центральный"
+                }
+            }
+        },
+        "controllers.PVZWithReceptionsResponse": {
+            "type": "object",
+            "properties": {
+                "pvz": {
+                    "$ref": "#/definitions/controllers.PVZResponse"
+                },
+                "receptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.ReceptionWithProducts"
+                    }
+                }
+            }
+        },
+        "controllers.ProductItemRequest": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "type": "string",
+                    "example": "4601234567893"
+                },
+                "cell": {
+                    "type": "string",
+                    "example": "A-01-03"
+                },
+                "heightMm": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "lengthMm": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "orderNumber": {
+                    "type": "string",
+                    "example": "ORD-2025-000123"
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "PHONE-128-BLK"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "электроника"
+                },
+                "weightGrams": {
+                    "type": "integer",
+                    "example": 450
+                },
+                "widthMm": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "controllers.ProductListResponse": {
+            "type": "object",
+            "properties": {
+                "nextCursor": {
+                    "type": "string",
+                    "example": "MjAyNS0wNC0wMVQxMjozMDowMFp8NTUwZTg0MDA"
+                },
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.ProductResponse"
+                    }
+                }
+            }
+        },
+        "controllers.ProductResponse": {
+            "type": "object",
+            "properties": {
+                "addedAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "barcode": {
+                    "type": "string",
+                    "example": "4601234567893"
+                },
+                "cell": {
+                    "type": "string",
+                    "example": "A-01-03"
+                },
+                "heightMm": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "issuedAt": {
+                    "type": "string",
+                    "example": "2023-10-02T15:00:00Z"
+                },
+                "lengthMm": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "orderNumber": {
+                    "type": "string",
+                    "example": "ORD-2025-000123"
+                },
+                "originalProductId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "pickupCode": {
+                    "type": "string",
+                    "example": "482913"
+                },
+                "receptionId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "returnComment": {
+                    "type": "string",
+                    "example": "Треснул экран"
+                },
+                "returnReason": {
+                    "type": "string",
+                    "enum": [
+                        "damaged",
+                        "wrong_item",
+                        "not_as_described",
+                        "changed_mind",
+                        "other"
+                    ],
+                    "example": "damaged"
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "PHONE-128-BLK"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "received",
+                        "ready_for_pickup",
+                        "issued",
+                        "returned"
+                    ],
+                    "example": "ready_for_pickup"
+                },
+                "storageExpiresAt": {
+                    "type": "string",
+                    "example": "2023-10-08T12:00:00Z"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "электроника"
+                },
+                "warning": {
+                    "type": "string",
+                    "example": "PVZ capacity exceeded: 11 of 10 slots occupied"
+                },
+                "weightGrams": {
+                    "type": "integer",
+                    "example": 450
+                },
+                "widthMm": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "controllers.ProductTypeRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "обувь"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "nameEn": {
+                    "type": "string",
+                    "example": "Shoes"
+                },
+                "nameRu": {
+                    "type": "string",
+                    "example": "Обувь"
+                },
+                "storageDays": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "controllers.ProductTypeResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "обувь"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "nameEn": {
+                    "type": "string",
+                    "example": "Shoes"
+                },
+                "nameRu": {
+                    "type": "string",
+                    "example": "Обувь"
+                },
+                "storageDays": {
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "controllers.ProductTypeUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "isActive": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "nameEn": {
+                    "type": "string",
+                    "example": "Shoes"
+                },
+                "nameRu": {
+                    "type": "string",
+                    "example": "Обувь"
+                },
+                "storageDays": {
+                    "type": "integer",
+                    "example": 14
                 }
             }
         },
-        "controllers.CreatePVZRequest": {
+        "controllers.ReceptionListResponse": {
             "type": "object",
             "properties": {
-                "city": {
+                "nextCursor": {
                     "type": "string",
-                    "example": "Москва"
+                    "example": "MjAyNS0wNC0wMVQxMjozMDowMFp8NTUwZTg0MDA"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "ПВЗ Центральный"
+                "receptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.ReceptionWithProducts"
+                    }
                 }
             }
         },
-        "controllers.DummyLoginRequest": {
+        "controllers.ReceptionRequest": {
             "type": "object",
             "properties": {
-                "role": {
+                "pvzId": {
                     "type": "string",
-                    "example": "employee"
+                    "example": "1"
                 }
             }
         },
-        "controllers.ErrorResponse": {
+        "controllers.ReceptionResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "dateTime": {
                     "type": "string",
-                    "example": "error message"
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "delivery",
+                        "return"
+                    ],
+                    "example": "delivery"
+                },
+                "pvzId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "in_progress",
+                        "closed",
+                        "cancelled"
+                    ],
+                    "example": "in_progress"
                 }
             }
         },
-        "controllers.HealthResponse": {
+        "controllers.ReceptionWithProducts": {
+            "type": "object",
+            "properties": {
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controllers.ProductResponse"
+                    }
+                },
+                "reception": {
+                    "$ref": "#/definitions/controllers.ReceptionResponse"
+                }
+            }
+        },
+        "controllers.ReceptionWithProductsResponse": {
             "type": "object",
             "properties": {
+                "dateTime": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "productIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"prod1\"",
+                        " \"prod2\"]"
+                    ]
+                },
+                "pvzId": {
+                    "type": "integer",
+                    "example": 1
+                },
                 "status": {
                     "type": "string",
-                    "example": "ok"
+                    "enum": [
+                        "in_progress",
+                        "closed",
+                        "cancelled"
+                    ],
+                    "example": "in_progress"
                 }
             }
         },
-        "controllers.LoginRequest": {
+        "controllers.RefreshRequest": {
+            "type": "object",
+            "properties": {
+                "refreshToken": {
+                    "type": "string",
+                    "example": "q8Vd3l0YyG2vJ6mX3f2c1b0a9Z8y7X6w5V4u3T2s1R0"
+                }
+            }
+        },
+        "controllers.RegisterRequest": {
             "type": "object",
             "properties": {
                 "email": {
@@ -526,167 +3920,316 @@ const docTemplate = `{
                 "password": {
                     "type": "string",
                     "example": "password123"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "employee"
                 }
             }
         },
-        "controllers.LoginResponse": {
+        "controllers.RegisterResponse": {
             "type": "object",
             "properties": {
-                "token": {
+                "email": {
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                    "example": "user@example.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "role": {
+                    "type": "string",
+                    "example": "employee"
                 }
             }
         },
-        "controllers.PVZListResponse": {
+        "controllers.ReturnItemRequest": {
             "type": "object",
             "properties": {
-                "page": {
+                "barcode": {
+                    "type": "string",
+                    "example": "4601234567893"
+                },
+                "cell": {
+                    "type": "string",
+                    "example": "A-01-03"
+                },
+                "comment": {
+                    "type": "string",
+                    "example": "Треснул экран"
+                },
+                "heightMm": {
                     "type": "integer",
-                    "example": 1
+                    "example": 60
                 },
-                "pvzs": {
+                "lengthMm": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "orderNumber": {
+                    "type": "string",
+                    "example": "ORD-2025-000123"
+                },
+                "originalProductId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "pvzId": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "reason": {
+                    "type": "string",
+                    "enum": [
+                        "damaged",
+                        "wrong_item",
+                        "not_as_described",
+                        "changed_mind",
+                        "other"
+                    ],
+                    "example": "damaged"
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "PHONE-128-BLK"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "электроника"
+                },
+                "weightGrams": {
+                    "type": "integer",
+                    "example": 450
+                },
+                "widthMm": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "controllers.ReturnToSenderRequest": {
+            "type": "object",
+            "properties": {
+                "productIds": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/controllers.PVZResponse"
+                        "type": "string"
                     }
                 }
             }
         },
-        "controllers.PVZResponse": {
+        "controllers.ScanProductRequest": {
             "type": "object",
             "properties": {
-                "city": {
+                "barcode": {
                     "type": "string",
-                    "example": "Москва"
+                    "example": "4601234567893"
                 },
-                "createdAt": {
+                "cell": {
                     "type": "string",
-                    "example": "2023-10-01T12:00:00Z"
+                    "example": "A-01-03"
                 },
-                "id": {
+                "heightMm": {
                     "type": "integer",
-                    "example": 1
+                    "example": 60
                 },
-                "name": {
-                    "type": "string",
-                    "example": "ПВЗ Центральный"
-                }
-            }
-        },
-        "controllers.ProductResponse": {
-            "type": "object",
-            "properties": {
-                "addedAt": {
+                "lengthMm": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "orderNumber": {
                     "type": "string",
-                    "example": "2023-10-01T12:00:00Z"
+                    "example": "ORD-2025-000123"
                 },
-                "id": {
+                "pvzId": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "1"
                 },
-                "receptionId": {
+                "sku": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                    "example": "PHONE-128-BLK"
                 },
                 "type": {
                     "type": "string",
-                    "example": "electronics"
+                    "example": "электроника"
+                },
+                "weightGrams": {
+                    "type": "integer",
+                    "example": 450
+                },
+                "widthMm": {
+                    "type": "integer",
+                    "example": 120
                 }
             }
         },
-        "controllers.ReceptionRequest": {
+        "controllers.StaffRequest": {
             "type": "object",
             "properties": {
-                "pvzId": {
+                "userId": {
                     "type": "string",
-                    "example": "1"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 }
             }
         },
-        "controllers.ReceptionResponse": {
+        "controllers.StaffResponse": {
             "type": "object",
             "properties": {
-                "dateTime": {
+                "assignedBy": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "createdAt": {
                     "type": "string",
                     "example": "2023-10-01T12:00:00Z"
                 },
-                "id": {
+                "email": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "user@example.com"
                 },
                 "pvzId": {
                     "type": "integer",
                     "example": 1
                 },
-                "status": {
+                "userId": {
                     "type": "string",
-                    "example": "active"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 }
             }
         },
-        "controllers.ReceptionWithProductsResponse": {
+        "controllers.StatusChangeResponse": {
             "type": "object",
             "properties": {
-                "dateTime": {
+                "changedAt": {
                     "type": "string",
                     "example": "2023-10-01T12:00:00Z"
                 },
-                "id": {
+                "changedBy": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "productIDs": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"prod1\"",
-                        " \"prod2\"]"
-                    ]
+                "fromStatus": {
+                    "type": "string",
+                    "example": "in_progress"
                 },
-                "pvzId": {
+                "toStatus": {
+                    "type": "string",
+                    "example": "closed"
+                }
+            }
+        },
+        "controllers.WebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
                     "type": "integer",
                     "example": 1
                 },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "deliveredAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:01Z"
+                },
+                "eventId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440003"
+                },
+                "eventType": {
+                    "type": "string",
+                    "example": "reception.closed"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440002"
+                },
+                "lastError": {
+                    "type": "string",
+                    "example": "unexpected status 503"
+                },
+                "lastStatusCode": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "nextAttemptAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:10Z"
+                },
                 "status": {
                     "type": "string",
-                    "example": "active"
+                    "enum": [
+                        "pending",
+                        "delivered",
+                        "failed"
+                    ],
+                    "example": "delivered"
                 }
             }
         },
-        "controllers.RegisterRequest": {
+        "controllers.WebhookRequest": {
             "type": "object",
             "properties": {
-                "email": {
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "reception.closed"
+                    ]
+                },
+                "pvzId": {
                     "type": "string",
-                    "example": "user@example.com"
+                    "example": "1"
                 },
-                "password": {
+                "secret": {
                     "type": "string",
-                    "example": "password123"
+                    "example": "7f9c2ba4e88f827d616045507605853e"
                 },
-                "role": {
+                "url": {
                     "type": "string",
-                    "example": "employee"
+                    "example": "https://wms.example.com/hooks/pvz"
                 }
             }
         },
-        "controllers.RegisterResponse": {
+        "controllers.WebhookResponse": {
             "type": "object",
             "properties": {
-                "email": {
+                "createdAt": {
                     "type": "string",
-                    "example": "user@example.com"
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "reception.closed"
+                    ]
                 },
                 "id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "role": {
+                "pvzId": {
                     "type": "string",
-                    "example": "employee"
+                    "example": "1"
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "7f9c2ba4e88f827d616045507605853e"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://wms.example.com/hooks/pvz"
                 }
             }
         }
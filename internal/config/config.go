@@ -3,29 +3,79 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 type Config struct {
-	DBUser     string
-	DBPassword string
-	DBHost     string
-	DBPort     string
-	DBName     string
-	DBSSLMode  string
-	JWTSecret  string
-	ServerPort string
+	DBUser                  string
+	DBPassword              string
+	DBHost                  string
+	DBPort                  string
+	DBName                  string
+	DBSSLMode               string
+	JWTSecret               string
+	AccessTokenTTL          time.Duration
+	RefreshTokenTTL         time.Duration
+	ProductTypeCacheTTL     time.Duration
+	ReceptionReopenWindow   time.Duration
+	AutoCloseEnabled        bool
+	AutoCloseMode           string
+	AutoCloseMaxAge         time.Duration
+	AutoCloseInterval       time.Duration
+	OutboxRelayInterval     time.Duration
+	OutboxBatchSize         int
+	OutboxMaxAttempts       int
+	OutboxFile              string
+	WebhookDispatchInterval time.Duration
+	WebhookBatchSize        int
+	WebhookMaxAttempts      int
+	WebhookTimeout          time.Duration
+	WebhookAllowInternal    bool
+	EventStreamBuffer       int
+	IdempotencyTTL          time.Duration
+	IdempotencyLockTTL      time.Duration
+	IdempotencyCleanup      time.Duration
+	RevocationCleanup       time.Duration
+	OverdueCheckInterval    time.Duration
+	ServerPort              string
+	GRPCPort                string
 }
 
 func Load() *Config {
 	return &Config{
-		DBUser:     getEnv("DB_USER", "postgres"),
-		DBPassword: getEnv("DB_PASSWORD", "secret"),
-		DBHost:     getEnv("DB_HOST", "localhost"),
-		DBPort:     getEnv("DB_PORT", "5432"),
-		DBName:     getEnv("DB_NAME", "pvz"),
-		DBSSLMode:  getEnv("DB_SSLMODE", "disable"),
-		JWTSecret:  getEnv("JWT_SECRET", "default-secret-key"),
-		ServerPort: getEnv("PORT", "8080"), // Добавляем порт сервера
+		DBUser:                  getEnv("DB_USER", "postgres"),
+		DBPassword:              getEnv("DB_PASSWORD", "secret"),
+		DBHost:                  getEnv("DB_HOST", "localhost"),
+		DBPort:                  getEnv("DB_PORT", "5432"),
+		DBName:                  getEnv("DB_NAME", "pvz"),
+		DBSSLMode:               getEnv("DB_SSLMODE", "disable"),
+		JWTSecret:               getEnv("JWT_SECRET", "default-secret-key"),
+		AccessTokenTTL:          getDuration("ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL:         getDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour),
+		ProductTypeCacheTTL:     getDuration("PRODUCT_TYPE_CACHE_TTL", time.Minute),
+		ReceptionReopenWindow:   getDuration("RECEPTION_REOPEN_WINDOW", time.Hour),
+		AutoCloseEnabled:        getBool("AUTO_CLOSE_ENABLED", true),
+		AutoCloseMode:           getEnv("AUTO_CLOSE_MODE", "close"),
+		AutoCloseMaxAge:         getDuration("AUTO_CLOSE_MAX_AGE", 12*time.Hour),
+		AutoCloseInterval:       getDuration("AUTO_CLOSE_INTERVAL", 5*time.Minute),
+		OutboxRelayInterval:     getDuration("OUTBOX_RELAY_INTERVAL", time.Second),
+		OutboxBatchSize:         getInt("OUTBOX_BATCH_SIZE", 100),
+		OutboxMaxAttempts:       getInt("OUTBOX_MAX_ATTEMPTS", 10),
+		OutboxFile:              getEnv("OUTBOX_FILE", ""),
+		WebhookDispatchInterval: getDuration("WEBHOOK_DISPATCH_INTERVAL", 2*time.Second),
+		WebhookBatchSize:        getInt("WEBHOOK_BATCH_SIZE", 20),
+		WebhookMaxAttempts:      getInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		WebhookTimeout:          getDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+		WebhookAllowInternal:    getBool("WEBHOOK_ALLOW_INTERNAL", false),
+		EventStreamBuffer:       getInt("EVENT_STREAM_BUFFER", 64),
+		IdempotencyTTL:          getDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+		IdempotencyLockTTL:      getDuration("IDEMPOTENCY_LOCK_TTL", time.Minute),
+		IdempotencyCleanup:      getDuration("IDEMPOTENCY_CLEANUP_INTERVAL", time.Hour),
+		RevocationCleanup:       getDuration("REVOCATION_CLEANUP_INTERVAL", time.Hour),
+		OverdueCheckInterval:    getDuration("OVERDUE_CHECK_INTERVAL", 15*time.Minute),
+		ServerPort:              getEnv("PORT", "8080"), // Добавляем порт сервера
+		GRPCPort:                getEnv("GRPC_PORT", "9090"),
 	}
 }
 
@@ -44,3 +94,30 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
+
+func getBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+
+func getInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultValue
+}
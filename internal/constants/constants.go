@@ -3,15 +3,55 @@ package constants
 const (
 	ReceptionInProgress = "in_progress"
 	ReceptionClosed     = "closed"
+	ReceptionCancelled  = "cancelled"
 )
 
+// Виды приёмок: поставка от продавца и возвраты от покупателей
 const (
-	CityMoscow = "Москва"
-	CityKazan  = "Казань"
-	CitySpb    = "Санкт-Петербург"
+	ReceptionKindDelivery = "delivery"
+	ReceptionKindReturn   = "return"
+)
+
+// Причины возврата товара покупателем
+const (
+	ReturnReasonDamaged        = "damaged"
+	ReturnReasonWrongItem      = "wrong_item"
+	ReturnReasonNotAsDescribed = "not_as_described"
+	ReturnReasonChangedMind    = "changed_mind"
+	ReturnReasonOther          = "other"
+)
+
+// Жизненный цикл товара после приёмки
+const (
+	ProductReceived       = "received"
+	ProductReadyForPickup = "ready_for_pickup"
+	ProductIssued         = "issued"
+	ProductReturned       = "returned"
+)
+
+// Действия в журнале product_audit_log
+const (
+	ProductActionDeleted = "deleted"
+	ProductActionIssued  = "issued"
+	ProductActionMoved   = "moved"
+
+	ProductActionReturnedToSender = "returned_to_sender"
+)
+
+// Что делать с товаром, если ПВЗ заполнен
+const (
+	CapacityPolicyReject = "reject"
+	CapacityPolicyWarn   = "warn"
 )
 
 const (
 	RoleEmployee  = "employee"
 	RoleModerator = "moderator"
 )
+
+// Статусы доставки вебхука
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
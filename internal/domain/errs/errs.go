@@ -0,0 +1,82 @@
+package errs
+
+import "errors"
+
+// Базовые виды ошибок; конкретную ошибку проверяют через errors.Is(err, errs.ErrNotFound).
+var (
+	ErrValidation   = errors.New("validation error")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrInternal     = errors.New("internal error")
+)
+
+// Error — доменная ошибка с сообщением для клиента и исходной причиной для логов.
+type Error struct {
+	kind  error
+	msg   string
+	cause error
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.msg + ": " + e.cause.Error()
+	}
+	return e.msg
+}
+
+// Message — текст, который можно отдавать клиенту, без деталей причины.
+func (e *Error) Message() string {
+	return e.msg
+}
+
+func (e *Error) Is(target error) bool {
+	return target == e.kind
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Kind возвращает вид ошибки: один из Err* или ErrInternal для всего остального.
+func Kind(err error) error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.kind
+	}
+	return ErrInternal
+}
+
+func Validation(msg string) error {
+	return &Error{kind: ErrValidation, msg: msg}
+}
+
+func Unauthorized(msg string) error {
+	return &Error{kind: ErrUnauthorized, msg: msg}
+}
+
+func Forbidden(msg string) error {
+	return &Error{kind: ErrForbidden, msg: msg}
+}
+
+func NotFound(msg string) error {
+	return &Error{kind: ErrNotFound, msg: msg}
+}
+
+func Conflict(msg string) error {
+	return &Error{kind: ErrConflict, msg: msg}
+}
+
+func Internal(msg string, cause error) error {
+	return &Error{kind: ErrInternal, msg: msg, cause: cause}
+}
+
+// Wrap оставляет доменную ошибку как есть, а любую другую превращает во внутреннюю.
+func Wrap(err error, msg string) error {
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+	return Internal(msg, err)
+}
@@ -0,0 +1,89 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Типы событий, которые сервисы пишут в outbox.
+const (
+	PVZCreated         = "pvz.created"
+	ReceptionCreated   = "reception.created"
+	ReceptionClosed    = "reception.closed"
+	ReceptionCancelled = "reception.cancelled"
+	ReceptionReopened  = "reception.reopened"
+	ProductAdded       = "product.added"
+	ProductDeleted     = "product.deleted"
+	ProductIssued      = "product.issued"
+	ProductMoved       = "product.moved"
+	ProductsOverdue    = "products.overdue"
+
+	ProductReturnedToSender = "product.returned_to_sender"
+)
+
+// Типы агрегатов, к которым относятся события.
+const (
+	AggregatePVZ       = "pvz"
+	AggregateReception = "reception"
+	AggregateProduct   = "product"
+)
+
+// Event — событие в том виде, в каком его получает подписчик. ID стабилен
+// между повторными попытками, по нему потребитель отбрасывает дубликаты.
+type Event struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+}
+
+// EventPublisher доставляет событие во внешнюю систему. Ошибка означает, что
+// событие не доставлено и релей повторит попытку позже.
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// Полезная нагрузка событий.
+type (
+	PVZPayload struct {
+		ID        int64     `json:"id"`
+		Name      string    `json:"name"`
+		City      string    `json:"city"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	ReceptionPayload struct {
+		ID         string `json:"id"`
+		PVZID      int64  `json:"pvzId"`
+		Kind       string `json:"kind"`
+		FromStatus string `json:"fromStatus,omitempty"`
+		Status     string `json:"status"`
+		ChangedBy  string `json:"changedBy,omitempty"`
+	}
+
+	ProductPayload struct {
+		ID          string `json:"id"`
+		ReceptionID string `json:"receptionId"`
+		PVZID       int64  `json:"pvzId"`
+		Type        string `json:"type"`
+		Barcode     string `json:"barcode,omitempty"`
+		ActorID     string `json:"actorId,omitempty"`
+
+		ReturnReason      string `json:"returnReason,omitempty"`
+		OriginalProductID string `json:"originalProductId,omitempty"`
+
+		Cell string `json:"cell,omitempty"`
+	}
+
+	OverduePayload struct {
+		PVZID      int64    `json:"pvzId"`
+		ProductIDs []string `json:"productIds"`
+	}
+)
+
+// MaxOverdueProducts — сколько посылок помещается в одно событие
+// products.overdue, чтобы оно уложилось в предел payload NOTIFY.
+const MaxOverdueProducts = 150
@@ -0,0 +1,152 @@
+package events
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// streamedEventTypes — события, которые уходят в живую ленту ПВЗ.
+var streamedEventTypes = map[string]bool{
+	ReceptionCreated:   true,
+	ReceptionClosed:    true,
+	ReceptionCancelled: true,
+	ReceptionReopened:  true,
+	ProductAdded:       true,
+	ProductDeleted:     true,
+	ProductIssued:      true,
+	ProductMoved:       true,
+	ProductsOverdue:    true,
+
+	ProductReturnedToSender: true,
+}
+
+// Streamed сообщает, попадает ли событие этого типа в живую ленту.
+func Streamed(eventType string) bool {
+	return streamedEventTypes[eventType]
+}
+
+// Hub раздаёт события подписчикам ленты одного ПВЗ внутри процесса.
+// Медленный подписчик не тормозит остальных: если его буфер заполнен,
+// событие для него теряется.
+type Hub struct {
+	mu     sync.Mutex
+	subs   map[int64]map[*Subscription]struct{}
+	buffer int
+	closed bool
+
+	// OnDrop вызывается, когда событие не поместилось в буфер подписчика.
+	OnDrop func(event Event)
+}
+
+func NewHub(buffer int) *Hub {
+	return &Hub{subs: make(map[int64]map[*Subscription]struct{}), buffer: buffer}
+}
+
+type Subscription struct {
+	hub    *Hub
+	pvzID  int64
+	events chan Event
+	once   sync.Once
+}
+
+// Events закрывается после Close подписки или остановки хаба.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.remove(s)
+}
+
+// Subscribe подписывает на события ПВЗ. После остановки хаба подписка
+// возвращается уже закрытой.
+func (h *Hub) Subscribe(pvzID int64) *Subscription {
+	sub := &Subscription{hub: h, pvzID: pvzID, events: make(chan Event, h.buffer)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		sub.close()
+		return sub
+	}
+
+	if h.subs[pvzID] == nil {
+		h.subs[pvzID] = make(map[*Subscription]struct{})
+	}
+	h.subs[pvzID][sub] = struct{}{}
+	return sub
+}
+
+// Dispatch отдаёт событие подписчикам ПВЗ из его payload. События без
+// pvzId и не относящиеся к ленте пропускаются.
+func (h *Hub) Dispatch(event Event) {
+	if !Streamed(event.Type) {
+		return
+	}
+
+	var target struct {
+		PVZID int64 `json:"pvzId"`
+	}
+	if err := json.Unmarshal(event.Payload, &target); err != nil || target.PVZID == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs[target.PVZID] {
+		select {
+		case sub.events <- event:
+		default:
+			if h.OnDrop != nil {
+				h.OnDrop(event)
+			}
+		}
+	}
+}
+
+// Subscribers возвращает число активных подписок.
+func (h *Hub) Subscribers() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	n := 0
+	for _, subs := range h.subs {
+		n += len(subs)
+	}
+	return n
+}
+
+// Close закрывает все подписки, чтобы открытые потоки завершились при
+// остановке сервера.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for _, subs := range h.subs {
+		for sub := range subs {
+			h.remove(sub)
+		}
+	}
+}
+
+// remove вызывается под h.mu.
+func (h *Hub) remove(sub *Subscription) {
+	subs := h.subs[sub.pvzID]
+	if _, ok := subs[sub]; ok {
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(h.subs, sub.pvzID)
+		}
+	}
+	sub.close()
+}
+
+func (s *Subscription) close() {
+	s.once.Do(func() { close(s.events) })
+}
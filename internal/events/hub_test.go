@@ -0,0 +1,84 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func event(t *testing.T, eventType string, pvzID int64) Event {
+	t.Helper()
+
+	payload, err := json.Marshal(ReceptionPayload{ID: "r1", PVZID: pvzID, Status: "in_progress"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Event{ID: eventType, Type: eventType, Payload: payload}
+}
+
+func TestHubDispatchesByPVZ(t *testing.T) {
+	hub := NewHub(4)
+	first := hub.Subscribe(1)
+	second := hub.Subscribe(2)
+	defer first.Close()
+	defer second.Close()
+
+	hub.Dispatch(event(t, ReceptionCreated, 1))
+	hub.Dispatch(event(t, PVZCreated, 1))
+
+	select {
+	case got := <-first.Events():
+		if got.Type != ReceptionCreated {
+			t.Fatalf("type = %q, want %q", got.Type, ReceptionCreated)
+		}
+	default:
+		t.Fatal("subscriber of PVZ 1 got no event")
+	}
+	if n := len(first.Events()); n != 0 {
+		t.Fatalf("pvz.created must not be streamed, got %d extra events", n)
+	}
+	if n := len(second.Events()); n != 0 {
+		t.Fatalf("subscriber of PVZ 2 got %d events", n)
+	}
+}
+
+func TestHubDropsForSlowSubscriber(t *testing.T) {
+	hub := NewHub(1)
+	dropped := 0
+	hub.OnDrop = func(Event) { dropped++ }
+
+	slow := hub.Subscribe(1)
+	defer slow.Close()
+
+	hub.Dispatch(event(t, ProductAdded, 1))
+	hub.Dispatch(event(t, ProductDeleted, 1))
+
+	if dropped != 1 {
+		t.Fatalf("dropped = %d, want 1", dropped)
+	}
+	if got := <-slow.Events(); got.Type != ProductAdded {
+		t.Fatalf("type = %q, want %q", got.Type, ProductAdded)
+	}
+}
+
+func TestHubClose(t *testing.T) {
+	hub := NewHub(1)
+	sub := hub.Subscribe(1)
+	sub.Close()
+	sub.Close()
+
+	if _, ok := <-sub.Events(); ok {
+		t.Fatal("closed subscription must have a closed channel")
+	}
+	if n := hub.Subscribers(); n != 0 {
+		t.Fatalf("subscribers = %d, want 0", n)
+	}
+
+	open := hub.Subscribe(2)
+	hub.Close()
+	if _, ok := <-open.Events(); ok {
+		t.Fatal("hub close must close subscriptions")
+	}
+	if _, ok := <-hub.Subscribe(3).Events(); ok {
+		t.Fatal("subscription to a closed hub must be closed")
+	}
+}
@@ -0,0 +1,52 @@
+package events
+
+import (
+	"PVZ/pkg/database"
+	"PVZ/pkg/metrics"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// NotifyChannel — канал Postgres, через который реплики обмениваются
+// событиями живой ленты.
+const NotifyChannel = "pvz_events"
+
+// maxNotifyPayload — предел Postgres на размер payload в NOTIFY.
+const maxNotifyPayload = 8000
+
+// NotifyPublisher рассылает события ленты всем репликам через pg_notify.
+// Внутри транзакции релея уведомление уходит только после коммита.
+type NotifyPublisher struct {
+	db      boil.ContextExecutor
+	channel string
+}
+
+func NewNotifyPublisher(db boil.ContextExecutor, channel string) *NotifyPublisher {
+	return &NotifyPublisher{db: db, channel: channel}
+}
+
+func (p *NotifyPublisher) Publish(ctx context.Context, event Event) error {
+	if !Streamed(event.Type) {
+		return nil
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	// Повтор не поможет: лента живая, поэтому слишком большое событие просто пропускается.
+	if len(data) >= maxNotifyPayload {
+		slog.WarnContext(ctx, "Event too large for notify, skipped", "id", event.ID, "type", event.Type, "size", len(data))
+		metrics.EventNotifyDropped.WithLabelValues(event.Type).Inc()
+		return nil
+	}
+
+	if _, err := database.Executor(ctx, p.db).ExecContext(ctx, "SELECT pg_notify($1, $2)", p.channel, string(data)); err != nil {
+		return fmt.Errorf("failed to notify: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package events
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOverdueEventFitsNotifyPayload(t *testing.T) {
+	ids := make([]string, MaxOverdueProducts)
+	for i := range ids {
+		ids[i] = "00000000-0000-0000-0000-000000000000"
+	}
+	payload, err := json.Marshal(OverduePayload{PVZID: 1 << 62, ProductIDs: ids})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(Event{
+		ID:            strings.Repeat("0", 36),
+		Type:          ProductsOverdue,
+		AggregateType: AggregatePVZ,
+		AggregateID:   "4611686018427387904",
+		Payload:       payload,
+		OccurredAt:    time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) >= maxNotifyPayload {
+		t.Fatalf("overdue event is %d bytes, NOTIFY limit is %d", len(data), maxNotifyPayload)
+	}
+}
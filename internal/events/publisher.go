@@ -0,0 +1,103 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+)
+
+// LogPublisher пишет события в лог приложения. Используется, пока внешнего
+// брокера нет.
+type LogPublisher struct{}
+
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, event Event) error {
+	slog.InfoContext(ctx, "Event published",
+		"id", event.ID,
+		"type", event.Type,
+		"aggregateType", event.AggregateType,
+		"aggregateId", event.AggregateID,
+		"payload", string(event.Payload),
+	)
+	return nil
+}
+
+// WriterPublisher пишет события построчно в JSON (например, в файл).
+type WriterPublisher struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{w: w}
+}
+
+func (p *WriterPublisher) Publish(ctx context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+	return nil
+}
+
+// MemoryPublisher копит события в памяти, для тестов. Если задан Fail,
+// событие, для которого он вернул ошибку, не сохраняется.
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []Event
+
+	Fail func(event Event) error
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, event Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.Fail != nil {
+		if err := p.Fail(event); err != nil {
+			return err
+		}
+	}
+
+	p.events = append(p.events, event)
+	return nil
+}
+
+// Events возвращает копию опубликованных событий в порядке публикации.
+func (p *MemoryPublisher) Events() []Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]Event(nil), p.events...)
+}
+
+// MultiPublisher отдаёт событие всем издателям по очереди. Ошибка любого
+// прерывает публикацию, и релей повторит событие для всех, поэтому издатели
+// должны переносить повторы.
+type MultiPublisher []EventPublisher
+
+func (m MultiPublisher) Publish(ctx context.Context, event Event) error {
+	for _, p := range m {
+		if err := p.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
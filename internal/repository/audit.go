@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"PVZ/pkg/uuid"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+type AuditRepo struct {
+	db boil.ContextExecutor
+}
+
+func NewAuditRepo(db boil.ContextExecutor) *AuditRepo {
+	return &AuditRepo{db: db}
+}
+
+func (r *AuditRepo) RecordProductAction(ctx context.Context, entry *models.ProductAuditLog) error {
+	id, err := uuid.GenerateUUID7()
+	if err != nil {
+		return errors.New("Failed to generate UUIDv7")
+	}
+
+	entry.ID = id
+	entry.CreatedAt = time.Now()
+
+	if err := entry.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		slog.Error("Failed to record product audit", "productID", entry.ProductID, "action", entry.Action, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *AuditRepo) RecordStatusChange(ctx context.Context, entry *models.StatusHistory) error {
+	id, err := uuid.GenerateUUID7()
+	if err != nil {
+		return errors.New("Failed to generate UUIDv7")
+	}
+
+	entry.ID = id
+	entry.ChangedAt = time.Now()
+
+	if err := entry.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		slog.Error("Failed to record status change", "receptionID", entry.ReceptionID, "to", entry.ToStatus, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *AuditRepo) ListStatusHistory(ctx context.Context, receptionID string) (models.StatusHistorySlice, error) {
+	history, err := models.StatusHistories(
+		models.StatusHistoryWhere.ReceptionID.EQ(receptionID),
+		qm.OrderBy(models.StatusHistoryColumns.ChangedAt+", "+models.StatusHistoryColumns.ID),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list status history", "receptionID", receptionID, "err", err)
+		return nil, err
+	}
+
+	return history, nil
+}
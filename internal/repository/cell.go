@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"PVZ/pkg/uuid"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+type CellRepo struct {
+	db boil.ContextExecutor
+}
+
+func NewCellRepo(db boil.ContextExecutor) *CellRepo {
+	return &CellRepo{db: db}
+}
+
+func (r *CellRepo) Create(ctx context.Context, cell *models.PVZCell) error {
+	id, err := uuid.GenerateUUID7()
+	if err != nil {
+		return errors.New("Failed to generate UUIDv7")
+	}
+
+	cell.ID = id
+	cell.CreatedAt = time.Now()
+
+	if err := cell.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		if isUniqueViolation(err) {
+			return errs.Conflict("cell with this code already exists")
+		}
+		slog.Error("Failed to insert cell", "pvzID", cell.PVZID, "code", cell.Code, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *CellRepo) ListByPVZ(ctx context.Context, pvzID int64) (models.PVZCellSlice, error) {
+	cells, err := models.PVZCells(
+		models.PVZCellWhere.PVZID.EQ(pvzID),
+		qm.OrderBy(models.PVZCellColumns.Code),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list cells", "pvzID", pvzID, "err", err)
+		return nil, err
+	}
+
+	return cells, nil
+}
+
+func (r *CellRepo) GetByCode(ctx context.Context, pvzID int64, code string) (*models.PVZCell, error) {
+	cell, err := models.PVZCells(
+		models.PVZCellWhere.PVZID.EQ(pvzID),
+		models.PVZCellWhere.Code.EQ(code),
+	).One(ctx, database.Executor(ctx, r.db))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get cell", "pvzID", pvzID, "code", code, "err", err)
+		return nil, err
+	}
+
+	return cell, nil
+}
+
+func (r *CellRepo) Delete(ctx context.Context, id string) error {
+	_, err := models.PVZCells(models.PVZCellWhere.ID.EQ(id)).DeleteAll(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to delete cell", "id", id, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+const countStoredByCellQuery = `
+SELECT p.cell_id, count(*) AS count
+FROM products p
+JOIN receptions r ON r.id = p.reception_id
+WHERE r.pvz_id = $1 AND r.status <> $2 AND NOT (r.kind = $3 AND r.status = $4)
+  AND p.status IN ($5, $6) AND p.cell_id IS NOT NULL
+GROUP BY p.cell_id`
+
+// CountStoredByCell считает товары, которые сейчас лежат в каждой непустой
+// ячейке ПВЗ. Учитываются те же товары, что и во вместимости ПВЗ.
+func (r *CellRepo) CountStoredByCell(ctx context.Context, pvzID int64) (map[string]int, error) {
+	var rows []struct {
+		CellID string `boil:"cell_id"`
+		Count  int    `boil:"count"`
+	}
+	err := queries.Raw(countStoredByCellQuery,
+		pvzID, constants.ReceptionCancelled, constants.ReceptionKindReturn, constants.ReceptionClosed,
+		constants.ProductReceived, constants.ProductReadyForPickup,
+	).Bind(ctx, database.Executor(ctx, r.db), &rows)
+	if err != nil {
+		slog.Error("Failed to count products by cell", "pvzID", pvzID, "err", err)
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.CellID] = row.Count
+	}
+	return counts, nil
+}
+
+// UpdateProductCell перекладывает товар в другую ячейку.
+func (r *CellRepo) UpdateProductCell(ctx context.Context, p *models.Product) error {
+	_, err := p.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(models.ProductColumns.CellID))
+	if err != nil {
+		slog.Error("Failed to update product cell", "id", p.ID, "cellID", p.CellID.String, "err", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+type CityRepo struct {
+	db boil.ContextExecutor
+}
+
+func NewCityRepo(db boil.ContextExecutor) *CityRepo {
+	return &CityRepo{db: db}
+}
+
+func (r *CityRepo) List(ctx context.Context) (models.CitySlice, error) {
+	cities, err := models.Cities(
+		qm.OrderBy(models.CityColumns.Name),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list cities", "err", err)
+		return nil, err
+	}
+
+	return cities, nil
+}
+
+func (r *CityRepo) GetByID(ctx context.Context, id string) (*models.City, error) {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, errs.Validation("invalid city ID format")
+	}
+
+	city, err := models.FindCity(ctx, database.Executor(ctx, r.db), idInt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get city", "id", id, "err", err)
+		return nil, err
+	}
+
+	return city, nil
+}
+
+func (r *CityRepo) GetByName(ctx context.Context, name string) (*models.City, error) {
+	city, err := models.Cities(
+		models.CityWhere.Name.EQ(name),
+	).One(ctx, database.Executor(ctx, r.db))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get city", "name", name, "err", err)
+		return nil, err
+	}
+
+	return city, nil
+}
+
+func (r *CityRepo) Create(ctx context.Context, city *models.City) error {
+	city.CreatedAt = time.Now()
+
+	if err := city.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		if isUniqueViolation(err) {
+			return errs.Conflict("city already exists")
+		}
+		slog.Error("Failed to create city", "name", city.Name, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// Update переименовывает город вместе с его ПВЗ (ON UPDATE CASCADE).
+func (r *CityRepo) Update(ctx context.Context, city *models.City) error {
+	updated, err := city.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(
+		models.CityColumns.Name,
+		models.CityColumns.Region,
+		models.CityColumns.Timezone,
+		models.CityColumns.Enabled,
+	))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return errs.Conflict("city already exists")
+		}
+		slog.Error("Failed to update city", "id", city.ID, "err", err)
+		return err
+	}
+	if updated == 0 {
+		return errs.NotFound("city not found")
+	}
+
+	return nil
+}
+
+func (r *CityRepo) Delete(ctx context.Context, id string) error {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return errs.Validation("invalid city ID format")
+	}
+
+	deleted, err := models.Cities(
+		models.CityWhere.ID.EQ(idInt),
+	).DeleteAll(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.Conflict("city has PVZs, disable it instead")
+		}
+		slog.Error("Failed to delete city", "id", id, "err", err)
+		return err
+	}
+	if deleted == 0 {
+		return errs.NotFound("city not found")
+	}
+
+	return nil
+}
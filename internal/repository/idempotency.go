@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+)
+
+type IdempotencyRepo struct {
+	db boil.ContextExecutor
+}
+
+func NewIdempotencyRepo(db boil.ContextExecutor) *IdempotencyRepo {
+	return &IdempotencyRepo{db: db}
+}
+
+// acquireKeyQuery занимает ключ, если его нет, он истёк или запрос, который
+// его занял, так и не завершился до locked_until. Живая запись не меняется,
+// и запрос не возвращает строк.
+const acquireKeyQuery = `
+INSERT INTO idempotency_keys (user_id, key, request_hash, created_at, locked_until, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (user_id, key) DO UPDATE SET
+    request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    content_type = NULL,
+    response_body = NULL,
+    created_at = EXCLUDED.created_at,
+    locked_until = EXCLUDED.locked_until,
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+   OR (idempotency_keys.status_code IS NULL AND idempotency_keys.locked_until <= EXCLUDED.created_at)
+RETURNING *`
+
+// Acquire занимает ключ за запросом до lockedUntil и возвращает true. Если
+// ключ уже занят, возвращается существующая запись: с ответом, если запрос
+// завершён, или без него, если он ещё выполняется.
+func (r *IdempotencyRepo) Acquire(ctx context.Context, userID, key, requestHash string, now, lockedUntil, expiresAt time.Time) (*models.IdempotencyKey, bool, error) {
+	exec := database.Executor(ctx, r.db)
+
+	// Вторая попытка нужна, если занявший ключ запрос успел его освободить
+	// между INSERT и чтением.
+	for range 2 {
+		var rec models.IdempotencyKey
+		err := queries.Raw(acquireKeyQuery, userID, key, requestHash, now, lockedUntil, expiresAt).Bind(ctx, exec, &rec)
+		if err == nil {
+			return &rec, true, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Failed to acquire idempotency key", "userID", userID, "key", key, "err", err)
+			return nil, false, err
+		}
+
+		existing, err := models.FindIdempotencyKey(ctx, exec, userID, key)
+		if err == nil {
+			return existing, false, nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Failed to get idempotency key", "userID", userID, "key", key, "err", err)
+			return nil, false, err
+		}
+	}
+
+	return nil, false, errors.New("idempotency key was released concurrently")
+}
+
+// Complete сохраняет ответ, который будет повторён на запросы с тем же ключом.
+func (r *IdempotencyRepo) Complete(ctx context.Context, rec *models.IdempotencyKey) error {
+	_, err := rec.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(
+		models.IdempotencyKeyColumns.StatusCode,
+		models.IdempotencyKeyColumns.ContentType,
+		models.IdempotencyKeyColumns.ResponseBody,
+	))
+	if err != nil {
+		slog.Error("Failed to save idempotent response", "userID", rec.UserID, "key", rec.Key, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// Release освобождает ключ, чтобы повтор запроса выполнился заново.
+func (r *IdempotencyRepo) Release(ctx context.Context, rec *models.IdempotencyKey) error {
+	_, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.UserID.EQ(rec.UserID),
+		models.IdempotencyKeyWhere.Key.EQ(rec.Key),
+		models.IdempotencyKeyWhere.RequestHash.EQ(rec.RequestHash),
+		models.IdempotencyKeyWhere.StatusCode.IsNull(),
+	).DeleteAll(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to release idempotency key", "userID", rec.UserID, "key", rec.Key, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepo) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	n, err := models.IdempotencyKeys(
+		models.IdempotencyKeyWhere.ExpiresAt.LTE(now),
+	).DeleteAll(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to delete expired idempotency keys", "err", err)
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"PVZ/pkg/uuid"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+type OutboxRepo struct {
+	db boil.ContextExecutor
+}
+
+func NewOutboxRepo(db boil.ContextExecutor) *OutboxRepo {
+	return &OutboxRepo{db: db}
+}
+
+// Add пишет событие в outbox; вызывается в транзакции, меняющей данные.
+func (r *OutboxRepo) Add(ctx context.Context, event *models.Outbox) error {
+	id, err := uuid.GenerateUUID7()
+	if err != nil {
+		return errors.New("Failed to generate UUIDv7")
+	}
+
+	now := time.Now()
+	event.ID = id
+	event.CreatedAt = now
+	event.NextAttemptAt = now
+
+	if err := event.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		slog.Error("Failed to add outbox event", "eventType", event.EventType, "aggregateID", event.AggregateID, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// FetchPending блокирует неопубликованные события, время повтора которых
+// наступило. SKIP LOCKED позволяет нескольким релеям разбирать очередь
+// параллельно, не получая одни и те же события.
+func (r *OutboxRepo) FetchPending(ctx context.Context, now time.Time, maxAttempts, limit int) (models.OutboxSlice, error) {
+	events, err := models.Outboxes(
+		models.OutboxWhere.PublishedAt.IsNull(),
+		models.OutboxWhere.NextAttemptAt.LTE(now),
+		models.OutboxWhere.Attempts.LT(maxAttempts),
+		qm.OrderBy(models.OutboxColumns.CreatedAt+", "+models.OutboxColumns.ID),
+		qm.Limit(limit),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to fetch outbox events", "err", err)
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *OutboxRepo) MarkPublished(ctx context.Context, event *models.Outbox) error {
+	event.PublishedAt = null.TimeFrom(time.Now())
+	event.LastError = null.String{}
+
+	_, err := event.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(
+		models.OutboxColumns.PublishedAt,
+		models.OutboxColumns.LastError,
+	))
+	if err != nil {
+		slog.Error("Failed to mark outbox event published", "id", event.ID, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// MarkFailed сохраняет число попыток, текст ошибки и время следующей попытки.
+func (r *OutboxRepo) MarkFailed(ctx context.Context, event *models.Outbox) error {
+	_, err := event.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(
+		models.OutboxColumns.Attempts,
+		models.OutboxColumns.NextAttemptAt,
+		models.OutboxColumns.LastError,
+	))
+	if err != nil {
+		slog.Error("Failed to mark outbox event failed", "id", event.ID, "err", err)
+		return err
+	}
+
+	return nil
+}
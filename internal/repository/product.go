@@ -1,16 +1,20 @@
 package repository
 
 import (
+	"PVZ/internal/constants"
 	"PVZ/models"
+	"PVZ/pkg/database"
 	"PVZ/pkg/uuid"
 	"context"
-	"encoding/json"
 	"errors"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
-	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/strmangle"
 )
 
 type ProductRepo struct {
@@ -21,45 +25,106 @@ func NewProductRepo(db boil.ContextExecutor) *ProductRepo {
 	return &ProductRepo{db: db}
 }
 
-func (r *ProductRepo) AddProduct(ctx context.Context, receptionID string, productType string) (*models.Product, error) {
+// AddProduct сохраняет товар; ID и время добавления проставляются здесь.
+func (r *ProductRepo) AddProduct(ctx context.Context, product *models.Product) error {
 	id, err := uuid.GenerateUUID7()
 	if err != nil {
-		return nil, errors.New("Failed to generate UUIDv7")
+		return errors.New("Failed to generate UUIDv7")
 	}
 
-	product := &models.Product{
-		ID:          id,
-		ReceptionID: receptionID,
-		Type:        productType,
-		AddedAt:     time.Now(),
-	}
+	product.ID = id
+	product.AddedAt = time.Now()
 
-	if err := product.Insert(ctx, r.db, boil.Infer()); err != nil {
-		slog.Error("Failed to insert product: %v", err)
-		return nil, err
+	if err := product.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		slog.Error("Failed to insert product", "err", err)
+		return err
 	}
 
-	rec, err := models.Receptions(models.ReceptionWhere.ID.EQ(receptionID)).One(ctx, r.db)
+	return nil
+}
+
+// LockBarcode сериализует проверку дубликатов одного штрихкода до конца
+// транзакции: разные ПВЗ блокируют разные приёмки, поэтому блокировки
+// строки приёмки здесь недостаточно.
+func (r *ProductRepo) LockBarcode(ctx context.Context, barcode string) error {
+	_, err := queries.Raw(
+		"SELECT pg_advisory_xact_lock(hashtextextended($1, 0))", barcode,
+	).ExecContext(ctx, database.Executor(ctx, r.db))
 	if err != nil {
-		return nil, errors.New("Failed to get reception")
+		slog.Error("Failed to lock barcode", "barcode", barcode, "err", err)
+		return err
 	}
+	return nil
+}
 
-	var productIds []string
-	if err := json.Unmarshal([]byte(rec.ProductIds), &productIds); err != nil {
-		return nil, errors.New("Failed to unmarshal product IDs")
+// AddProducts вставляет товары одним многострочным INSERT.
+func (r *ProductRepo) AddProducts(ctx context.Context, products []*models.Product) error {
+	if len(products) == 0 {
+		return nil
 	}
 
-	productIds = append(productIds, product.ID)
-	updatedJSON, err := json.Marshal(productIds)
-	if err != nil {
-		slog.Error("Failed to marshal product IDs: %v", err)
-		return nil, err
+	cols := []string{
+		models.ProductColumns.ID,
+		models.ProductColumns.ReceptionID,
+		models.ProductColumns.Type,
+		models.ProductColumns.AddedAt,
+		models.ProductColumns.CreatedBy,
+		models.ProductColumns.Barcode,
+		models.ProductColumns.Sku,
+		models.ProductColumns.OrderNumber,
+		models.ProductColumns.WeightGrams,
+		models.ProductColumns.LengthMM,
+		models.ProductColumns.WidthMM,
+		models.ProductColumns.HeightMM,
+		models.ProductColumns.Status,
+		models.ProductColumns.CellID,
+	}
+
+	// Товары одного батча получают одно время добавления; порядок внутри
+	// батча сохраняется через UUIDv7.
+	now := time.Now()
+	args := make([]interface{}, 0, len(products)*len(cols))
+	for _, p := range products {
+		id, err := uuid.GenerateUUID7()
+		if err != nil {
+			return errors.New("Failed to generate UUIDv7")
+		}
+		p.ID = id
+		p.AddedAt = now
+
+		args = append(args,
+			p.ID, p.ReceptionID, p.Type, p.AddedAt, p.CreatedBy,
+			p.Barcode, p.Sku, p.OrderNumber,
+			p.WeightGrams, p.LengthMM, p.WidthMM, p.HeightMM,
+			p.Status, p.CellID,
+		)
+	}
+
+	query := "INSERT INTO " + models.TableNames.Products + " (" + strings.Join(cols, ", ") + ") VALUES " +
+		strmangle.Placeholders(true, len(args), 1, len(cols))
+	if _, err := queries.Raw(query, args...).ExecContext(ctx, database.Executor(ctx, r.db)); err != nil {
+		slog.Error("Failed to insert products", "count", len(products), "err", err)
+		return err
 	}
-	rec.ProductIds = types.JSON(updatedJSON)
 
-	if _, err := rec.Update(ctx, r.db, boil.Whitelist("product_ids")); err != nil {
-		return nil, errors.New("Failed to update reception product_ids")
+	return nil
+}
+
+// FindOpenByBarcodes ищет товары с такими штрихкодами в незакрытых приёмках.
+func (r *ProductRepo) FindOpenByBarcodes(ctx context.Context, barcodes []string) (models.ProductSlice, error) {
+	if len(barcodes) == 0 {
+		return nil, nil
+	}
+
+	products, err := models.Products(
+		qm.InnerJoin(models.TableNames.Receptions+" r ON r."+models.ReceptionColumns.ID+" = "+models.ProductTableColumns.ReceptionID),
+		models.ProductWhere.Barcode.IN(barcodes),
+		qm.Where("r."+models.ReceptionColumns.Status+" = ?", constants.ReceptionInProgress),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to find products by barcode", "count", len(barcodes), "err", err)
+		return nil, err
 	}
 
-	return product, nil
+	return products, nil
 }
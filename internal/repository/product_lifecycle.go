@@ -0,0 +1,316 @@
+package repository
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"PVZ/pkg/pagination"
+	"PVZ/pkg/uuid"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// joinReception подключает приёмку товара как r, чтобы фильтровать по ПВЗ.
+var joinReception = qm.InnerJoin(models.TableNames.Receptions + " r ON r." + models.ReceptionColumns.ID + " = " + models.ProductTableColumns.ReceptionID)
+
+// storedReception оставляет товары приёмок, которые занимают место в ПВЗ:
+// отменённая приёмка ничего не хранит, а закрытая приёмка возвратов передана
+// отправителю вместе с товарами. Требует joinReception.
+var storedReception = qm.Where(
+	"r."+models.ReceptionColumns.Status+" <> ? AND NOT (r."+models.ReceptionColumns.Kind+" = ? AND r."+models.ReceptionColumns.Status+" = ?)",
+	constants.ReceptionCancelled, constants.ReceptionKindReturn, constants.ReceptionClosed,
+)
+
+func (r *ProductRepo) ListByReception(ctx context.Context, receptionID string) (models.ProductSlice, error) {
+	products, err := models.Products(
+		models.ProductWhere.ReceptionID.EQ(receptionID),
+		qm.OrderBy(models.ProductColumns.AddedAt+", "+models.ProductColumns.ID),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list reception products", "receptionID", receptionID, "err", err)
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// UpdateLifecycle сохраняет статус товара и связанные с ним поля выдачи.
+func (r *ProductRepo) UpdateLifecycle(ctx context.Context, p *models.Product) error {
+	_, err := p.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(
+		models.ProductColumns.Status,
+		models.ProductColumns.PickupCode,
+		models.ProductColumns.StatusChangedAt,
+		models.ProductColumns.IssuedAt,
+		models.ProductColumns.IssuedBy,
+		models.ProductColumns.StorageExpiresAt,
+		models.ProductColumns.OverdueReportedAt,
+	))
+	if err != nil {
+		slog.Error("Failed to update product status", "id", p.ID, "status", p.Status, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// FindPickupCode возвращает код выдачи, уже назначенный заказу в ПВЗ, или
+// пустую строку.
+func (r *ProductRepo) FindPickupCode(ctx context.Context, pvzID int64, orderNumber string) (string, error) {
+	p, err := models.Products(
+		joinReception,
+		qm.Where("r."+models.ReceptionColumns.PVZID+" = ?", pvzID),
+		models.ProductWhere.OrderNumber.EQ(null.StringFrom(orderNumber)),
+		models.ProductWhere.Status.EQ(constants.ProductReadyForPickup),
+		models.ProductWhere.PickupCode.IsNotNull(),
+	).One(ctx, database.Executor(ctx, r.db))
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		slog.Error("Failed to find pickup code", "pvzID", pvzID, "orderNumber", orderNumber, "err", err)
+		return "", err
+	}
+
+	return p.PickupCode.String, nil
+}
+
+func (r *ProductRepo) IsPickupCodeInUse(ctx context.Context, pvzID int64, code string) (bool, error) {
+	exists, err := models.Products(
+		joinReception,
+		qm.Where("r."+models.ReceptionColumns.PVZID+" = ?", pvzID),
+		models.ProductWhere.PickupCode.EQ(null.StringFrom(code)),
+		models.ProductWhere.Status.EQ(constants.ProductReadyForPickup),
+	).Exists(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to check pickup code", "pvzID", pvzID, "err", err)
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// ListForPickupForUpdate блокирует готовые к выдаче товары ПВЗ с этим кодом,
+// чтобы одну посылку не выдали дважды.
+func (r *ProductRepo) ListForPickupForUpdate(ctx context.Context, pvzID int64, code string) (models.ProductSlice, error) {
+	products, err := models.Products(
+		joinReception,
+		qm.Where("r."+models.ReceptionColumns.PVZID+" = ?", pvzID),
+		models.ProductWhere.PickupCode.EQ(null.StringFrom(code)),
+		models.ProductWhere.Status.EQ(constants.ProductReadyForPickup),
+		qm.OrderBy(models.ProductTableColumns.AddedAt+", "+models.ProductTableColumns.ID),
+		qm.For("UPDATE OF "+models.TableNames.Products),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list products for pickup", "pvzID", pvzID, "err", err)
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// ListReadyForUpdate блокирует ожидающие выдачи посылки ПВЗ: с указанными
+// ID или, если ids пуст, срок хранения которых истёк к expiredBefore.
+func (r *ProductRepo) ListReadyForUpdate(ctx context.Context, pvzID int64, ids []string, expiredBefore *time.Time, limit int) (models.ProductSlice, error) {
+	mods := []qm.QueryMod{
+		joinReception,
+		qm.Where("r."+models.ReceptionColumns.PVZID+" = ?", pvzID),
+		models.ProductWhere.Status.EQ(constants.ProductReadyForPickup),
+	}
+	if len(ids) > 0 {
+		mods = append(mods, models.ProductWhere.ID.IN(ids))
+	}
+	if expiredBefore != nil {
+		mods = append(mods, models.ProductWhere.StorageExpiresAt.LT(null.TimeFrom(*expiredBefore)))
+	}
+
+	mods = append(mods,
+		qm.OrderBy(models.ProductTableColumns.StorageExpiresAt+", "+models.ProductTableColumns.ID),
+		qm.Limit(limit),
+		qm.For("UPDATE OF "+models.TableNames.Products),
+	)
+
+	products, err := models.Products(mods...).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list products ready for pickup", "pvzID", pvzID, "err", err)
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// ListStored отдаёт товары, которые физически находятся в ПВЗ: принятые и
+// ожидающие выдачи, кроме товаров отменённых приёмок и закрытых приёмок
+// возвратов. Пустой status — оба
+// статуса, непустой cell — только товары из ячейки с этим кодом. Сортировка
+// от новых к старым, after — курсор предыдущей страницы.
+func (r *ProductRepo) ListStored(ctx context.Context, pvzID int64, status, pickupCode, cell string, after *pagination.Cursor, limit int) (models.ProductSlice, error) {
+	mods := []qm.QueryMod{
+		joinReception,
+		qm.Where("r."+models.ReceptionColumns.PVZID+" = ?", pvzID),
+		storedReception,
+	}
+	if status != "" {
+		mods = append(mods, models.ProductWhere.Status.EQ(status))
+	} else {
+		mods = append(mods, models.ProductWhere.Status.IN([]string{constants.ProductReceived, constants.ProductReadyForPickup}))
+	}
+	if pickupCode != "" {
+		mods = append(mods, models.ProductWhere.PickupCode.EQ(null.StringFrom(pickupCode)))
+	}
+	if cell != "" {
+		mods = append(mods, qm.Where(
+			models.ProductTableColumns.CellID+" IN (SELECT "+models.PVZCellColumns.ID+" FROM "+models.TableNames.PVZCells+
+				" WHERE "+models.PVZCellColumns.PVZID+" = ? AND "+models.PVZCellColumns.Code+" = ?)",
+			pvzID, cell,
+		))
+	}
+	if after != nil {
+		mods = append(mods, qm.Where(
+			"("+models.ProductTableColumns.AddedAt+", "+models.ProductTableColumns.ID+") < (?, ?)",
+			after.Time, after.ID,
+		))
+	}
+
+	mods = append(mods,
+		qm.Load(models.ProductRels.Cell),
+		qm.OrderBy(models.ProductTableColumns.AddedAt+" DESC, "+models.ProductTableColumns.ID+" DESC"),
+		qm.Limit(limit),
+	)
+
+	products, err := models.Products(mods...).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list stored products", "pvzID", pvzID, "err", err)
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (r *ProductRepo) GetByIDForUpdate(ctx context.Context, id string) (*models.Product, error) {
+	if !uuid.IsValid(id) {
+		return nil, errs.Validation("invalid product ID format")
+	}
+
+	p, err := models.Products(
+		models.ProductWhere.ID.EQ(id),
+		qm.For("UPDATE"),
+	).One(ctx, database.Executor(ctx, r.db))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to lock product", "id", id, "err", err)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// FindIssuedByBarcodeForUpdate блокирует последний выданный в ПВЗ товар с
+// этим штрихкодом — исходный товар для возврата, если покупатель не назвал его ID.
+func (r *ProductRepo) FindIssuedByBarcodeForUpdate(ctx context.Context, pvzID int64, barcode string) (*models.Product, error) {
+	p, err := models.Products(
+		joinReception,
+		qm.Where("r."+models.ReceptionColumns.PVZID+" = ?", pvzID),
+		models.ProductWhere.Barcode.EQ(null.StringFrom(barcode)),
+		models.ProductWhere.Status.EQ(constants.ProductIssued),
+		qm.OrderBy(models.ProductTableColumns.IssuedAt+" DESC"),
+		qm.Limit(1),
+		qm.For("UPDATE OF "+models.TableNames.Products),
+	).One(ctx, database.Executor(ctx, r.db))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to find issued product", "pvzID", pvzID, "barcode", barcode, "err", err)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// ListOverdue отдаёт посылки ПВЗ, срок хранения которых истёк к now: сначала
+// самые просроченные. after — курсор по storage_expires_at и ID.
+func (r *ProductRepo) ListOverdue(ctx context.Context, pvzID int64, now time.Time, after *pagination.Cursor, limit int) (models.ProductSlice, error) {
+	mods := []qm.QueryMod{
+		joinReception,
+		qm.Where("r."+models.ReceptionColumns.PVZID+" = ?", pvzID),
+		models.ProductWhere.Status.EQ(constants.ProductReadyForPickup),
+		models.ProductWhere.StorageExpiresAt.LT(null.TimeFrom(now)),
+	}
+	if after != nil {
+		mods = append(mods, qm.Where(
+			"("+models.ProductTableColumns.StorageExpiresAt+", "+models.ProductTableColumns.ID+") > (?, ?)",
+			after.Time, after.ID,
+		))
+	}
+
+	mods = append(mods,
+		qm.OrderBy(models.ProductTableColumns.StorageExpiresAt+", "+models.ProductTableColumns.ID),
+		qm.Limit(limit),
+	)
+
+	products, err := models.Products(mods...).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list overdue products", "pvzID", pvzID, "err", err)
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// ListUnreportedOverdueForUpdate блокирует просроченные посылки, о которых ещё
+// не сообщали, вместе с их приёмками. Строки, занятые другой репликой,
+// пропускаются.
+func (r *ProductRepo) ListUnreportedOverdueForUpdate(ctx context.Context, now time.Time, limit int) (models.ProductSlice, error) {
+	products, err := models.Products(
+		models.ProductWhere.Status.EQ(constants.ProductReadyForPickup),
+		models.ProductWhere.StorageExpiresAt.LT(null.TimeFrom(now)),
+		models.ProductWhere.OverdueReportedAt.IsNull(),
+		qm.Load(models.ProductRels.Reception),
+		qm.OrderBy(models.ProductColumns.StorageExpiresAt+", "+models.ProductColumns.ID),
+		qm.Limit(limit),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list unreported overdue products", "err", err)
+		return nil, err
+	}
+
+	return products, nil
+}
+
+const countOverdueQuery = `
+SELECT r.pvz_id, count(*) AS count
+FROM products p
+JOIN receptions r ON r.id = p.reception_id
+WHERE p.status = $1 AND p.storage_expires_at < $2
+GROUP BY r.pvz_id`
+
+// CountOverdueByPVZ считает просроченные посылки в каждом ПВЗ, где они есть.
+func (r *ProductRepo) CountOverdueByPVZ(ctx context.Context, now time.Time) (map[int64]int, error) {
+	var rows []struct {
+		PVZID int64 `boil:"pvz_id"`
+		Count int   `boil:"count"`
+	}
+	err := queries.Raw(countOverdueQuery, constants.ProductReadyForPickup, now).Bind(ctx, database.Executor(ctx, r.db), &rows)
+	if err != nil {
+		slog.Error("Failed to count overdue products", "err", err)
+		return nil, err
+	}
+
+	counts := make(map[int64]int, len(rows))
+	for _, row := range rows {
+		counts[row.PVZID] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+type ProductTypeRepo struct {
+	db boil.ContextExecutor
+}
+
+func NewProductTypeRepo(db boil.ContextExecutor) *ProductTypeRepo {
+	return &ProductTypeRepo{db: db}
+}
+
+func (r *ProductTypeRepo) List(ctx context.Context) (models.ProductTypeSlice, error) {
+	types, err := models.ProductTypes(
+		qm.OrderBy(models.ProductTypeColumns.Code),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list product types", "err", err)
+		return nil, err
+	}
+
+	return types, nil
+}
+
+func (r *ProductTypeRepo) GetByCode(ctx context.Context, code string) (*models.ProductType, error) {
+	pt, err := models.FindProductType(ctx, database.Executor(ctx, r.db), code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get product type", "code", code, "err", err)
+		return nil, err
+	}
+
+	return pt, nil
+}
+
+func (r *ProductTypeRepo) Create(ctx context.Context, pt *models.ProductType) error {
+	now := time.Now()
+	pt.CreatedAt = now
+	pt.UpdatedAt = now
+
+	if err := pt.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		if isUniqueViolation(err) {
+			return errs.Conflict("product type already exists")
+		}
+		slog.Error("Failed to create product type", "code", pt.Code, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProductTypeRepo) Update(ctx context.Context, pt *models.ProductType) error {
+	pt.UpdatedAt = time.Now()
+
+	updated, err := pt.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(
+		models.ProductTypeColumns.NameRu,
+		models.ProductTypeColumns.NameEn,
+		models.ProductTypeColumns.IsActive,
+		models.ProductTypeColumns.StorageDays,
+		models.ProductTypeColumns.UpdatedAt,
+	))
+	if err != nil {
+		slog.Error("Failed to update product type", "code", pt.Code, "err", err)
+		return err
+	}
+	if updated == 0 {
+		return errs.NotFound("product type not found")
+	}
+
+	return nil
+}
+
+// Delete удаляет тип, только если на него не ссылается ни один товар;
+// иначе тип нужно выключить.
+func (r *ProductTypeRepo) Delete(ctx context.Context, code string) error {
+	deleted, err := models.ProductTypes(
+		models.ProductTypeWhere.Code.EQ(code),
+	).DeleteAll(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.Conflict("product type is used by products, deactivate it instead")
+		}
+		slog.Error("Failed to delete product type", "code", code, "err", err)
+		return err
+	}
+	if deleted == 0 {
+		return errs.NotFound("product type not found")
+	}
+
+	return nil
+}
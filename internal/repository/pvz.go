@@ -1,9 +1,15 @@
 package repository
 
 import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
 	"PVZ/models"
+	"PVZ/pkg/database"
 	"context"
+	"database/sql"
+	"errors"
 	"log/slog"
+	"strconv"
 	"time"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
@@ -25,15 +31,17 @@ func (r *PVZRepo) CreatePVZ(ctx context.Context, name string, city string) (*mod
 		CreatedAt: time.Now(),
 	}
 
-	if err := pvz.Insert(ctx, r.db, boil.Infer()); err != nil {
-		slog.Error("Failed to insert PVZ %s: %v", name, err)
+	if err := pvz.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		slog.Error("Failed to insert PVZ", "name", name, "err", err)
 		return nil, err
 	}
 
 	return pvz, nil
 }
 
-func (r *PVZRepo) GetPVZList(ctx context.Context, offset, limit int, cityFilter string) ([]*models.PVZ, error) {
+// GetPVZList отдаёт ПВЗ с приёмками и товарами; при заданном диапазоне дат
+// остаются только ПВЗ, у которых есть приёмки в этом диапазоне.
+func (r *PVZRepo) GetPVZList(ctx context.Context, offset, limit int, cityFilter string, startDate, endDate *time.Time) ([]*models.PVZ, error) {
 	mods := []qm.QueryMod{
 		qm.Limit(limit),
 		qm.Offset(offset),
@@ -44,11 +52,113 @@ func (r *PVZRepo) GetPVZList(ctx context.Context, offset, limit int, cityFilter
 		mods = append(mods, models.PVZWhere.City.EQ(cityFilter))
 	}
 
-	pvzList, err := models.PVZS(mods...).All(ctx, r.db)
+	var receptionMods []qm.QueryMod
+	if startDate != nil || endDate != nil {
+		exists := "EXISTS (SELECT 1 FROM " + models.TableNames.Receptions + " r WHERE r." +
+			models.ReceptionColumns.PVZID + " = " + models.PVZTableColumns.ID
+		var args []interface{}
+
+		if startDate != nil {
+			exists += " AND r." + models.ReceptionColumns.DateTime + " >= ?"
+			args = append(args, *startDate)
+			receptionMods = append(receptionMods, models.ReceptionWhere.DateTime.GTE(*startDate))
+		}
+		if endDate != nil {
+			exists += " AND r." + models.ReceptionColumns.DateTime + " <= ?"
+			args = append(args, *endDate)
+			receptionMods = append(receptionMods, models.ReceptionWhere.DateTime.LTE(*endDate))
+		}
+
+		mods = append(mods, qm.Where(exists+")", args...))
+	}
+
+	receptionMods = append(receptionMods, qm.OrderBy(models.ReceptionColumns.DateTime+" DESC"))
+	mods = append(mods,
+		qm.Load(models.PVZRels.Receptions, receptionMods...),
+		qm.Load(
+			qm.Rels(models.PVZRels.Receptions, models.ReceptionRels.Products),
+			qm.OrderBy(models.ProductColumns.AddedAt+", "+models.ProductColumns.ID),
+		),
+	)
+
+	pvzList, err := models.PVZS(mods...).All(ctx, database.Executor(ctx, r.db))
 	if err != nil {
-		slog.Error("Failed to get PVZ list: %v", err)
+		slog.Error("Failed to get PVZ list", "err", err)
 		return nil, err
 	}
 
 	return pvzList, nil
 }
+
+func (r *PVZRepo) GetByID(ctx context.Context, pvzID string) (*models.PVZ, error) {
+	pvzIDInt, err := strconv.ParseInt(pvzID, 10, 64)
+	if err != nil {
+		return nil, errs.Validation("invalid PVZ ID format")
+	}
+
+	pvz, err := models.FindPVZ(ctx, database.Executor(ctx, r.db), pvzIDInt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get PVZ", "id", pvzID, "err", err)
+		return nil, err
+	}
+
+	return pvz, nil
+}
+
+// GetByIDForUpdate блокирует строку ПВЗ, чтобы параллельные приёмки не
+// превысили вместимость. NO KEY UPDATE не мешает вставке приёмок, которые
+// ссылаются на ПВЗ.
+func (r *PVZRepo) GetByIDForUpdate(ctx context.Context, pvzID string) (*models.PVZ, error) {
+	pvzIDInt, err := strconv.ParseInt(pvzID, 10, 64)
+	if err != nil {
+		return nil, errs.Validation("invalid PVZ ID format")
+	}
+
+	pvz, err := models.PVZS(
+		models.PVZWhere.ID.EQ(pvzIDInt),
+		qm.For("NO KEY UPDATE"),
+	).One(ctx, database.Executor(ctx, r.db))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to lock PVZ", "id", pvzID, "err", err)
+		return nil, err
+	}
+
+	return pvz, nil
+}
+
+// UpdateCapacity сохраняет вместимость ПВЗ и правило её соблюдения.
+func (r *PVZRepo) UpdateCapacity(ctx context.Context, pvz *models.PVZ) error {
+	_, err := pvz.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(
+		models.PVZColumns.Capacity,
+		models.PVZColumns.CapacityPolicy,
+	))
+	if err != nil {
+		slog.Error("Failed to update PVZ capacity", "id", pvz.ID, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// CountStoredProducts считает товары, занимающие место в ПВЗ: принятые и
+// ожидающие выдачи, кроме товаров отменённых приёмок и закрытых приёмок возвратов.
+func (r *PVZRepo) CountStoredProducts(ctx context.Context, pvzID int64) (int, error) {
+	count, err := models.Products(
+		joinReception,
+		qm.Where("r."+models.ReceptionColumns.PVZID+" = ?", pvzID),
+		storedReception,
+		models.ProductWhere.Status.IN([]string{constants.ProductReceived, constants.ProductReadyForPickup}),
+	).Count(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to count stored products", "pvzID", pvzID, "err", err)
+		return 0, err
+	}
+
+	return int(count), nil
+}
@@ -2,18 +2,21 @@ package repository
 
 import (
 	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
 	"PVZ/models"
+	"PVZ/pkg/database"
+	"PVZ/pkg/pagination"
 	"PVZ/pkg/uuid"
 	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"log/slog"
 	"strconv"
+	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
-	"github.com/aarondl/sqlboiler/v4/types"
 )
 
 type ReceptionRepo struct {
@@ -24,10 +27,10 @@ func NewReceptionRepo(db boil.ContextExecutor) *ReceptionRepo {
 	return &ReceptionRepo{db: db}
 }
 
-func (r *ReceptionRepo) CreateReception(ctx context.Context, pvzID string) (*models.Reception, error) {
+func (r *ReceptionRepo) CreateReception(ctx context.Context, pvzID, kind, createdBy string) (*models.Reception, error) {
 	pvzIDInt, err := strconv.ParseInt(pvzID, 10, 64)
 	if err != nil {
-		return nil, errors.New("invalid PVZ ID format")
+		return nil, errs.Validation("invalid PVZ ID format")
 	}
 
 	id, err := uuid.GenerateUUID7()
@@ -36,117 +39,234 @@ func (r *ReceptionRepo) CreateReception(ctx context.Context, pvzID string) (*mod
 	}
 
 	rec := &models.Reception{
-		ID:     id,
-		PVZID:  pvzIDInt,
-		Status: constants.ReceptionInProgress,
+		ID:        id,
+		PVZID:     pvzIDInt,
+		Status:    constants.ReceptionInProgress,
+		Kind:      kind,
+		CreatedBy: null.NewString(createdBy, createdBy != ""),
 	}
 
-	if err := rec.Insert(ctx, r.db, boil.Infer()); err != nil {
-		slog.Error("Failed to create reception %s: %v", id, err)
+	if err := rec.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		if isUniqueViolation(err) {
+			return nil, errs.Conflict("there is already an active reception")
+		}
+		slog.Error("Failed to create reception", "id", id, "err", err)
 		return nil, err
 	}
 
 	return rec, nil
 }
 
-func (r *ReceptionRepo) GetActiveByPVZ(ctx context.Context, pvzID string) (*models.Reception, error) {
+// GetActiveByPVZ отдаёт приёмку в работе заданного вида (поставка или возвраты).
+func (r *ReceptionRepo) GetActiveByPVZ(ctx context.Context, pvzID, kind string) (*models.Reception, error) {
+	return r.getActiveByPVZ(ctx, pvzID, kind, false)
+}
+
+// GetActiveByPVZForUpdate блокирует активную приёмку до конца транзакции,
+// поэтому вызывать его нужно внутри TxManager.Do.
+func (r *ReceptionRepo) GetActiveByPVZForUpdate(ctx context.Context, pvzID, kind string) (*models.Reception, error) {
+	return r.getActiveByPVZ(ctx, pvzID, kind, true)
+}
+
+func (r *ReceptionRepo) getActiveByPVZ(ctx context.Context, pvzID, kind string, forUpdate bool) (*models.Reception, error) {
 	pvzIDInt, err := strconv.ParseInt(pvzID, 10, 64)
-	rec, err := models.Receptions(
+	if err != nil {
+		return nil, errs.Validation("invalid PVZ ID format")
+	}
+
+	mods := []qm.QueryMod{
 		models.ReceptionWhere.PVZID.EQ(pvzIDInt),
 		models.ReceptionWhere.Status.EQ(constants.ReceptionInProgress),
-		qm.OrderBy(models.ReceptionColumns.DateTime+" DESC"),
+		models.ReceptionWhere.Kind.EQ(kind),
+		qm.OrderBy(models.ReceptionColumns.DateTime + " DESC"),
 		qm.Limit(1),
-	).One(ctx, r.db)
+	}
+	if forUpdate {
+		mods = append(mods, qm.For("UPDATE"))
+	}
+
+	rec, err := models.Receptions(mods...).One(ctx, database.Executor(ctx, r.db))
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.Info("No active reception found", "pvzID", pvzID, "kind", kind)
+		return nil, nil
+	}
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, err
-		}
-		slog.Info("No active reception found for PVZ %s", pvzID)
+		slog.Error("Failed to get active reception", "pvzID", pvzID, "err", err)
 		return nil, err
 	}
 	return rec, nil
 }
 
-func (r *ReceptionRepo) CloseReception(ctx context.Context, receptionID string) error {
-	rec, err := models.FindReception(ctx, r.db, receptionID)
+// UpdateStatus сохраняет статус приёмки вместе с closed_by/closed_at.
+func (r *ReceptionRepo) UpdateStatus(ctx context.Context, rec *models.Reception) error {
+	_, err := rec.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(
+		models.ReceptionColumns.Status,
+		models.ReceptionColumns.ClosedBy,
+		models.ReceptionColumns.ClosedAt,
+		models.ReceptionColumns.ReopenedAt,
+	))
 	if err != nil {
-		slog.Warn("Failed to find reception %s: %v", receptionID, err)
+		if isUniqueViolation(err) {
+			return errs.Conflict("there is already an active reception")
+		}
+		slog.Error("Failed to update reception status", "id", rec.ID, "status", rec.Status, "err", err)
 		return err
 	}
 
-	rec.Status = constants.ReceptionClosed
-	_, err = rec.Update(ctx, r.db, boil.Whitelist(models.ReceptionColumns.Status))
+	return nil
+}
+
+// GetByID отдаёт приёмку вместе с товарами в порядке добавления.
+func (r *ReceptionRepo) GetByID(ctx context.Context, receptionID string) (*models.Reception, error) {
+	if !uuid.IsValid(receptionID) {
+		return nil, errs.Validation("invalid reception ID format")
+	}
+
+	rec, err := models.Receptions(
+		models.ReceptionWhere.ID.EQ(receptionID),
+		qm.Load(models.ReceptionRels.Products, qm.OrderBy(models.ProductColumns.AddedAt+", "+models.ProductColumns.ID)),
+	).One(ctx, database.Executor(ctx, r.db))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
 	if err != nil {
-		slog.Error("Failed to close reception %s: %v", receptionID, err)
-		return err
+		slog.Error("Failed to get reception", "id", receptionID, "err", err)
+		return nil, err
 	}
 
-	return nil
+	return rec, nil
 }
 
-func (r *ReceptionRepo) UpdateProducts(ctx context.Context, receptionID string, productIDs []string) error {
-	rec, err := models.FindReception(ctx, r.db, receptionID)
+// ListByPVZ отдаёт приёмки ПВЗ от новых к старым. after — курсор последней
+// приёмки предыдущей страницы.
+func (r *ReceptionRepo) ListByPVZ(ctx context.Context, pvzID, status string, startDate, endDate *time.Time, after *pagination.Cursor, limit int) (models.ReceptionSlice, error) {
+	pvzIDInt, err := strconv.ParseInt(pvzID, 10, 64)
 	if err != nil {
-		slog.Warn("Failed to find reception %s: %v", receptionID, err)
-		return err
+		return nil, errs.Validation("invalid PVZ ID format")
 	}
 
-	jsonData, err := json.Marshal(productIDs)
+	mods := []qm.QueryMod{
+		models.ReceptionWhere.PVZID.EQ(pvzIDInt),
+	}
+	if status != "" {
+		mods = append(mods, models.ReceptionWhere.Status.EQ(status))
+	}
+	if startDate != nil {
+		mods = append(mods, models.ReceptionWhere.DateTime.GTE(*startDate))
+	}
+	if endDate != nil {
+		mods = append(mods, models.ReceptionWhere.DateTime.LTE(*endDate))
+	}
+	if after != nil {
+		mods = append(mods, qm.Where(
+			"("+models.ReceptionColumns.DateTime+", "+models.ReceptionColumns.ID+") < (?, ?)",
+			after.Time, after.ID,
+		))
+	}
+
+	mods = append(mods,
+		qm.OrderBy(models.ReceptionColumns.DateTime+" DESC, "+models.ReceptionColumns.ID+" DESC"),
+		qm.Limit(limit),
+		qm.Load(models.ReceptionRels.Products, qm.OrderBy(models.ProductColumns.AddedAt+", "+models.ProductColumns.ID)),
+	)
+
+	list, err := models.Receptions(mods...).All(ctx, database.Executor(ctx, r.db))
 	if err != nil {
-		slog.Error("Failed to marshal product IDs: %v", err)
-		return err
+		slog.Error("Failed to list receptions", "pvzID", pvzID, "err", err)
+		return nil, err
 	}
 
-	rec.ProductIds = types.JSON(jsonData)
-	_, err = rec.Update(ctx, r.db, boil.Whitelist(models.ReceptionColumns.ProductIds))
+	return list, nil
+}
+
+// ListStaleInProgress отдаёт приёмки в работе, открытые или возобновлённые
+// раньше olderThan, от самых старых (без товаров).
+func (r *ReceptionRepo) ListStaleInProgress(ctx context.Context, olderThan time.Time, limit int) (models.ReceptionSlice, error) {
+	openedAt := "COALESCE(" + models.ReceptionColumns.ReopenedAt + ", " + models.ReceptionColumns.DateTime + ")"
+	list, err := models.Receptions(
+		models.ReceptionWhere.Status.EQ(constants.ReceptionInProgress),
+		qm.Where(openedAt+" < ?", olderThan),
+		qm.OrderBy(openedAt+", "+models.ReceptionColumns.ID),
+		qm.Limit(limit),
+	).All(ctx, database.Executor(ctx, r.db))
 	if err != nil {
-		slog.Error("Failed to update products for reception %s: %v", receptionID, err)
-		return err
+		slog.Error("Failed to list stale receptions", "olderThan", olderThan, "err", err)
+		return nil, err
 	}
 
-	return nil
+	return list, nil
 }
 
-func (r *ReceptionRepo) GetByID(ctx context.Context, receptionID string) (*models.Reception, error) {
-	rec, err := models.FindReception(ctx, r.db, receptionID)
+// GetByIDForUpdate блокирует приёмку до конца транзакции (без товаров).
+func (r *ReceptionRepo) GetByIDForUpdate(ctx context.Context, receptionID string) (*models.Reception, error) {
+	if !uuid.IsValid(receptionID) {
+		return nil, errs.Validation("invalid reception ID format")
+	}
+
+	rec, err := models.Receptions(
+		models.ReceptionWhere.ID.EQ(receptionID),
+		qm.For("UPDATE"),
+	).One(ctx, database.Executor(ctx, r.db))
 	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to lock reception", "id", receptionID, "err", err)
 		return nil, err
 	}
-	return rec, err
+
+	return rec, nil
 }
 
-func (r *ReceptionRepo) DeleteLastProduct(ctx context.Context, receptionID string) (*models.Reception, error) {
-	rec, err := r.GetByID(ctx, receptionID)
-	if err != nil || rec == nil {
-		slog.Error("Can not get reception by id %s: %v", receptionID, err)
+// DeleteLastProduct удаляет последний добавленный товар и возвращает его.
+func (r *ReceptionRepo) DeleteLastProduct(ctx context.Context, receptionID string) (*models.Product, error) {
+	exec := database.Executor(ctx, r.db)
+
+	last, err := models.Products(
+		models.ProductWhere.ReceptionID.EQ(receptionID),
+		qm.OrderBy(models.ProductColumns.AddedAt+" DESC, "+models.ProductColumns.ID+" DESC"),
+		qm.Limit(1),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.NotFound("no products to delete")
+	}
+	if err != nil {
+		slog.Error("Failed to get last product", "receptionID", receptionID, "err", err)
 		return nil, err
 	}
 
-	var productIDs []string
-	if err := rec.ProductIds.Unmarshal(&productIDs); err != nil {
-		slog.Error("Failed to unmarshal reception IDs: %v", err)
+	if _, err := last.Delete(ctx, exec); err != nil {
+		slog.Error("Failed to delete product", "id", last.ID, "err", err)
 		return nil, err
 	}
 
-	if len(productIDs) == 0 {
-		return nil, errors.New("No products to delete")
+	return last, nil
+}
+
+// DeleteProduct удаляет конкретный товар приёмки и возвращает его.
+func (r *ReceptionRepo) DeleteProduct(ctx context.Context, receptionID, productID string) (*models.Product, error) {
+	if !uuid.IsValid(productID) {
+		return nil, errs.Validation("invalid product ID format")
 	}
 
-	productIDs = productIDs[:len(productIDs)-1]
+	exec := database.Executor(ctx, r.db)
 
-	jsonData, err := json.Marshal(productIDs)
+	product, err := models.Products(
+		models.ProductWhere.ID.EQ(productID),
+		models.ProductWhere.ReceptionID.EQ(receptionID),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errs.NotFound("product not found in this reception")
+	}
 	if err != nil {
-		slog.Error("Failed to marshal reception IDs: %v", err)
+		slog.Error("Failed to get product", "id", productID, "err", err)
 		return nil, err
 	}
 
-	rec.ProductIds = types.JSON(jsonData)
-
-	_, err = rec.Update(ctx, r.db, boil.Whitelist(models.ReceptionColumns.ProductIds))
-	if err != nil {
-		slog.Error("Failed to update reception %s: %v", receptionID, err)
+	if _, err := product.Delete(ctx, exec); err != nil {
+		slog.Error("Failed to delete product", "id", productID, "err", err)
 		return nil, err
 	}
 
-	return rec, nil
+	return product, nil
 }
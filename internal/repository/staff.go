@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+type StaffRepo struct {
+	db boil.ContextExecutor
+}
+
+func NewStaffRepo(db boil.ContextExecutor) *StaffRepo {
+	return &StaffRepo{db: db}
+}
+
+func (r *StaffRepo) Assign(ctx context.Context, pvzID, userID, assignedBy string) (*models.PVZStaff, error) {
+	pvzIDInt, err := strconv.ParseInt(pvzID, 10, 64)
+	if err != nil {
+		return nil, errs.Validation("invalid PVZ ID format")
+	}
+
+	staff := &models.PVZStaff{
+		PVZID:      pvzIDInt,
+		UserID:     userID,
+		AssignedBy: null.NewString(assignedBy, assignedBy != ""),
+		CreatedAt:  time.Now(),
+	}
+
+	if err := staff.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		if isUniqueViolation(err) {
+			return nil, errs.Conflict("user is already assigned to this PVZ")
+		}
+		slog.Error("Failed to assign staff", "pvzID", pvzID, "userID", userID, "err", err)
+		return nil, err
+	}
+
+	return staff, nil
+}
+
+func (r *StaffRepo) Unassign(ctx context.Context, pvzID, userID string) error {
+	pvzIDInt, err := strconv.ParseInt(pvzID, 10, 64)
+	if err != nil {
+		return errs.Validation("invalid PVZ ID format")
+	}
+
+	deleted, err := models.PVZStaffs(
+		models.PVZStaffWhere.PVZID.EQ(pvzIDInt),
+		models.PVZStaffWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to unassign staff", "pvzID", pvzID, "userID", userID, "err", err)
+		return err
+	}
+	if deleted == 0 {
+		return errs.NotFound("user is not assigned to this PVZ")
+	}
+
+	return nil
+}
+
+func (r *StaffRepo) IsAssigned(ctx context.Context, pvzID, userID string) (bool, error) {
+	pvzIDInt, err := strconv.ParseInt(pvzID, 10, 64)
+	if err != nil {
+		return false, errs.Validation("invalid PVZ ID format")
+	}
+
+	return models.PVZStaffExists(ctx, database.Executor(ctx, r.db), pvzIDInt, userID)
+}
+
+func (r *StaffRepo) ListByPVZ(ctx context.Context, pvzID string) ([]*models.PVZStaff, error) {
+	pvzIDInt, err := strconv.ParseInt(pvzID, 10, 64)
+	if err != nil {
+		return nil, errs.Validation("invalid PVZ ID format")
+	}
+
+	staff, err := models.PVZStaffs(
+		models.PVZStaffWhere.PVZID.EQ(pvzIDInt),
+		qm.Load(models.PVZStaffRels.User),
+		qm.OrderBy(models.PVZStaffColumns.CreatedAt),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list staff", "pvzID", pvzID, "err", err)
+		return nil, err
+	}
+
+	return staff, nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+type TokenRepo struct {
+	db boil.ContextExecutor
+}
+
+func NewTokenRepo(db boil.ContextExecutor) *TokenRepo {
+	return &TokenRepo{db: db}
+}
+
+func (r *TokenRepo) CreateRefreshToken(ctx context.Context, token *models.RefreshToken) error {
+	if err := token.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		slog.Error("Failed to create refresh token", "userID", token.UserID, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetRefreshTokenForUpdate блокирует строку токена, чтобы один refresh token
+// нельзя было обменять дважды параллельными запросами.
+func (r *TokenRepo) GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	token, err := models.RefreshTokens(
+		models.RefreshTokenWhere.TokenHash.EQ(tokenHash),
+		qm.For("UPDATE"),
+	).One(ctx, database.Executor(ctx, r.db))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get refresh token", "err", err)
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (r *TokenRepo) RevokeRefreshToken(ctx context.Context, id string) error {
+	_, err := models.RefreshTokens(
+		models.RefreshTokenWhere.ID.EQ(id),
+		models.RefreshTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, database.Executor(ctx, r.db), models.M{
+		models.RefreshTokenColumns.RevokedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		slog.Error("Failed to revoke refresh token", "id", id, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *TokenRepo) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	_, err := models.RefreshTokens(
+		models.RefreshTokenWhere.UserID.EQ(userID),
+		models.RefreshTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, database.Executor(ctx, r.db), models.M{
+		models.RefreshTokenColumns.RevokedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		slog.Error("Failed to revoke user refresh tokens", "userID", userID, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// RevokeAccessToken заносит jti в список отозванных.
+func (r *TokenRepo) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	revoked := &models.RevokedToken{
+		Jti:       jti,
+		ExpiresAt: expiresAt,
+		RevokedAt: time.Now(),
+	}
+	err := revoked.Upsert(ctx, database.Executor(ctx, r.db), false, []string{models.RevokedTokenColumns.Jti}, boil.None(), boil.Infer())
+	if err != nil {
+		slog.Error("Failed to revoke access token", "jti", jti, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpiredRevocations удаляет отзывы токенов, которые уже истекли сами.
+func (r *TokenRepo) DeleteExpiredRevocations(ctx context.Context, now time.Time) (int64, error) {
+	n, err := models.RevokedTokens(
+		models.RevokedTokenWhere.ExpiresAt.LTE(now),
+	).DeleteAll(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to delete expired revoked tokens", "err", err)
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (r *TokenRepo) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return models.RevokedTokenExists(ctx, database.Executor(ctx, r.db), jti)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"PVZ/models"
+	"PVZ/pkg/database"
 	"context"
 	"database/sql"
 	"errors"
@@ -19,9 +20,9 @@ func NewUserRepo(db boil.ContextExecutor) *UserRepo {
 }
 
 func (r *UserRepo) CreateUser(ctx context.Context, user *models.User) error {
-	err := user.Insert(ctx, r.db, boil.Infer())
+	err := user.Insert(ctx, database.Executor(ctx, r.db), boil.Infer())
 	if err != nil {
-		slog.Error("Failed to create user %s: %v", user.Email, err)
+		slog.Error("Failed to create user", "email", user.Email, "err", err)
 		return err
 	}
 
@@ -31,14 +32,27 @@ func (r *UserRepo) CreateUser(ctx context.Context, user *models.User) error {
 func (r *UserRepo) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	user, err := models.Users(
 		models.UserWhere.Email.EQ(email),
-	).One(ctx, r.db)
+	).One(ctx, database.Executor(ctx, r.db))
 
 	if errors.Is(err, sql.ErrNoRows) {
-		slog.Info("User %s not found", email)
+		slog.Info("User not found", "email", email)
 		return nil, nil
 	}
 	if err != nil {
-		slog.Info("Failed to get user %s: %v", email, err)
+		slog.Info("Failed to get user", "email", email, "err", err)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *UserRepo) GetByID(ctx context.Context, id string) (*models.User, error) {
+	user, err := models.FindUser(ctx, database.Executor(ctx, r.db), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get user", "id", id, "err", err)
 		return nil, err
 	}
 
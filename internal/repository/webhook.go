@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/database"
+	"PVZ/pkg/uuid"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+type WebhookRepo struct {
+	db boil.ContextExecutor
+}
+
+func NewWebhookRepo(db boil.ContextExecutor) *WebhookRepo {
+	return &WebhookRepo{db: db}
+}
+
+func (r *WebhookRepo) Create(ctx context.Context, hook *models.Webhook) error {
+	id, err := uuid.GenerateUUID7()
+	if err != nil {
+		return errors.New("Failed to generate UUIDv7")
+	}
+
+	hook.ID = id
+	hook.CreatedAt = time.Now()
+
+	if err := hook.Insert(ctx, database.Executor(ctx, r.db), boil.Infer()); err != nil {
+		if isForeignKeyViolation(err) {
+			return errs.NotFound("PVZ not found")
+		}
+		slog.Error("Failed to create webhook", "url", hook.URL, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *WebhookRepo) List(ctx context.Context) (models.WebhookSlice, error) {
+	hooks, err := models.Webhooks(
+		qm.OrderBy(models.WebhookColumns.CreatedAt+", "+models.WebhookColumns.ID),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list webhooks", "err", err)
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+func (r *WebhookRepo) GetByID(ctx context.Context, id string) (*models.Webhook, error) {
+	if !uuid.IsValid(id) {
+		return nil, errs.Validation("invalid webhook ID format")
+	}
+
+	hook, err := models.FindWebhook(ctx, database.Executor(ctx, r.db), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get webhook", "id", id, "err", err)
+		return nil, err
+	}
+
+	return hook, nil
+}
+
+// Delete удаляет подписку вместе с журналом её доставок.
+func (r *WebhookRepo) Delete(ctx context.Context, id string) (bool, error) {
+	if !uuid.IsValid(id) {
+		return false, errs.Validation("invalid webhook ID format")
+	}
+
+	n, err := models.Webhooks(models.WebhookWhere.ID.EQ(id)).DeleteAll(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to delete webhook", "id", id, "err", err)
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// ListSubscribed отдаёт включённые подписки на тип события.
+func (r *WebhookRepo) ListSubscribed(ctx context.Context, eventType string) (models.WebhookSlice, error) {
+	hooks, err := models.Webhooks(
+		models.WebhookWhere.Enabled.EQ(true),
+		// Оператор ? у jsonb конфликтует с плейсхолдерами, поэтому @>.
+		qm.Where(models.WebhookColumns.EventTypes+" @> jsonb_build_array(?::text)", eventType),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list subscribed webhooks", "eventType", eventType, "err", err)
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+// AddDelivery ставит доставку в очередь. Повтор того же события для той же
+// подписки игнорируется.
+func (r *WebhookRepo) AddDelivery(ctx context.Context, d *models.WebhookDelivery) error {
+	id, err := uuid.GenerateUUID7()
+	if err != nil {
+		return errors.New("Failed to generate UUIDv7")
+	}
+
+	now := time.Now()
+	d.ID = id
+	d.Status = constants.WebhookDeliveryPending
+	d.CreatedAt = now
+	d.NextAttemptAt = now
+
+	err = d.Upsert(ctx, database.Executor(ctx, r.db), false,
+		[]string{models.WebhookDeliveryColumns.WebhookID, models.WebhookDeliveryColumns.EventID},
+		boil.None(), boil.Infer(),
+	)
+	if err != nil {
+		slog.Error("Failed to add webhook delivery", "webhookID", d.WebhookID, "eventID", d.EventID, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+const claimDueDeliveriesQuery = `
+UPDATE webhook_deliveries SET next_attempt_at = $1
+WHERE id IN (
+    SELECT id FROM webhook_deliveries
+    WHERE status = $2 AND next_attempt_at <= $3
+    ORDER BY next_attempt_at, id
+    LIMIT $4
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+// ClaimDueDeliveries забирает доставки, время которых наступило, и сдвигает
+// их next_attempt_at на leaseUntil. Пока аренда не истекла, другие реплики
+// их не возьмут, а если реплика упала посреди отправки — доставки снова
+// станут доступны. Отправлять можно без открытой транзакции.
+func (r *WebhookRepo) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) (models.WebhookDeliverySlice, error) {
+	var deliveries models.WebhookDeliverySlice
+	err := queries.Raw(claimDueDeliveriesQuery,
+		leaseUntil, constants.WebhookDeliveryPending, now, limit,
+	).Bind(ctx, database.Executor(ctx, r.db), &deliveries)
+	if err != nil {
+		slog.Error("Failed to claim webhook deliveries", "err", err)
+		return nil, err
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID < deliveries[j].ID })
+	return deliveries, nil
+}
+
+// SaveAttempt сохраняет результат попытки доставки.
+func (r *WebhookRepo) SaveAttempt(ctx context.Context, d *models.WebhookDelivery) error {
+	_, err := d.Update(ctx, database.Executor(ctx, r.db), boil.Whitelist(
+		models.WebhookDeliveryColumns.Status,
+		models.WebhookDeliveryColumns.Attempts,
+		models.WebhookDeliveryColumns.NextAttemptAt,
+		models.WebhookDeliveryColumns.LastStatusCode,
+		models.WebhookDeliveryColumns.LastError,
+		models.WebhookDeliveryColumns.DeliveredAt,
+	))
+	if err != nil {
+		slog.Error("Failed to save webhook delivery attempt", "id", d.ID, "err", err)
+		return err
+	}
+
+	return nil
+}
+
+// ListDeliveries отдаёт журнал доставок подписки, от новых к старым.
+func (r *WebhookRepo) ListDeliveries(ctx context.Context, webhookID string, limit int) (models.WebhookDeliverySlice, error) {
+	deliveries, err := models.WebhookDeliveries(
+		models.WebhookDeliveryWhere.WebhookID.EQ(webhookID),
+		qm.OrderBy(models.WebhookDeliveryColumns.CreatedAt+" DESC, "+models.WebhookDeliveryColumns.ID+" DESC"),
+		qm.Limit(limit),
+	).All(ctx, database.Executor(ctx, r.db))
+	if err != nil {
+		slog.Error("Failed to list webhook deliveries", "webhookID", webhookID, "err", err)
+		return nil, err
+	}
+
+	return deliveries, nil
+}
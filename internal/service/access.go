@@ -0,0 +1,26 @@
+package service
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/pkg/auth"
+	"context"
+)
+
+// requireStaff пропускает только сотрудника, закреплённого за ПВЗ.
+// У токенов из /auth/dummy нет пользователя, поэтому они сюда не проходят.
+func requireStaff(ctx context.Context, staff StaffRepository, pvzID string) error {
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return errs.Forbidden("employee is not assigned to this PVZ")
+	}
+
+	assigned, err := staff.IsAssigned(ctx, pvzID, userID)
+	if err != nil {
+		return errs.Wrap(err, "failed to check PVZ staff")
+	}
+	if !assigned {
+		return errs.Forbidden("employee is not assigned to this PVZ")
+	}
+
+	return nil
+}
@@ -2,52 +2,65 @@ package service
 
 import (
 	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
 	"PVZ/models"
 	"PVZ/pkg/auth"
 	"PVZ/pkg/uuid"
 	"context"
-	"errors"
-	"log/slog"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"golang.org/x/crypto/bcrypt"
 )
 
+type TokenSettings struct {
+	Key        []byte
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int64
+}
+
 type UserService struct {
-	repo   UserRepository
-	jwtKey []byte
+	repo     UserRepository
+	tokens   TokenRepository
+	tx       TxManager
+	settings TokenSettings
 }
 
-func NewUserService(repo UserRepository, jwtKey []byte) *UserService {
-	return &UserService{repo: repo, jwtKey: jwtKey}
+func NewUserService(repo UserRepository, tokens TokenRepository, tx TxManager, settings TokenSettings) *UserService {
+	return &UserService{repo: repo, tokens: tokens, tx: tx, settings: settings}
 }
 
 func (s *UserService) Register(ctx context.Context, email, password, role string) (*models.User, error) {
 	if role != constants.RoleEmployee && role != constants.RoleModerator {
-		return nil, errors.New("invalid role")
+		return nil, errs.Validation("invalid role")
 	}
 
 	if len(password) < 8 {
-		return nil, errors.New("password too short")
+		return nil, errs.Validation("password too short")
 	}
 
 	existing, err := s.repo.GetByEmail(ctx, email)
 	if err != nil {
-		return nil, errors.New("user not found")
+		return nil, errs.Internal("failed to get user", err)
 	}
 	if existing != nil {
-		return nil, errors.New("user already exists")
+		return nil, errs.Conflict("user already exists")
 	}
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
-		return nil, errors.New("failed to hash password")
+		return nil, errs.Internal("failed to hash password", err)
 	}
 
 	id, err := uuid.GenerateUUID7()
 	if err != nil {
-		return nil, errors.New("cannot generate uuid")
+		return nil, errs.Internal("cannot generate uuid", err)
 	}
 
 	user := &models.User{
@@ -59,57 +72,180 @@ func (s *UserService) Register(ctx context.Context, email, password, role string
 	}
 
 	if err := s.repo.CreateUser(ctx, user); err != nil {
-		return nil, errors.New("failed to create user")
+		return nil, errs.Internal("failed to create user", err)
 	}
 
 	return user, nil
 }
 
-func (s *UserService) Login(ctx context.Context, email, password string) (string, error) {
+func (s *UserService) Login(ctx context.Context, email, password string) (*TokenPair, error) {
 	user, err := s.repo.GetByEmail(ctx, email)
 	if err != nil {
-		return "", errors.New("user not found")
+		return nil, errs.Internal("failed to get user", err)
 	}
 
 	if user == nil || bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) != nil {
-		return "", errors.New("invalid email or password")
+		return nil, errs.Unauthorized("invalid email or password")
 	}
 
-	claims := &auth.UserClaims{
-		Role: string(user.Role),
-		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(24 * time.Hour).Unix(),
-		},
+	return s.issueTokens(ctx, user)
+}
+
+// Refresh обменивает refresh token на новую пару. Старый токен отзывается;
+// повторное предъявление отозванного токена считается утечкой, и тогда
+// отзываются все refresh tokens пользователя.
+func (s *UserService) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	if refreshToken == "" {
+		return nil, errs.Validation("refresh token is required")
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString(s.jwtKey)
+	var (
+		pair   *TokenPair
+		reused bool
+	)
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		stored, err := s.tokens.GetRefreshTokenForUpdate(ctx, auth.HashRefreshToken(refreshToken))
+		if err != nil {
+			return errs.Internal("failed to get refresh token", err)
+		}
+		if stored == nil {
+			return errs.Unauthorized("invalid refresh token")
+		}
+
+		if stored.RevokedAt.Valid {
+			reused = true
+			if err := s.tokens.RevokeUserRefreshTokens(ctx, stored.UserID); err != nil {
+				return errs.Internal("failed to revoke refresh tokens", err)
+			}
+			return nil
+		}
+
+		if time.Now().After(stored.ExpiresAt) {
+			return errs.Unauthorized("refresh token expired")
+		}
+
+		user, err := s.repo.GetByID(ctx, stored.UserID)
+		if err != nil {
+			return errs.Internal("failed to get user", err)
+		}
+		if user == nil {
+			return errs.Unauthorized("invalid refresh token")
+		}
+
+		if err := s.tokens.RevokeRefreshToken(ctx, stored.ID); err != nil {
+			return errs.Internal("failed to revoke refresh token", err)
+		}
+
+		pair, err = s.issueTokens(ctx, user)
+		return err
+	})
 	if err != nil {
-		slog.Warn("failed to sign JWT")
-		return "", err
+		return nil, err
+	}
+	if reused {
+		return nil, errs.Unauthorized("refresh token has already been used")
 	}
 
-	return signedToken, nil
+	return pair, nil
+}
+
+// Logout отзывает текущий access token и, если он передан, refresh token пользователя.
+func (s *UserService) Logout(ctx context.Context, refreshToken string) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return errs.Unauthorized("authentication required")
+	}
+
+	return s.tx.Do(ctx, func(ctx context.Context) error {
+		if principal.TokenID != "" {
+			if err := s.tokens.RevokeAccessToken(ctx, principal.TokenID, principal.ExpiresAt); err != nil {
+				return errs.Internal("failed to revoke access token", err)
+			}
+		}
+
+		if refreshToken == "" {
+			return nil
+		}
+
+		stored, err := s.tokens.GetRefreshTokenForUpdate(ctx, auth.HashRefreshToken(refreshToken))
+		if err != nil {
+			return errs.Internal("failed to get refresh token", err)
+		}
+		if stored == nil || stored.UserID != principal.UserID {
+			return errs.Validation("invalid refresh token")
+		}
+
+		if err := s.tokens.RevokeRefreshToken(ctx, stored.ID); err != nil {
+			return errs.Internal("failed to revoke refresh token", err)
+		}
+
+		return nil
+	})
 }
 
 func (s *UserService) DummyLogin(role string) (string, error) {
 	if role != constants.RoleModerator && role != constants.RoleEmployee {
-		return "", errors.New("invalid role")
+		return "", errs.Validation("invalid role")
 	}
 
-	claims := &auth.UserClaims{
-		Role: role,
+	return s.signAccessToken(&auth.UserClaims{Role: role})
+}
+
+func (s *UserService) issueTokens(ctx context.Context, user *models.User) (*TokenPair, error) {
+	accessToken, err := s.signAccessToken(&auth.UserClaims{
+		Role:  user.Role,
+		Email: user.Email,
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(24 * time.Hour).Unix(),
+			Subject: user.ID,
 		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, hash, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, errs.Internal("failed to generate refresh token", err)
+	}
+
+	id, err := uuid.GenerateUUID7()
+	if err != nil {
+		return nil, errs.Internal("cannot generate uuid", err)
+	}
+
+	now := time.Now()
+	err = s.tokens.CreateRefreshToken(ctx, &models.RefreshToken{
+		ID:        id,
+		UserID:    user.ID,
+		TokenHash: hash,
+		ExpiresAt: now.Add(s.settings.RefreshTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, errs.Internal("failed to store refresh token", err)
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(s.settings.AccessTTL.Seconds()),
+	}, nil
+}
+
+func (s *UserService) signAccessToken(claims *auth.UserClaims) (string, error) {
+	jti, err := uuid.GenerateUUID7()
+	if err != nil {
+		return "", errs.Internal("cannot generate uuid", err)
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	now := time.Now()
+	claims.Id = jti
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(s.settings.AccessTTL).Unix()
 
-	signedToken, err := token.SignedString(s.jwtKey)
+	signedToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.settings.Key)
 	if err != nil {
-		slog.Warn("Failed to sign dummy JWT for role %s: %v", role, err)
-		return "", err
+		return "", errs.Internal("failed to sign JWT", err)
 	}
 
 	return signedToken, nil
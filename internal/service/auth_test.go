@@ -0,0 +1,135 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/auth"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testJWTKey = []byte("test-secret")
+
+func newAuthTestService(t *testing.T) (*UserService, *fakeTokenRepo) {
+	t.Helper()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+
+	users := &fakeUserRepo{users: map[string]*models.User{
+		"user-1": {ID: "user-1", Email: "user@example.com", Password: string(hash), Role: constants.RoleEmployee},
+	}}
+	tokens := newFakeTokenRepo()
+
+	svc := NewUserService(users, tokens, &fakeTx{store: newFakeStore()}, TokenSettings{
+		Key:        testJWTKey,
+		AccessTTL:  15 * time.Minute,
+		RefreshTTL: time.Hour,
+	})
+	return svc, tokens
+}
+
+func parseTestToken(t *testing.T, token string) *auth.UserClaims {
+	t.Helper()
+
+	claims := &auth.UserClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return testJWTKey, nil
+	}); err != nil {
+		t.Fatalf("parse token: %v", err)
+	}
+	return claims
+}
+
+func TestLogin_IssuesIdentityClaims(t *testing.T) {
+	svc, _ := newAuthTestService(t)
+
+	pair, err := svc.Login(context.Background(), "user@example.com", "password123")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	claims := parseTestToken(t, pair.AccessToken)
+	if claims.Subject != "user-1" || claims.Email != "user@example.com" || claims.Role != constants.RoleEmployee {
+		t.Fatalf("unexpected claims: %+v", claims)
+	}
+	if claims.Id == "" || claims.IssuedAt == 0 {
+		t.Fatalf("expected jti and iat, got %+v", claims.StandardClaims)
+	}
+	if pair.RefreshToken == "" {
+		t.Fatal("expected refresh token")
+	}
+}
+
+func TestRefresh_RotatesToken(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newAuthTestService(t)
+
+	first, err := svc.Login(ctx, "user@example.com", "password123")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	second, err := svc.Refresh(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("refresh token was not rotated")
+	}
+
+	if _, err := svc.Refresh(ctx, second.RefreshToken); err != nil {
+		t.Fatalf("refresh with rotated token: %v", err)
+	}
+}
+
+func TestRefresh_ReuseRevokesAllTokens(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newAuthTestService(t)
+
+	first, err := svc.Login(ctx, "user@example.com", "password123")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	second, err := svc.Refresh(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+
+	if _, err := svc.Refresh(ctx, first.RefreshToken); !errors.Is(err, errs.ErrUnauthorized) {
+		t.Fatalf("expected unauthorized on reuse, got %v", err)
+	}
+	if _, err := svc.Refresh(ctx, second.RefreshToken); !errors.Is(err, errs.ErrUnauthorized) {
+		t.Fatalf("expected rotated token to be revoked after reuse, got %v", err)
+	}
+}
+
+func TestLogout_RevokesAccessAndRefreshTokens(t *testing.T) {
+	svc, tokens := newAuthTestService(t)
+
+	pair, err := svc.Login(context.Background(), "user@example.com", "password123")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	claims := parseTestToken(t, pair.AccessToken)
+	ctx := auth.WithPrincipal(context.Background(), auth.PrincipalFromClaims(claims))
+
+	if err := svc.Logout(ctx, pair.RefreshToken); err != nil {
+		t.Fatalf("logout: %v", err)
+	}
+	if _, ok := tokens.revoked[claims.Id]; !ok {
+		t.Fatal("access token jti was not revoked")
+	}
+	if _, err := svc.Refresh(context.Background(), pair.RefreshToken); !errors.Is(err, errs.ErrUnauthorized) {
+		t.Fatalf("expected refresh after logout to fail, got %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/metrics"
+	"context"
+	"log/slog"
+	"strconv"
+
+	"github.com/aarondl/null/v8"
+)
+
+// Occupancy — заполненность ПВЗ. Capacity == nil — вместимость не ограничена.
+type Occupancy struct {
+	PVZID    int64
+	Capacity *int
+	Policy   string
+	Occupied int
+}
+
+// SetCapacity задаёт вместимость ПВЗ в местах хранения и что делать при
+// заполнении: отклонять товар (reject) или принимать с предупреждением (warn).
+// capacity == nil снимает ограничение.
+func (s *PVZService) SetCapacity(ctx context.Context, pvzID string, capacity *int, policy, userRole string) (*models.PVZ, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if capacity != nil && *capacity <= 0 {
+		return nil, errs.Validation("capacity must be positive")
+	}
+	if policy == "" {
+		policy = constants.CapacityPolicyReject
+	}
+	if policy != constants.CapacityPolicyReject && policy != constants.CapacityPolicyWarn {
+		return nil, errs.Validation("invalid capacity policy")
+	}
+
+	var pvz *models.PVZ
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		pvz, err = s.repo.GetByIDForUpdate(ctx, pvzID)
+		if err != nil {
+			return errs.Wrap(err, "failed to get PVZ")
+		}
+		if pvz == nil {
+			return errs.NotFound("PVZ not found")
+		}
+
+		pvz.Capacity = null.IntFromPtr(capacity)
+		pvz.CapacityPolicy = policy
+
+		if err := s.repo.UpdateCapacity(ctx, pvz); err != nil {
+			return errs.Wrap(err, "failed to update PVZ capacity")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pvz, nil
+}
+
+// Occupancy доступна модератору и сотрудникам ПВЗ.
+func (s *PVZService) Occupancy(ctx context.Context, pvzID, userRole string) (*Occupancy, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	pvz, err := s.repo.GetByID(ctx, pvzID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get PVZ")
+	}
+	if pvz == nil {
+		return nil, errs.NotFound("PVZ not found")
+	}
+
+	if userRole == constants.RoleEmployee {
+		if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+			return nil, err
+		}
+	}
+
+	occupied, err := s.repo.CountStoredProducts(ctx, pvz.ID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to count stored products")
+	}
+
+	return &Occupancy{
+		PVZID:    pvz.ID,
+		Capacity: pvz.Capacity.Ptr(),
+		Policy:   capacityPolicy(pvz),
+		Occupied: occupied,
+	}, nil
+}
+
+// reserveCapacity проверяет, что в ПВЗ поместятся ещё adding товаров.
+// Строка ПВЗ блокируется до конца транзакции, поэтому вызывать нужно внутри
+// tx после блокировки приёмки. При политике warn превышение не ошибка:
+// возвращается предупреждение, которое уходит клиенту вместе с ответом.
+func (s *ProductService) reserveCapacity(ctx context.Context, pvzID string, adding int) (string, error) {
+	pvz, err := s.pvzs.GetByIDForUpdate(ctx, pvzID)
+	if err != nil {
+		return "", errs.Wrap(err, "failed to lock PVZ")
+	}
+	if pvz == nil {
+		return "", errs.NotFound("PVZ not found")
+	}
+	if !pvz.Capacity.Valid {
+		return "", nil
+	}
+
+	occupied, err := s.pvzs.CountStoredProducts(ctx, pvz.ID)
+	if err != nil {
+		return "", errs.Wrap(err, "failed to count stored products")
+	}
+	if occupied+adding <= pvz.Capacity.Int {
+		return "", nil
+	}
+
+	policy := capacityPolicy(pvz)
+	metrics.CapacityExceeded.WithLabelValues(policy).Inc()
+	if policy == constants.CapacityPolicyWarn {
+		slog.Warn("PVZ capacity exceeded", "pvzID", pvz.ID, "capacity", pvz.Capacity.Int, "occupied", occupied, "adding", adding)
+		return "PVZ capacity exceeded: " + strconv.Itoa(occupied+adding) + " of " + strconv.Itoa(pvz.Capacity.Int) + " slots occupied", nil
+	}
+
+	return "", errs.Conflict("PVZ is full: " + strconv.Itoa(occupied) + " of " + strconv.Itoa(pvz.Capacity.Int) + " slots occupied")
+}
+
+func capacityPolicy(pvz *models.PVZ) string {
+	if pvz.CapacityPolicy == constants.CapacityPolicyWarn {
+		return constants.CapacityPolicyWarn
+	}
+	return constants.CapacityPolicyReject
+}
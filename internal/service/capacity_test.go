@@ -0,0 +1,94 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aarondl/null/v8"
+)
+
+func TestAddProductRespectsCapacity(t *testing.T) {
+	ctx := employeeCtx(testEmployeeID)
+	store, products, receptions, pvz := newCapacityTestServices()
+	pvz.Capacity = null.IntFrom(1)
+
+	if _, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	if _, warning, err := products.AddProduct(ctx, "1", constants.RoleEmployee, "обувь"); err != nil || warning != "" {
+		t.Fatalf("add product: warning %q, err %v", warning, err)
+	}
+
+	if _, _, err := products.AddProduct(ctx, "1", constants.RoleEmployee, "обувь"); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict when PVZ is full, got %v", err)
+	}
+	if _, _, err := products.AddProducts(ctx, "1", constants.RoleEmployee, []ProductInput{{Type: "обувь"}}); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict for batch when PVZ is full, got %v", err)
+	}
+	if len(store.products) != 1 {
+		t.Fatalf("expected 1 product, got %d", len(store.products))
+	}
+
+	// С правилом warn товар принимается сверх вместимости, а клиент получает
+	// предупреждение.
+	pvz.CapacityPolicy = constants.CapacityPolicyWarn
+	_, warning, err := products.AddProduct(ctx, "1", constants.RoleEmployee, "обувь")
+	if err != nil {
+		t.Fatalf("add product with warn policy: %v", err)
+	}
+	if want := "PVZ capacity exceeded: 2 of 1 slots occupied"; warning != want {
+		t.Fatalf("warning = %q, want %q", warning, want)
+	}
+	_, warning, err = products.AddProducts(ctx, "1", constants.RoleEmployee, []ProductInput{{Type: "обувь"}, {Type: "обувь"}})
+	if err != nil {
+		t.Fatalf("add batch with warn policy: %v", err)
+	}
+	if want := "PVZ capacity exceeded: 4 of 1 slots occupied"; warning != want {
+		t.Fatalf("batch warning = %q, want %q", warning, want)
+	}
+	if len(store.products) != 4 {
+		t.Fatalf("expected 4 products, got %d", len(store.products))
+	}
+}
+
+func TestOccupancy(t *testing.T) {
+	ctx := employeeCtx(testEmployeeID)
+	store, products, receptions, pvz := newCapacityTestServices()
+	pvzs := NewPVZService(&fakePVZRepo{pvz: []*models.PVZ{pvz}, store: store}, newFakeStaffRepo(), &fakeUserRepo{}, nil, &fakeOutboxRepo{store: store}, &fakeTx{store: store})
+
+	capacity := 10
+	if _, err := pvzs.SetCapacity(context.Background(), "1", &capacity, "", constants.RoleModerator); err != nil {
+		t.Fatalf("set capacity: %v", err)
+	}
+	if _, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	for range 3 {
+		if _, _, err := products.AddProduct(ctx, "1", constants.RoleEmployee, "одежда"); err != nil {
+			t.Fatalf("add product: %v", err)
+		}
+	}
+
+	occ, err := pvzs.Occupancy(context.Background(), "1", constants.RoleModerator)
+	if err != nil {
+		t.Fatalf("occupancy: %v", err)
+	}
+	if occ.Occupied != 3 || occ.Capacity == nil || *occ.Capacity != capacity || occ.Policy != constants.CapacityPolicyReject {
+		t.Fatalf("unexpected occupancy %+v", occ)
+	}
+
+	zero := 0
+	if _, err := pvzs.SetCapacity(context.Background(), "1", &zero, "", constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error for zero capacity, got %v", err)
+	}
+	if _, err := pvzs.SetCapacity(context.Background(), "1", nil, "drop", constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error for unknown policy, got %v", err)
+	}
+	if _, err := pvzs.SetCapacity(ctx, "1", nil, "", constants.RoleEmployee); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for employee, got %v", err)
+	}
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"PVZ/pkg/auth"
+	"PVZ/pkg/metrics"
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/aarondl/null/v8"
+)
+
+const (
+	maxCellCodeLen  = 32
+	maxCellCapacity = 1000
+)
+
+// CellOccupancy — ячейка и число товаров, которые в ней сейчас лежат.
+type CellOccupancy struct {
+	Cell     *models.PVZCell
+	Occupied int
+}
+
+type CellService struct {
+	cells      CellRepository
+	products   ProductLifecycleRepository
+	receptions ReceptionRepository
+	pvzs       PVZRepository
+	staff      StaffRepository
+	audit      AuditRepository
+	outbox     OutboxRepository
+	tx         TxManager
+}
+
+func NewCellService(cells CellRepository, products ProductLifecycleRepository, receptions ReceptionRepository, pvzs PVZRepository, staff StaffRepository, audit AuditRepository, outbox OutboxRepository, tx TxManager) *CellService {
+	return &CellService{
+		cells:      cells,
+		products:   products,
+		receptions: receptions,
+		pvzs:       pvzs,
+		staff:      staff,
+		audit:      audit,
+		outbox:     outbox,
+		tx:         tx,
+	}
+}
+
+// Create заводит в ПВЗ ячейку хранения с кодом вида A-01-03. capacity — сколько
+// товаров в ней помещается, по умолчанию один.
+func (s *CellService) Create(ctx context.Context, pvzID, code string, capacity *int, userRole string) (*models.PVZCell, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	code, ok := normalizeCellCode(code)
+	if !ok {
+		return nil, errs.Validation("invalid cell code")
+	}
+	size := 1
+	if capacity != nil {
+		size = *capacity
+	}
+	if size <= 0 || size > maxCellCapacity {
+		return nil, errs.Validation("invalid cell capacity")
+	}
+
+	pvz, err := s.pvzs.GetByID(ctx, pvzID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get PVZ")
+	}
+	if pvz == nil {
+		return nil, errs.NotFound("PVZ not found")
+	}
+
+	cell := &models.PVZCell{PVZID: pvz.ID, Code: code, Capacity: size}
+	if err := s.cells.Create(ctx, cell); err != nil {
+		return nil, errs.Wrap(err, "failed to create cell")
+	}
+
+	return cell, nil
+}
+
+// List отдаёт ячейки ПВЗ с их заполненностью: модератору — любого ПВЗ,
+// сотруднику — своего.
+func (s *CellService) List(ctx context.Context, pvzID, userRole string) ([]CellOccupancy, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	pvz, err := s.pvzs.GetByID(ctx, pvzID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get PVZ")
+	}
+	if pvz == nil {
+		return nil, errs.NotFound("PVZ not found")
+	}
+
+	if userRole == constants.RoleEmployee {
+		if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+			return nil, err
+		}
+	}
+
+	cells, err := s.cells.ListByPVZ(ctx, pvz.ID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list cells")
+	}
+	occupied, err := s.cells.CountStoredByCell(ctx, pvz.ID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to count products by cell")
+	}
+
+	list := make([]CellOccupancy, len(cells))
+	for i, c := range cells {
+		list[i] = CellOccupancy{Cell: c, Occupied: occupied[c.ID]}
+	}
+	return list, nil
+}
+
+// Delete удаляет пустую ячейку. Строка ПВЗ блокируется, чтобы в ячейку
+// ничего не положили, пока она удаляется.
+func (s *CellService) Delete(ctx context.Context, pvzID, code, userRole string) error {
+	if userRole != constants.RoleModerator {
+		return errs.Forbidden("access denied")
+	}
+
+	code, ok := normalizeCellCode(code)
+	if !ok {
+		return errs.Validation("invalid cell code")
+	}
+
+	return s.tx.Do(ctx, func(ctx context.Context) error {
+		pvz, err := s.pvzs.GetByIDForUpdate(ctx, pvzID)
+		if err != nil {
+			return errs.Wrap(err, "failed to lock PVZ")
+		}
+		if pvz == nil {
+			return errs.NotFound("PVZ not found")
+		}
+
+		cell, err := s.cells.GetByCode(ctx, pvz.ID, code)
+		if err != nil {
+			return errs.Wrap(err, "failed to get cell")
+		}
+		if cell == nil {
+			return errs.NotFound("cell not found")
+		}
+
+		occupied, err := s.cells.CountStoredByCell(ctx, pvz.ID)
+		if err != nil {
+			return errs.Wrap(err, "failed to count products by cell")
+		}
+		if occupied[cell.ID] > 0 {
+			return errs.Conflict("cell is not empty")
+		}
+
+		if err := s.cells.Delete(ctx, cell.ID); err != nil {
+			return errs.Wrap(err, "failed to delete cell")
+		}
+		return nil
+	})
+}
+
+// MoveProduct перекладывает хранящийся товар в другую ячейку того же ПВЗ.
+func (s *CellService) MoveProduct(ctx context.Context, productID, code, userRole string) (*models.Product, error) {
+	if userRole != constants.RoleEmployee {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	code, ok := normalizeCellCode(code)
+	if !ok {
+		return nil, errs.Validation("invalid cell code")
+	}
+
+	var product *models.Product
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		product, err = s.products.GetByIDForUpdate(ctx, productID)
+		if err != nil {
+			return errs.Wrap(err, "failed to get product")
+		}
+		if product == nil {
+			return errs.NotFound("product not found")
+		}
+
+		rec, err := s.receptions.GetByID(ctx, product.ReceptionID)
+		if err != nil {
+			return errs.Wrap(err, "failed to get reception")
+		}
+		if rec == nil || rec.Status == constants.ReceptionCancelled || !isStored(product) {
+			return errs.Conflict("product is not stored in PVZ")
+		}
+
+		pvzID := strconv.FormatInt(rec.PVZID, 10)
+		if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+			return err
+		}
+
+		// Приёмка раскладывает товары под той же блокировкой, поэтому место
+		// в ячейке не займут параллельно.
+		if _, err := s.pvzs.GetByIDForUpdate(ctx, pvzID); err != nil {
+			return errs.Wrap(err, "failed to lock PVZ")
+		}
+
+		cell, err := s.cells.GetByCode(ctx, rec.PVZID, code)
+		if err != nil {
+			return errs.Wrap(err, "failed to get cell")
+		}
+		if cell == nil {
+			return errs.NotFound("cell not found")
+		}
+		if product.CellID.String == cell.ID {
+			setCell(product, cell)
+			return nil
+		}
+
+		occupied, err := s.cells.CountStoredByCell(ctx, rec.PVZID)
+		if err != nil {
+			return errs.Wrap(err, "failed to count products by cell")
+		}
+		if occupied[cell.ID] >= cell.Capacity {
+			return errs.Conflict("cell " + cell.Code + " is full")
+		}
+
+		setCell(product, cell)
+		if err := s.cells.UpdateProductCell(ctx, product); err != nil {
+			return errs.Wrap(err, "failed to move product")
+		}
+
+		actorID := auth.UserIDFromContext(ctx)
+		err = s.audit.RecordProductAction(ctx, &models.ProductAuditLog{
+			Action:      constants.ProductActionMoved,
+			ProductID:   product.ID,
+			ReceptionID: product.ReceptionID,
+			ProductType: product.Type,
+			Barcode:     product.Barcode,
+			ActorID:     nullString(actorID),
+		})
+		if err != nil {
+			return errs.Wrap(err, "failed to record product move")
+		}
+
+		return addEvent(ctx, s.outbox, events.ProductMoved, events.AggregateProduct, product.ID, productPayload(product, rec.PVZID, actorID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ProductsMoved.Inc()
+	return product, nil
+}
+
+// cellPlan раскладывает принимаемые товары по ячейкам ПВЗ. Строится внутри
+// tx после блокировки строки ПВЗ, поэтому занятость ячеек до конца
+// транзакции меняется только через place.
+type cellPlan struct {
+	cells    models.PVZCellSlice
+	occupied map[string]int
+}
+
+func (s *ProductService) planCells(ctx context.Context, pvzID int64) (*cellPlan, error) {
+	cells, err := s.cells.ListByPVZ(ctx, pvzID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list cells")
+	}
+	if len(cells) == 0 {
+		return &cellPlan{}, nil
+	}
+
+	occupied, err := s.cells.CountStoredByCell(ctx, pvzID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to count products by cell")
+	}
+
+	return &cellPlan{cells: cells, occupied: occupied}, nil
+}
+
+// place занимает место для товара: в ячейке, которую выбрал сотрудник, или,
+// если код пуст, в ячейке с наибольшим числом свободных мест (при равенстве —
+// с меньшим кодом). nil — в ПВЗ нет ячеек или все заняты, товар остаётся
+// без ячейки.
+func (p *cellPlan) place(code string) (*models.PVZCell, error) {
+	var best *models.PVZCell
+	bestFree := 0
+	for _, c := range p.cells {
+		free := c.Capacity - p.occupied[c.ID]
+		if code != "" {
+			if c.Code != code {
+				continue
+			}
+			if free <= 0 {
+				return nil, errs.Conflict("cell " + c.Code + " is full")
+			}
+			best = c
+			break
+		}
+		if free > bestFree {
+			best, bestFree = c, free
+		}
+	}
+
+	if best == nil {
+		if code != "" {
+			return nil, errs.NotFound("cell " + code + " not found")
+		}
+		return nil, nil
+	}
+
+	p.occupied[best.ID]++
+	return best, nil
+}
+
+// withCell кладёт товар в ячейку при сохранении; nil — без ячейки.
+func withCell(cell *models.PVZCell) func(*models.Product) {
+	return func(p *models.Product) {
+		if cell != nil {
+			setCell(p, cell)
+		}
+	}
+}
+
+func setCell(p *models.Product, cell *models.PVZCell) {
+	p.CellID = null.StringFrom(cell.ID)
+	if p.R == nil {
+		p.R = p.R.NewStruct()
+	}
+	p.R.Cell = cell
+}
+
+func cellCode(p *models.Product) string {
+	if cell := p.R.GetCell(); cell != nil {
+		return cell.Code
+	}
+	return ""
+}
+
+func isStored(p *models.Product) bool {
+	return p.Status == constants.ProductReceived || p.Status == constants.ProductReadyForPickup
+}
+
+// normalizeCellCode приводит код к верхнему регистру и проверяет формат:
+// сегменты из латинских букв и цифр через дефис, например A-01-03.
+func normalizeCellCode(code string) (string, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" || len(code) > maxCellCodeLen {
+		return "", false
+	}
+
+	prev := '-'
+	for _, c := range code {
+		switch {
+		case c == '-':
+			if prev == '-' {
+				return "", false
+			}
+		case (c < 'A' || c > 'Z') && (c < '0' || c > '9'):
+			return "", false
+		}
+		prev = c
+	}
+	if prev == '-' {
+		return "", false
+	}
+
+	return code, true
+}
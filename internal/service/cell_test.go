@@ -0,0 +1,266 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+type cellTestEnv struct {
+	store      *fakeStore
+	products   *ProductService
+	receptions *ReceptionService
+	issuance   *IssuanceService
+	cells      *CellService
+}
+
+// newCellTestEnv: testEmployeeID закреплён за ПВЗ "1", в нём открыта приёмка
+// и заведены ячейки с заданной вместимостью.
+func newCellTestEnv(t *testing.T, capacities map[string]int) *cellTestEnv {
+	t.Helper()
+
+	store := newFakeStore()
+	tx := &fakeTx{store: store}
+	receptionRepo := &fakeReceptionRepo{store: store}
+	productRepo := &fakeProductRepo{store: store}
+	cellRepo := &fakeCellRepo{store: store}
+	staffRepo := newFakeStaffRepo()
+	_, _ = staffRepo.Assign(context.Background(), "1", testEmployeeID, "")
+	types := NewProductTypeService(newFakeProductTypeRepo("обувь", "одежда"), time.Minute)
+	audit := &fakeAuditRepo{store: store}
+	outbox := &fakeOutboxRepo{store: store}
+	pvzRepo := &fakePVZRepo{pvz: []*models.PVZ{{ID: 1}, {ID: 2}}, store: store}
+
+	env := &cellTestEnv{
+		store:      store,
+		products:   NewProductService(productRepo, receptionRepo, pvzRepo, cellRepo, staffRepo, types, outbox, tx),
+		receptions: NewReceptionService(receptionRepo, pvzRepo, productRepo, staffRepo, types, audit, outbox, tx, time.Hour),
+		issuance:   NewIssuanceService(productRepo, pvzRepo, staffRepo, audit, outbox, tx),
+		cells:      NewCellService(cellRepo, productRepo, receptionRepo, pvzRepo, staffRepo, audit, outbox, tx),
+	}
+
+	for code, size := range capacities {
+		if _, err := env.cells.Create(context.Background(), "1", code, &size, constants.RoleModerator); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := env.receptions.CreateReception(employeeCtx(testEmployeeID), "1", constants.RoleEmployee); err != nil {
+		t.Fatal(err)
+	}
+
+	return env
+}
+
+func (env *cellTestEnv) scan(t *testing.T, barcode, cell string) *models.Product {
+	t.Helper()
+
+	p, _, err := env.products.ScanProduct(employeeCtx(testEmployeeID), "1", constants.RoleEmployee, ProductInput{Type: "обувь", Barcode: barcode, Cell: cell})
+	if err != nil {
+		t.Fatalf("scan %s: %v", barcode, err)
+	}
+	return p
+}
+
+func TestCreateCell(t *testing.T) {
+	env := newCellTestEnv(t, nil)
+	ctx := context.Background()
+
+	cell, err := env.cells.Create(ctx, "1", " a-01-03 ", nil, constants.RoleModerator)
+	if err != nil {
+		t.Fatalf("create cell: %v", err)
+	}
+	if cell.Code != "A-01-03" || cell.Capacity != 1 {
+		t.Fatalf("cell = %s/%d, want A-01-03/1", cell.Code, cell.Capacity)
+	}
+
+	if _, err := env.cells.Create(ctx, "1", "A-01-03", nil, constants.RoleModerator); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict for duplicate code, got %v", err)
+	}
+	if _, err := env.cells.Create(ctx, "2", "A-01-03", nil, constants.RoleModerator); err != nil {
+		t.Fatalf("same code in another PVZ: %v", err)
+	}
+	if _, err := env.cells.Create(ctx, "1", "B-01", nil, constants.RoleEmployee); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for employee, got %v", err)
+	}
+
+	zero := 0
+	for _, code := range []string{"", "A--01", "-A", "A-", "A_01", "Я-01"} {
+		if _, err := env.cells.Create(ctx, "1", code, nil, constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+			t.Fatalf("code %q: expected validation error, got %v", code, err)
+		}
+	}
+	if _, err := env.cells.Create(ctx, "1", "B-01", &zero, constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error for zero capacity, got %v", err)
+	}
+}
+
+func TestAddProduct_SuggestsFreestCell(t *testing.T) {
+	env := newCellTestEnv(t, map[string]int{"A-01": 2, "B-01": 3})
+
+	var got []string
+	for _, bc := range []string{"1", "2", "3", "4", "5", "6"} {
+		got = append(got, cellCode(env.scan(t, bc, "")))
+	}
+
+	// Свободных мест поровну — берётся ячейка с меньшим кодом; когда все
+	// заняты, товар принимается без ячейки.
+	want := []string{"B-01", "A-01", "B-01", "A-01", "B-01", ""}
+	if !slices.Equal(got, want) {
+		t.Fatalf("cells = %v, want %v", got, want)
+	}
+}
+
+func TestAddProduct_ChosenCell(t *testing.T) {
+	env := newCellTestEnv(t, map[string]int{"A-01": 1, "B-01": 1})
+	ctx := employeeCtx(testEmployeeID)
+
+	if p := env.scan(t, "1", "b-01"); cellCode(p) != "B-01" {
+		t.Fatalf("cell = %q, want B-01", cellCode(p))
+	}
+
+	if _, _, err := env.products.ScanProduct(ctx, "1", constants.RoleEmployee, ProductInput{Type: "обувь", Barcode: "2", Cell: "B-01"}); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict for full cell, got %v", err)
+	}
+	if _, _, err := env.products.ScanProduct(ctx, "1", constants.RoleEmployee, ProductInput{Type: "обувь", Barcode: "2", Cell: "C-01"}); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected not found for unknown cell, got %v", err)
+	}
+	if len(env.store.products) != 1 {
+		t.Fatalf("expected 1 product, got %d", len(env.store.products))
+	}
+}
+
+func TestAddProducts_AssignsCellsInOrder(t *testing.T) {
+	env := newCellTestEnv(t, map[string]int{"A-01": 1, "B-01": 2})
+	ctx := employeeCtx(testEmployeeID)
+
+	results, _, err := env.products.AddProducts(ctx, "1", constants.RoleEmployee, []ProductInput{
+		{Type: "обувь", Cell: "B-01"},
+		{Type: "обувь"},
+		{Type: "обувь"},
+	})
+	if err != nil {
+		t.Fatalf("add products: %v", err)
+	}
+
+	var got []string
+	for _, r := range results {
+		got = append(got, cellCode(r.Product))
+	}
+	if want := []string{"B-01", "A-01", "B-01"}; !slices.Equal(got, want) {
+		t.Fatalf("cells = %v, want %v", got, want)
+	}
+
+	results, _, err = env.products.AddProducts(ctx, "1", constants.RoleEmployee, []ProductInput{
+		{Type: "обувь"},
+		{Type: "обувь", Cell: "A-01"},
+	})
+	if !errors.Is(err, errs.ErrConflict) || results[1].Err == nil {
+		t.Fatalf("expected conflict for full cell in batch, got %v", err)
+	}
+	if len(env.store.products) != 3 {
+		t.Fatalf("expected 3 products, got %d", len(env.store.products))
+	}
+}
+
+func TestMoveProduct(t *testing.T) {
+	env := newCellTestEnv(t, map[string]int{"A-01": 1, "B-01": 1})
+	ctx := employeeCtx(testEmployeeID)
+
+	first := env.scan(t, "1", "A-01")
+	env.scan(t, "2", "B-01")
+
+	if _, err := env.cells.MoveProduct(ctx, first.ID, "B-01", constants.RoleEmployee); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict when moving into a full cell, got %v", err)
+	}
+
+	if _, err := env.cells.Create(context.Background(), "1", "C-01", nil, constants.RoleModerator); err != nil {
+		t.Fatal(err)
+	}
+	moved, err := env.cells.MoveProduct(ctx, first.ID, "c-01", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("move product: %v", err)
+	}
+	if cellCode(moved) != "C-01" {
+		t.Fatalf("cell = %q, want C-01", cellCode(moved))
+	}
+	if got := env.store.eventTypes(); got[len(got)-1] != events.ProductMoved {
+		t.Fatalf("events = %v, want %s last", got, events.ProductMoved)
+	}
+	if a := env.store.audit[len(env.store.audit)-1]; a.Action != constants.ProductActionMoved || a.ProductID != first.ID {
+		t.Fatalf("audit = %s %s, want moved %s", a.Action, a.ProductID, first.ID)
+	}
+
+	// Освободившееся место снова доступно.
+	if p := env.scan(t, "3", ""); cellCode(p) != "A-01" {
+		t.Fatalf("cell = %q, want A-01", cellCode(p))
+	}
+
+	if _, err := env.cells.MoveProduct(employeeCtx("stranger"), first.ID, "A-01", constants.RoleEmployee); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for unassigned employee, got %v", err)
+	}
+}
+
+func TestMoveProduct_NotStored(t *testing.T) {
+	env := newCellTestEnv(t, map[string]int{"A-01": 1, "B-01": 1})
+	ctx := employeeCtx(testEmployeeID)
+
+	p := env.scan(t, "1", "A-01")
+	if _, err := env.receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := env.issuance.Issue(ctx, "1", constants.RoleEmployee, IssueInput{PickupCode: env.store.products[0].PickupCode.String}); err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+
+	if _, err := env.cells.MoveProduct(ctx, p.ID, "B-01", constants.RoleEmployee); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict for issued product, got %v", err)
+	}
+}
+
+func TestListStored_ByCell(t *testing.T) {
+	env := newCellTestEnv(t, map[string]int{"A-01": 2, "B-01": 2})
+	ctx := employeeCtx(testEmployeeID)
+
+	env.scan(t, "1", "A-01")
+	env.scan(t, "2", "B-01")
+	inA := env.scan(t, "3", "A-01")
+
+	stored, _, err := env.issuance.ListStored(ctx, "1", "", "", "a-01", "", 10, constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("list stored: %v", err)
+	}
+	if len(stored) != 2 || stored[0].ID != inA.ID {
+		t.Fatalf("expected 2 products of A-01 newest first, got %d", len(stored))
+	}
+
+	if _, _, err := env.issuance.ListStored(ctx, "1", "", "", "A 01", "", 10, constants.RoleEmployee); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error for bad cell code, got %v", err)
+	}
+}
+
+func TestDeleteCell(t *testing.T) {
+	env := newCellTestEnv(t, map[string]int{"A-01": 1, "B-01": 1})
+
+	env.scan(t, "1", "A-01")
+
+	if err := env.cells.Delete(context.Background(), "1", "A-01", constants.RoleModerator); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict for non-empty cell, got %v", err)
+	}
+	if err := env.cells.Delete(context.Background(), "1", "B-01", constants.RoleModerator); err != nil {
+		t.Fatalf("delete cell: %v", err)
+	}
+
+	cells, err := env.cells.List(employeeCtx(testEmployeeID), "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("list cells: %v", err)
+	}
+	if len(cells) != 1 || cells[0].Cell.Code != "A-01" || cells[0].Occupied != 1 {
+		t.Fatalf("unexpected cells after delete: %+v", cells)
+	}
+}
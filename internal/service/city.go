@@ -0,0 +1,110 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"context"
+	"strings"
+	"time"
+)
+
+type CityService struct {
+	repo CityRepository
+}
+
+func NewCityService(repo CityRepository) *CityService {
+	return &CityService{repo: repo}
+}
+
+func (s *CityService) List(ctx context.Context, userRole string) (models.CitySlice, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	cities, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list cities")
+	}
+
+	return cities, nil
+}
+
+func (s *CityService) Create(ctx context.Context, name, region, timezone string, enabled *bool, userRole string) (*models.City, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	city := &models.City{
+		Name:     strings.TrimSpace(name),
+		Region:   region,
+		Timezone: timezone,
+		Enabled:  enabled == nil || *enabled,
+	}
+	if err := validateCity(city); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, city); err != nil {
+		return nil, errs.Wrap(err, "failed to create city")
+	}
+
+	return city, nil
+}
+
+// Update меняет данные города; enabled == nil оставляет флаг как есть.
+// Выключенный город остаётся у существующих ПВЗ, но новые в нём не создаются.
+func (s *CityService) Update(ctx context.Context, id, name, region, timezone string, enabled *bool, userRole string) (*models.City, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	city, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get city")
+	}
+	if city == nil {
+		return nil, errs.NotFound("city not found")
+	}
+
+	city.Name = strings.TrimSpace(name)
+	city.Region = region
+	city.Timezone = timezone
+	if enabled != nil {
+		city.Enabled = *enabled
+	}
+	if err := validateCity(city); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, city); err != nil {
+		return nil, errs.Wrap(err, "failed to update city")
+	}
+
+	return city, nil
+}
+
+func (s *CityService) Delete(ctx context.Context, id, userRole string) error {
+	if userRole != constants.RoleModerator {
+		return errs.Forbidden("access denied")
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return errs.Wrap(err, "failed to delete city")
+	}
+
+	return nil
+}
+
+func validateCity(city *models.City) error {
+	if city.Name == "" || len([]rune(city.Name)) > 50 {
+		return errs.Validation("invalid city name")
+	}
+	if city.Timezone == "" {
+		return errs.Validation("timezone is required")
+	}
+	if _, err := time.LoadLocation(city.Timezone); err != nil {
+		return errs.Validation("invalid timezone")
+	}
+	return nil
+}
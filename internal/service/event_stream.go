@@ -0,0 +1,48 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"context"
+	"strconv"
+)
+
+type EventStreamService struct {
+	pvzs  PVZRepository
+	staff StaffRepository
+	hub   EventHub
+}
+
+func NewEventStreamService(pvzs PVZRepository, staff StaffRepository, hub EventHub) *EventStreamService {
+	return &EventStreamService{pvzs: pvzs, staff: staff, hub: hub}
+}
+
+// Subscribe открывает ленту событий ПВЗ: модератору — любого, сотруднику —
+// только того, к которому он назначен. Подписку нужно закрыть.
+func (s *EventStreamService) Subscribe(ctx context.Context, pvzID, userRole string) (*events.Subscription, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	id, err := strconv.ParseInt(pvzID, 10, 64)
+	if err != nil {
+		return nil, errs.Validation("invalid PVZ id")
+	}
+
+	pvz, err := s.pvzs.GetByID(ctx, pvzID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get PVZ")
+	}
+	if pvz == nil {
+		return nil, errs.NotFound("PVZ not found")
+	}
+
+	if userRole == constants.RoleEmployee {
+		if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.hub.Subscribe(id), nil
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestEventStreamSubscribeAccess(t *testing.T) {
+	staff := newFakeStaffRepo()
+	if _, err := staff.Assign(context.Background(), "1", "emp-1", "mod-1"); err != nil {
+		t.Fatal(err)
+	}
+	hub := events.NewHub(1)
+	svc := NewEventStreamService(&fakePVZRepo{pvz: []*models.PVZ{{ID: 1}, {ID: 2}}}, staff, hub)
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		pvzID string
+		role  string
+		kind  error
+	}{
+		{"assigned employee", employeeCtx("emp-1"), "1", constants.RoleEmployee, nil},
+		{"moderator", context.Background(), "2", constants.RoleModerator, nil},
+		{"other PVZ", employeeCtx("emp-1"), "2", constants.RoleEmployee, errs.ErrForbidden},
+		{"unknown role", context.Background(), "1", "client", errs.ErrForbidden},
+		{"missing PVZ", context.Background(), "3", constants.RoleModerator, errs.ErrNotFound},
+		{"invalid id", context.Background(), "abc", constants.RoleModerator, errs.ErrValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, err := svc.Subscribe(tt.ctx, tt.pvzID, tt.role)
+			if tt.kind != nil {
+				if !errors.Is(err, tt.kind) {
+					t.Fatalf("err = %v, want %v", err, tt.kind)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			sub.Close()
+		})
+	}
+
+	if n := hub.Subscribers(); n != 0 {
+		t.Fatalf("subscribers = %d, want 0", n)
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"context"
+	"encoding/json"
+
+	"github.com/aarondl/sqlboiler/v4/types"
+)
+
+// addEvent пишет доменное событие в outbox. Вызывать нужно внутри той же
+// транзакции, что и изменение данных, иначе событие может потеряться.
+func addEvent(ctx context.Context, outbox OutboxRepository, eventType, aggregateType, aggregateID string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return errs.Wrap(err, "failed to marshal event")
+	}
+
+	err = outbox.Add(ctx, &models.Outbox{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       types.JSON(data),
+	})
+	if err != nil {
+		return errs.Wrap(err, "failed to add event to outbox")
+	}
+	return nil
+}
+
+func productPayload(p *models.Product, pvzID int64, actorID string) events.ProductPayload {
+	return events.ProductPayload{
+		ID:          p.ID,
+		ReceptionID: p.ReceptionID,
+		PVZID:       pvzID,
+		Type:        p.Type,
+		Barcode:     p.Barcode.String,
+		ActorID:     actorID,
+
+		ReturnReason:      p.ReturnReason.String,
+		OriginalProductID: p.OriginalProductID.String,
+
+		Cell: cellCode(p),
+	}
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestReceptionLifecycle_WritesOutboxEvents(t *testing.T) {
+	store, products, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	rec, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	if _, _, err := products.AddProduct(ctx, "1", constants.RoleEmployee, "обувь"); err != nil {
+		t.Fatalf("add product: %v", err)
+	}
+	if _, err := receptions.DeleteLastProduct(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("delete last product: %v", err)
+	}
+	if _, err := receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close reception: %v", err)
+	}
+
+	want := []string{events.ReceptionCreated, events.ProductAdded, events.ProductDeleted, events.ReceptionClosed}
+	if got := store.eventTypes(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+
+	closed := store.outbox[len(store.outbox)-1]
+	if closed.AggregateType != events.AggregateReception || closed.AggregateID != rec.ID {
+		t.Fatalf("unexpected aggregate %s/%s", closed.AggregateType, closed.AggregateID)
+	}
+
+	var payload events.ReceptionPayload
+	if err := json.Unmarshal(closed.Payload, &payload); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if payload.FromStatus != constants.ReceptionInProgress || payload.Status != constants.ReceptionClosed || payload.ChangedBy != testEmployeeID {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestCreatePVZ_WritesOutboxEvent(t *testing.T) {
+	store := newFakeStore()
+	cities := newFakeCityRepo(&models.City{ID: 1, Name: "Москва", Timezone: "Europe/Moscow", Enabled: true})
+	svc := NewPVZService(&fakePVZRepo{}, newFakeStaffRepo(), &fakeUserRepo{}, cities, &fakeOutboxRepo{store: store}, &fakeTx{store: store})
+
+	if _, err := svc.CreatePVZ(context.Background(), "ПВЗ №1", "Москва", constants.RoleModerator); err != nil {
+		t.Fatalf("create PVZ: %v", err)
+	}
+
+	if got := store.eventTypes(); !reflect.DeepEqual(got, []string{events.PVZCreated}) {
+		t.Fatalf("events = %v", got)
+	}
+	if store.outbox[0].AggregateID != "1" {
+		t.Fatalf("aggregate id = %q", store.outbox[0].AggregateID)
+	}
+}
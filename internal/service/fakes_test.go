@@ -0,0 +1,926 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/auth"
+	"PVZ/pkg/pagination"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+// fakeStore имитирует БД: Do держит mu на всю транзакцию так же, как
+// SELECT ... FOR UPDATE держит блокировку строки активной приёмки.
+type fakeStore struct {
+	mu         sync.Mutex
+	seq        int
+	receptions map[string]*models.Reception
+	products   []*models.Product
+	audit      []*models.ProductAuditLog
+	history    []*models.StatusHistory
+	outbox     []*models.Outbox
+	cells      []*models.PVZCell
+
+	// productsInClosed считает товары, попавшие в уже закрытую приёмку.
+	productsInClosed int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{receptions: map[string]*models.Reception{}}
+}
+
+func (s *fakeStore) nextID() string {
+	s.seq++
+	return fmt.Sprintf("00000000-0000-7000-8000-%012d", s.seq)
+}
+
+type fakeTx struct {
+	store *fakeStore
+}
+
+func (t *fakeTx) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	t.store.mu.Lock()
+	defer t.store.mu.Unlock()
+	return fn(ctx)
+}
+
+type fakeReceptionRepo struct {
+	store *fakeStore
+}
+
+func (r *fakeReceptionRepo) CreateReception(ctx context.Context, pvzID, kind, createdBy string) (*models.Reception, error) {
+	id, err := strconv.ParseInt(pvzID, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid PVZ ID format")
+	}
+
+	rec := &models.Reception{
+		ID:        r.store.nextID(),
+		PVZID:     id,
+		Status:    constants.ReceptionInProgress,
+		Kind:      kind,
+		DateTime:  time.Now(),
+		CreatedBy: null.NewString(createdBy, createdBy != ""),
+	}
+	r.store.receptions[rec.ID] = rec
+	cp := *rec
+	return &cp, nil
+}
+
+func (r *fakeReceptionRepo) GetActiveByPVZ(ctx context.Context, pvzID, kind string) (*models.Reception, error) {
+	for _, rec := range r.store.receptions {
+		if strconv.FormatInt(rec.PVZID, 10) == pvzID && rec.Kind == kind && rec.Status == constants.ReceptionInProgress {
+			cp := *rec
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeReceptionRepo) GetActiveByPVZForUpdate(ctx context.Context, pvzID, kind string) (*models.Reception, error) {
+	return r.GetActiveByPVZ(ctx, pvzID, kind)
+}
+
+func (r *fakeReceptionRepo) UpdateStatus(ctx context.Context, rec *models.Reception) error {
+	stored, ok := r.store.receptions[rec.ID]
+	if !ok {
+		return errors.New("reception not found")
+	}
+	stored.Status = rec.Status
+	stored.ClosedBy = rec.ClosedBy
+	stored.ClosedAt = rec.ClosedAt
+	stored.ReopenedAt = rec.ReopenedAt
+	return nil
+}
+
+func (r *fakeReceptionRepo) DeleteLastProduct(ctx context.Context, receptionID string) (*models.Product, error) {
+	products := r.store.products
+	for i := len(products) - 1; i >= 0; i-- {
+		if products[i].ReceptionID == receptionID {
+			deleted := products[i]
+			r.store.products = append(products[:i], products[i+1:]...)
+			return deleted, nil
+		}
+	}
+	return nil, errs.NotFound("no products to delete")
+}
+
+func (r *fakeReceptionRepo) DeleteProduct(ctx context.Context, receptionID, productID string) (*models.Product, error) {
+	for i, p := range r.store.products {
+		if p.ID == productID && p.ReceptionID == receptionID {
+			r.store.products = append(r.store.products[:i], r.store.products[i+1:]...)
+			return p, nil
+		}
+	}
+	return nil, errs.NotFound("product not found in this reception")
+}
+
+func (r *fakeReceptionRepo) GetByIDForUpdate(ctx context.Context, receptionID string) (*models.Reception, error) {
+	return r.GetByID(ctx, receptionID)
+}
+
+func (r *fakeReceptionRepo) GetByID(ctx context.Context, receptionID string) (*models.Reception, error) {
+	rec, ok := r.store.receptions[receptionID]
+	if !ok {
+		return nil, nil
+	}
+	cp := *rec
+	return &cp, nil
+}
+
+func (r *fakeReceptionRepo) ListByPVZ(ctx context.Context, pvzID, status string, startDate, endDate *time.Time, after *pagination.Cursor, limit int) (models.ReceptionSlice, error) {
+	var list models.ReceptionSlice
+	for _, rec := range r.store.receptions {
+		if strconv.FormatInt(rec.PVZID, 10) != pvzID || (status != "" && rec.Status != status) {
+			continue
+		}
+		if after != nil && !rec.DateTime.Before(after.Time) && !(rec.DateTime.Equal(after.Time) && rec.ID < after.ID) {
+			continue
+		}
+		cp := *rec
+		list = append(list, &cp)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].DateTime.Equal(list[j].DateTime) {
+			return list[i].DateTime.After(list[j].DateTime)
+		}
+		return list[i].ID > list[j].ID
+	})
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *fakeReceptionRepo) ListStaleInProgress(ctx context.Context, olderThan time.Time, limit int) (models.ReceptionSlice, error) {
+	var list models.ReceptionSlice
+	for _, rec := range r.store.receptions {
+		if rec.Status == constants.ReceptionInProgress && openedAt(rec).Before(olderThan) {
+			cp := *rec
+			list = append(list, &cp)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool { return openedAt(list[i]).Before(openedAt(list[j])) })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+type fakeProductRepo struct {
+	store *fakeStore
+}
+
+func (r *fakeProductRepo) AddProduct(ctx context.Context, product *models.Product) error {
+	rec, ok := r.store.receptions[product.ReceptionID]
+	if !ok {
+		return errors.New("reception not found")
+	}
+	if rec.Status != constants.ReceptionInProgress {
+		r.store.productsInClosed++
+	}
+
+	product.ID = r.store.nextID()
+	product.AddedAt = time.Now()
+	cp := *product
+	r.store.products = append(r.store.products, &cp)
+	return nil
+}
+
+// LockBarcode ничего не делает: fakeTx и так держит общий lock на всю транзакцию.
+func (r *fakeProductRepo) LockBarcode(ctx context.Context, barcode string) error {
+	return nil
+}
+
+func (r *fakeProductRepo) AddProducts(ctx context.Context, products []*models.Product) error {
+	for _, p := range products {
+		if err := r.AddProduct(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeProductRepo) FindOpenByBarcodes(ctx context.Context, barcodes []string) (models.ProductSlice, error) {
+	want := make(map[string]bool, len(barcodes))
+	for _, bc := range barcodes {
+		want[bc] = true
+	}
+
+	var found models.ProductSlice
+	for _, p := range r.store.products {
+		if !want[p.Barcode.String] {
+			continue
+		}
+		if rec := r.store.receptions[p.ReceptionID]; rec != nil && rec.Status == constants.ReceptionInProgress {
+			cp := *p
+			found = append(found, &cp)
+		}
+	}
+	return found, nil
+}
+
+func (r *fakeProductRepo) ListByReception(ctx context.Context, receptionID string) (models.ProductSlice, error) {
+	var list models.ProductSlice
+	for _, p := range r.store.products {
+		if p.ReceptionID == receptionID {
+			cp := *p
+			list = append(list, &cp)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeProductRepo) GetByIDForUpdate(ctx context.Context, id string) (*models.Product, error) {
+	for _, p := range r.store.products {
+		if p.ID == id {
+			cp := *p
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeProductRepo) FindIssuedByBarcodeForUpdate(ctx context.Context, pvzID int64, barcode string) (*models.Product, error) {
+	var found *models.Product
+	for _, p := range r.store.products {
+		if r.store.receptions[p.ReceptionID].PVZID == pvzID && p.Barcode.String == barcode && p.Status == constants.ProductIssued &&
+			(found == nil || p.IssuedAt.Time.After(found.IssuedAt.Time)) {
+			found = p
+		}
+	}
+	if found == nil {
+		return nil, nil
+	}
+	cp := *found
+	return &cp, nil
+}
+
+func (r *fakeProductRepo) UpdateLifecycle(ctx context.Context, p *models.Product) error {
+	for _, stored := range r.store.products {
+		if stored.ID == p.ID {
+			stored.Status = p.Status
+			stored.PickupCode = p.PickupCode
+			stored.StatusChangedAt = p.StatusChangedAt
+			stored.IssuedAt = p.IssuedAt
+			stored.IssuedBy = p.IssuedBy
+			stored.StorageExpiresAt = p.StorageExpiresAt
+			stored.OverdueReportedAt = p.OverdueReportedAt
+			return nil
+		}
+	}
+	return errors.New("product not found")
+}
+
+// pvzProducts отдаёт товары ПВЗ с заданным статусом.
+func (r *fakeProductRepo) pvzProducts(pvzID int64, status string) models.ProductSlice {
+	var list models.ProductSlice
+	for _, p := range r.store.products {
+		rec := r.store.receptions[p.ReceptionID]
+		if rec == nil || rec.PVZID != pvzID || p.Status != status {
+			continue
+		}
+		cp := *p
+		list = append(list, &cp)
+	}
+	return list
+}
+
+func (r *fakeProductRepo) FindPickupCode(ctx context.Context, pvzID int64, orderNumber string) (string, error) {
+	for _, p := range r.pvzProducts(pvzID, constants.ProductReadyForPickup) {
+		if p.OrderNumber.String == orderNumber && p.PickupCode.Valid {
+			return p.PickupCode.String, nil
+		}
+	}
+	return "", nil
+}
+
+func (r *fakeProductRepo) IsPickupCodeInUse(ctx context.Context, pvzID int64, code string) (bool, error) {
+	for _, p := range r.pvzProducts(pvzID, constants.ProductReadyForPickup) {
+		if p.PickupCode.String == code {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeProductRepo) ListForPickupForUpdate(ctx context.Context, pvzID int64, code string) (models.ProductSlice, error) {
+	var list models.ProductSlice
+	for _, p := range r.pvzProducts(pvzID, constants.ProductReadyForPickup) {
+		if p.PickupCode.String == code {
+			list = append(list, p)
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeProductRepo) ListReadyForUpdate(ctx context.Context, pvzID int64, ids []string, expiredBefore *time.Time, limit int) (models.ProductSlice, error) {
+	var list models.ProductSlice
+	for _, p := range r.pvzProducts(pvzID, constants.ProductReadyForPickup) {
+		if len(ids) > 0 && !slices.Contains(ids, p.ID) {
+			continue
+		}
+		if expiredBefore != nil && (!p.StorageExpiresAt.Valid || !p.StorageExpiresAt.Time.Before(*expiredBefore)) {
+			continue
+		}
+		list = append(list, p)
+	}
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *fakeProductRepo) ListStored(ctx context.Context, pvzID int64, status, pickupCode, cell string, after *pagination.Cursor, limit int) (models.ProductSlice, error) {
+	statuses := []string{constants.ProductReceived, constants.ProductReadyForPickup}
+	if status != "" {
+		statuses = []string{status}
+	}
+
+	var list models.ProductSlice
+	for _, s := range statuses {
+		for _, p := range r.pvzProducts(pvzID, s) {
+			if !occupiesSpace(r.store.receptions[p.ReceptionID]) {
+				continue
+			}
+			if pickupCode != "" && p.PickupCode.String != pickupCode {
+				continue
+			}
+			if cell != "" && r.store.cellCode(p.CellID.String) != cell {
+				continue
+			}
+			list = append(list, p)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].ID > list[j].ID })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *fakeProductRepo) ListOverdue(ctx context.Context, pvzID int64, now time.Time, after *pagination.Cursor, limit int) (models.ProductSlice, error) {
+	var list models.ProductSlice
+	for _, p := range r.pvzProducts(pvzID, constants.ProductReadyForPickup) {
+		if p.StorageExpiresAt.Valid && p.StorageExpiresAt.Time.Before(now) {
+			list = append(list, p)
+		}
+	}
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *fakeProductRepo) ListUnreportedOverdueForUpdate(ctx context.Context, now time.Time, limit int) (models.ProductSlice, error) {
+	var list models.ProductSlice
+	for _, p := range r.store.products {
+		if p.Status != constants.ProductReadyForPickup || p.OverdueReportedAt.Valid ||
+			!p.StorageExpiresAt.Valid || !p.StorageExpiresAt.Time.Before(now) {
+			continue
+		}
+		cp := *p
+		cp.R = cp.R.NewStruct()
+		cp.R.Reception = r.store.receptions[p.ReceptionID]
+		list = append(list, &cp)
+		if len(list) == limit {
+			break
+		}
+	}
+	return list, nil
+}
+
+func (r *fakeProductRepo) CountOverdueByPVZ(ctx context.Context, now time.Time) (map[int64]int, error) {
+	counts := make(map[int64]int)
+	for _, p := range r.store.products {
+		if p.Status == constants.ProductReadyForPickup && p.StorageExpiresAt.Valid && p.StorageExpiresAt.Time.Before(now) {
+			counts[r.store.receptions[p.ReceptionID].PVZID]++
+		}
+	}
+	return counts, nil
+}
+
+type fakeUserRepo struct {
+	users map[string]*models.User
+}
+
+func (r *fakeUserRepo) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	for _, u := range r.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeUserRepo) GetByID(ctx context.Context, id string) (*models.User, error) {
+	return r.users[id], nil
+}
+
+func (r *fakeUserRepo) CreateUser(ctx context.Context, user *models.User) error {
+	r.users[user.ID] = user
+	return nil
+}
+
+type fakeTokenRepo struct {
+	refresh map[string]*models.RefreshToken
+	revoked map[string]time.Time
+}
+
+func newFakeTokenRepo() *fakeTokenRepo {
+	return &fakeTokenRepo{
+		refresh: map[string]*models.RefreshToken{},
+		revoked: map[string]time.Time{},
+	}
+}
+
+func (r *fakeTokenRepo) CreateRefreshToken(ctx context.Context, token *models.RefreshToken) error {
+	r.refresh[token.TokenHash] = token
+	return nil
+}
+
+func (r *fakeTokenRepo) GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	return r.refresh[tokenHash], nil
+}
+
+func (r *fakeTokenRepo) RevokeRefreshToken(ctx context.Context, id string) error {
+	for _, t := range r.refresh {
+		if t.ID == id && !t.RevokedAt.Valid {
+			t.RevokedAt = null.TimeFrom(time.Now())
+		}
+	}
+	return nil
+}
+
+func (r *fakeTokenRepo) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	for _, t := range r.refresh {
+		if t.UserID == userID && !t.RevokedAt.Valid {
+			t.RevokedAt = null.TimeFrom(time.Now())
+		}
+	}
+	return nil
+}
+
+func (r *fakeTokenRepo) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	r.revoked[jti] = expiresAt
+	return nil
+}
+
+type fakeStaffRepo struct {
+	mu       sync.Mutex
+	assigned map[string]bool
+}
+
+func newFakeStaffRepo() *fakeStaffRepo {
+	return &fakeStaffRepo{assigned: map[string]bool{}}
+}
+
+func (r *fakeStaffRepo) Assign(ctx context.Context, pvzID, userID, assignedBy string) (*models.PVZStaff, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := pvzID + "/" + userID
+	if r.assigned[key] {
+		return nil, errs.Conflict("user is already assigned to this PVZ")
+	}
+	r.assigned[key] = true
+
+	id, _ := strconv.ParseInt(pvzID, 10, 64)
+	return &models.PVZStaff{PVZID: id, UserID: userID, AssignedBy: null.NewString(assignedBy, assignedBy != "")}, nil
+}
+
+func (r *fakeStaffRepo) Unassign(ctx context.Context, pvzID, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := pvzID + "/" + userID
+	if !r.assigned[key] {
+		return errs.NotFound("user is not assigned to this PVZ")
+	}
+	delete(r.assigned, key)
+	return nil
+}
+
+func (r *fakeStaffRepo) IsAssigned(ctx context.Context, pvzID, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.assigned[pvzID+"/"+userID], nil
+}
+
+func (r *fakeStaffRepo) ListByPVZ(ctx context.Context, pvzID string) ([]*models.PVZStaff, error) {
+	return nil, nil
+}
+
+// employeeCtx — контекст запроса сотрудника с заданным ID.
+func employeeCtx(userID string) context.Context {
+	return auth.WithPrincipal(context.Background(), auth.Principal{UserID: userID, Role: constants.RoleEmployee})
+}
+
+type fakeProductTypeRepo struct {
+	mu    sync.Mutex
+	types map[string]*models.ProductType
+	lists int
+}
+
+func newFakeProductTypeRepo(codes ...string) *fakeProductTypeRepo {
+	r := &fakeProductTypeRepo{types: map[string]*models.ProductType{}}
+	for _, code := range codes {
+		r.types[code] = &models.ProductType{Code: code, NameRu: code, IsActive: true, StorageDays: defaultStorageDays}
+	}
+	return r
+}
+
+func (r *fakeProductTypeRepo) List(ctx context.Context) (models.ProductTypeSlice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lists++
+	list := make(models.ProductTypeSlice, 0, len(r.types))
+	for _, pt := range r.types {
+		cp := *pt
+		list = append(list, &cp)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list, nil
+}
+
+func (r *fakeProductTypeRepo) GetByCode(ctx context.Context, code string) (*models.ProductType, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pt, ok := r.types[code]
+	if !ok {
+		return nil, nil
+	}
+	cp := *pt
+	return &cp, nil
+}
+
+func (r *fakeProductTypeRepo) Create(ctx context.Context, pt *models.ProductType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.types[pt.Code]; ok {
+		return errs.Conflict("product type already exists")
+	}
+	cp := *pt
+	r.types[pt.Code] = &cp
+	return nil
+}
+
+func (r *fakeProductTypeRepo) Update(ctx context.Context, pt *models.ProductType) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.types[pt.Code]; !ok {
+		return errs.NotFound("product type not found")
+	}
+	cp := *pt
+	r.types[pt.Code] = &cp
+	return nil
+}
+
+func (r *fakeProductTypeRepo) Delete(ctx context.Context, code string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.types[code]; !ok {
+		return errs.NotFound("product type not found")
+	}
+	delete(r.types, code)
+	return nil
+}
+
+type fakeCityRepo struct {
+	cities map[string]*models.City
+}
+
+func newFakeCityRepo(cities ...*models.City) *fakeCityRepo {
+	r := &fakeCityRepo{cities: map[string]*models.City{}}
+	for _, c := range cities {
+		r.cities[c.Name] = c
+	}
+	return r
+}
+
+func (r *fakeCityRepo) List(ctx context.Context) (models.CitySlice, error) {
+	list := make(models.CitySlice, 0, len(r.cities))
+	for _, c := range r.cities {
+		list = append(list, c)
+	}
+	return list, nil
+}
+
+func (r *fakeCityRepo) GetByID(ctx context.Context, id string) (*models.City, error) {
+	for _, c := range r.cities {
+		if strconv.FormatInt(c.ID, 10) == id {
+			cp := *c
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeCityRepo) GetByName(ctx context.Context, name string) (*models.City, error) {
+	c, ok := r.cities[name]
+	if !ok {
+		return nil, nil
+	}
+	cp := *c
+	return &cp, nil
+}
+
+func (r *fakeCityRepo) Create(ctx context.Context, city *models.City) error {
+	if _, ok := r.cities[city.Name]; ok {
+		return errs.Conflict("city already exists")
+	}
+	city.ID = int64(len(r.cities) + 1)
+	r.cities[city.Name] = city
+	return nil
+}
+
+func (r *fakeCityRepo) Update(ctx context.Context, city *models.City) error {
+	for name, c := range r.cities {
+		if c.ID == city.ID {
+			delete(r.cities, name)
+			r.cities[city.Name] = city
+			return nil
+		}
+	}
+	return errs.NotFound("city not found")
+}
+
+func (r *fakeCityRepo) Delete(ctx context.Context, id string) error {
+	for name, c := range r.cities {
+		if strconv.FormatInt(c.ID, 10) == id {
+			delete(r.cities, name)
+			return nil
+		}
+	}
+	return errs.NotFound("city not found")
+}
+
+// fakePVZRepo считает занятые места по товарам store, если он задан.
+type fakePVZRepo struct {
+	pvz   []*models.PVZ
+	store *fakeStore
+}
+
+func (r *fakePVZRepo) CreatePVZ(ctx context.Context, name string, city string) (*models.PVZ, error) {
+	p := &models.PVZ{ID: int64(len(r.pvz) + 1), Name: name, City: city, CreatedAt: time.Now()}
+	r.pvz = append(r.pvz, p)
+	return p, nil
+}
+
+func (r *fakePVZRepo) GetByID(ctx context.Context, pvzID string) (*models.PVZ, error) {
+	for _, p := range r.pvz {
+		if strconv.FormatInt(p.ID, 10) == pvzID {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakePVZRepo) GetByIDForUpdate(ctx context.Context, pvzID string) (*models.PVZ, error) {
+	return r.GetByID(ctx, pvzID)
+}
+
+func (r *fakePVZRepo) UpdateCapacity(ctx context.Context, pvz *models.PVZ) error {
+	return nil
+}
+
+func (r *fakePVZRepo) CountStoredProducts(ctx context.Context, pvzID int64) (int, error) {
+	if r.store == nil {
+		return 0, nil
+	}
+
+	n := 0
+	for _, p := range r.store.products {
+		rec := r.store.receptions[p.ReceptionID]
+		if rec.PVZID == pvzID && occupiesSpace(rec) &&
+			(p.Status == constants.ProductReceived || p.Status == constants.ProductReadyForPickup) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *fakePVZRepo) GetPVZList(ctx context.Context, offset, limit int, city string, startDate, endDate *time.Time) ([]*models.PVZ, error) {
+	var list []*models.PVZ
+	for _, p := range r.pvz {
+		if city == "" || p.City == city {
+			list = append(list, p)
+		}
+	}
+	return list, nil
+}
+
+// occupiesSpace повторяет storedReception из репозитория.
+func occupiesSpace(rec *models.Reception) bool {
+	return rec.Status != constants.ReceptionCancelled &&
+		!(rec.Kind == constants.ReceptionKindReturn && rec.Status == constants.ReceptionClosed)
+}
+
+type fakeCellRepo struct {
+	store *fakeStore
+}
+
+func (r *fakeCellRepo) Create(ctx context.Context, cell *models.PVZCell) error {
+	for _, c := range r.store.cells {
+		if c.PVZID == cell.PVZID && c.Code == cell.Code {
+			return errs.Conflict("cell with this code already exists")
+		}
+	}
+
+	cell.ID = r.store.nextID()
+	cell.CreatedAt = time.Now()
+	r.store.cells = append(r.store.cells, cell)
+	return nil
+}
+
+func (r *fakeCellRepo) ListByPVZ(ctx context.Context, pvzID int64) (models.PVZCellSlice, error) {
+	var list models.PVZCellSlice
+	for _, c := range r.store.cells {
+		if c.PVZID == pvzID {
+			list = append(list, c)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list, nil
+}
+
+func (r *fakeCellRepo) GetByCode(ctx context.Context, pvzID int64, code string) (*models.PVZCell, error) {
+	for _, c := range r.store.cells {
+		if c.PVZID == pvzID && c.Code == code {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeCellRepo) Delete(ctx context.Context, id string) error {
+	r.store.cells = slices.DeleteFunc(r.store.cells, func(c *models.PVZCell) bool { return c.ID == id })
+	return nil
+}
+
+func (r *fakeCellRepo) CountStoredByCell(ctx context.Context, pvzID int64) (map[string]int, error) {
+	counts := map[string]int{}
+	for _, p := range r.store.products {
+		rec := r.store.receptions[p.ReceptionID]
+		if p.CellID.Valid && rec.PVZID == pvzID && occupiesSpace(rec) &&
+			(p.Status == constants.ProductReceived || p.Status == constants.ProductReadyForPickup) {
+			counts[p.CellID.String]++
+		}
+	}
+	return counts, nil
+}
+
+func (r *fakeCellRepo) UpdateProductCell(ctx context.Context, p *models.Product) error {
+	for _, stored := range r.store.products {
+		if stored.ID == p.ID {
+			stored.CellID = p.CellID
+			return nil
+		}
+	}
+	return errors.New("product not found")
+}
+
+// cellCode возвращает код ячейки по её ID или пустую строку.
+func (s *fakeStore) cellCode(id string) string {
+	for _, c := range s.cells {
+		if c.ID == id {
+			return c.Code
+		}
+	}
+	return ""
+}
+
+type fakeOutboxRepo struct {
+	store *fakeStore
+}
+
+func (r *fakeOutboxRepo) Add(ctx context.Context, event *models.Outbox) error {
+	event.ID = r.store.nextID()
+	r.store.outbox = append(r.store.outbox, event)
+	return nil
+}
+
+// eventTypes возвращает типы событий outbox в порядке записи.
+func (s *fakeStore) eventTypes() []string {
+	types := make([]string, 0, len(s.outbox))
+	for _, e := range s.outbox {
+		types = append(types, e.EventType)
+	}
+	return types
+}
+
+type fakeAuditRepo struct {
+	store *fakeStore
+}
+
+func (r *fakeAuditRepo) RecordProductAction(ctx context.Context, entry *models.ProductAuditLog) error {
+	r.store.audit = append(r.store.audit, entry)
+	return nil
+}
+
+func (r *fakeAuditRepo) RecordStatusChange(ctx context.Context, entry *models.StatusHistory) error {
+	r.store.history = append(r.store.history, entry)
+	return nil
+}
+
+func (r *fakeAuditRepo) ListStatusHistory(ctx context.Context, receptionID string) (models.StatusHistorySlice, error) {
+	var list models.StatusHistorySlice
+	for _, h := range r.store.history {
+		if h.ReceptionID == receptionID {
+			list = append(list, h)
+		}
+	}
+	return list, nil
+}
+
+type fakeWebhookRepo struct {
+	seq        int
+	hooks      []*models.Webhook
+	deliveries []*models.WebhookDelivery
+}
+
+func newFakeWebhookRepo() *fakeWebhookRepo {
+	return &fakeWebhookRepo{}
+}
+
+func (r *fakeWebhookRepo) Create(ctx context.Context, hook *models.Webhook) error {
+	r.seq++
+	hook.ID = fmt.Sprintf("00000000-0000-7000-9000-%012d", r.seq)
+	r.hooks = append(r.hooks, hook)
+	return nil
+}
+
+func (r *fakeWebhookRepo) List(ctx context.Context) (models.WebhookSlice, error) {
+	return r.hooks, nil
+}
+
+func (r *fakeWebhookRepo) GetByID(ctx context.Context, id string) (*models.Webhook, error) {
+	for _, h := range r.hooks {
+		if h.ID == id {
+			return h, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeWebhookRepo) Delete(ctx context.Context, id string) (bool, error) {
+	for i, h := range r.hooks {
+		if h.ID == id {
+			r.hooks = append(r.hooks[:i], r.hooks[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeWebhookRepo) ListSubscribed(ctx context.Context, eventType string) (models.WebhookSlice, error) {
+	var list models.WebhookSlice
+	for _, h := range r.hooks {
+		var types []string
+		_ = json.Unmarshal(h.EventTypes, &types)
+		if h.Enabled && slices.Contains(types, eventType) {
+			list = append(list, h)
+		}
+	}
+	return list, nil
+}
+
+// AddDelivery повторяет уникальность (webhook_id, event_id).
+func (r *fakeWebhookRepo) AddDelivery(ctx context.Context, d *models.WebhookDelivery) error {
+	for _, existing := range r.deliveries {
+		if existing.WebhookID == d.WebhookID && existing.EventID == d.EventID {
+			return nil
+		}
+	}
+	d.Status = constants.WebhookDeliveryPending
+	r.deliveries = append(r.deliveries, d)
+	return nil
+}
+
+func (r *fakeWebhookRepo) ListDeliveries(ctx context.Context, webhookID string, limit int) (models.WebhookDeliverySlice, error) {
+	var list models.WebhookDeliverySlice
+	for _, d := range r.deliveries {
+		if d.WebhookID == webhookID {
+			list = append(list, d)
+		}
+	}
+	return list, nil
+}
@@ -1,27 +1,140 @@
 package service
 
 import (
+	"PVZ/internal/events"
 	"PVZ/models"
+	"PVZ/pkg/pagination"
 	"context"
+	"time"
 )
 
+type TxManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*models.User, error)
+	GetByID(ctx context.Context, id string) (*models.User, error)
 	CreateUser(ctx context.Context, user *models.User) error
 }
 
+type TokenRepository interface {
+	CreateRefreshToken(ctx context.Context, token *models.RefreshToken) error
+	GetRefreshTokenForUpdate(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, id string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+}
+
 type ProductRepository interface {
-	AddProduct(ctx context.Context, receptionID string, productType string) (*models.Product, error)
+	AddProduct(ctx context.Context, product *models.Product) error
+	LockBarcode(ctx context.Context, barcode string) error
+	AddProducts(ctx context.Context, products []*models.Product) error
+	FindOpenByBarcodes(ctx context.Context, barcodes []string) (models.ProductSlice, error)
+	GetByIDForUpdate(ctx context.Context, id string) (*models.Product, error)
+	FindIssuedByBarcodeForUpdate(ctx context.Context, pvzID int64, barcode string) (*models.Product, error)
+	UpdateLifecycle(ctx context.Context, p *models.Product) error
+}
+
+type ProductTypeRepository interface {
+	List(ctx context.Context) (models.ProductTypeSlice, error)
+	GetByCode(ctx context.Context, code string) (*models.ProductType, error)
+	Create(ctx context.Context, pt *models.ProductType) error
+	Update(ctx context.Context, pt *models.ProductType) error
+	Delete(ctx context.Context, code string) error
+}
+
+// ProductTypeCatalog проверяет тип товара по справочнику.
+type ProductTypeCatalog interface {
+	IsActive(ctx context.Context, code string) (bool, error)
+	StoragePeriod(ctx context.Context, code string) (time.Duration, error)
+}
+
+type CityRepository interface {
+	List(ctx context.Context) (models.CitySlice, error)
+	GetByID(ctx context.Context, id string) (*models.City, error)
+	GetByName(ctx context.Context, name string) (*models.City, error)
+	Create(ctx context.Context, city *models.City) error
+	Update(ctx context.Context, city *models.City) error
+	Delete(ctx context.Context, id string) error
 }
 
 type PVZRepository interface {
 	CreatePVZ(ctx context.Context, name string, city string) (*models.PVZ, error)
-	GetPVZList(ctx context.Context, offset, limit int, city string) ([]*models.PVZ, error)
+	GetByID(ctx context.Context, pvzID string) (*models.PVZ, error)
+	GetByIDForUpdate(ctx context.Context, pvzID string) (*models.PVZ, error)
+	GetPVZList(ctx context.Context, offset, limit int, city string, startDate, endDate *time.Time) ([]*models.PVZ, error)
+	UpdateCapacity(ctx context.Context, pvz *models.PVZ) error
+	CountStoredProducts(ctx context.Context, pvzID int64) (int, error)
+}
+
+// CellRepository — ячейки хранения внутри ПВЗ и раскладка товаров по ним.
+type CellRepository interface {
+	Create(ctx context.Context, cell *models.PVZCell) error
+	ListByPVZ(ctx context.Context, pvzID int64) (models.PVZCellSlice, error)
+	GetByCode(ctx context.Context, pvzID int64, code string) (*models.PVZCell, error)
+	Delete(ctx context.Context, id string) error
+	CountStoredByCell(ctx context.Context, pvzID int64) (map[string]int, error)
+	UpdateProductCell(ctx context.Context, p *models.Product) error
 }
 
 type ReceptionRepository interface {
-	CreateReception(ctx context.Context, pvzID string) (*models.Reception, error)
-	GetActiveByPVZ(ctx context.Context, pvzID string) (*models.Reception, error)
-	CloseReception(ctx context.Context, pvzID string) error
-	DeleteLastProduct(ctx context.Context, receptionID string) (*models.Reception, error)
+	CreateReception(ctx context.Context, pvzID, kind, createdBy string) (*models.Reception, error)
+	GetActiveByPVZ(ctx context.Context, pvzID, kind string) (*models.Reception, error)
+	GetActiveByPVZForUpdate(ctx context.Context, pvzID, kind string) (*models.Reception, error)
+	UpdateStatus(ctx context.Context, rec *models.Reception) error
+	DeleteLastProduct(ctx context.Context, receptionID string) (*models.Product, error)
+	DeleteProduct(ctx context.Context, receptionID, productID string) (*models.Product, error)
+	GetByID(ctx context.Context, receptionID string) (*models.Reception, error)
+	GetByIDForUpdate(ctx context.Context, receptionID string) (*models.Reception, error)
+	ListByPVZ(ctx context.Context, pvzID, status string, startDate, endDate *time.Time, after *pagination.Cursor, limit int) (models.ReceptionSlice, error)
+	ListStaleInProgress(ctx context.Context, olderThan time.Time, limit int) (models.ReceptionSlice, error)
+}
+
+type OutboxRepository interface {
+	Add(ctx context.Context, event *models.Outbox) error
+}
+
+type WebhookRepository interface {
+	Create(ctx context.Context, hook *models.Webhook) error
+	List(ctx context.Context) (models.WebhookSlice, error)
+	GetByID(ctx context.Context, id string) (*models.Webhook, error)
+	Delete(ctx context.Context, id string) (bool, error)
+	ListSubscribed(ctx context.Context, eventType string) (models.WebhookSlice, error)
+	AddDelivery(ctx context.Context, d *models.WebhookDelivery) error
+	ListDeliveries(ctx context.Context, webhookID string, limit int) (models.WebhookDeliverySlice, error)
+}
+
+// ProductLifecycleRepository — статусы товаров после приёмки: подготовка к
+// выдаче, выдача и то, что хранится в ПВЗ.
+type ProductLifecycleRepository interface {
+	ListByReception(ctx context.Context, receptionID string) (models.ProductSlice, error)
+	GetByIDForUpdate(ctx context.Context, id string) (*models.Product, error)
+	UpdateLifecycle(ctx context.Context, p *models.Product) error
+	FindPickupCode(ctx context.Context, pvzID int64, orderNumber string) (string, error)
+	IsPickupCodeInUse(ctx context.Context, pvzID int64, code string) (bool, error)
+	ListForPickupForUpdate(ctx context.Context, pvzID int64, code string) (models.ProductSlice, error)
+	ListReadyForUpdate(ctx context.Context, pvzID int64, ids []string, expiredBefore *time.Time, limit int) (models.ProductSlice, error)
+	ListStored(ctx context.Context, pvzID int64, status, pickupCode, cell string, after *pagination.Cursor, limit int) (models.ProductSlice, error)
+	ListOverdue(ctx context.Context, pvzID int64, now time.Time, after *pagination.Cursor, limit int) (models.ProductSlice, error)
+	ListUnreportedOverdueForUpdate(ctx context.Context, now time.Time, limit int) (models.ProductSlice, error)
+	CountOverdueByPVZ(ctx context.Context, now time.Time) (map[int64]int, error)
+}
+
+type AuditRepository interface {
+	RecordProductAction(ctx context.Context, entry *models.ProductAuditLog) error
+	RecordStatusChange(ctx context.Context, entry *models.StatusHistory) error
+	ListStatusHistory(ctx context.Context, receptionID string) (models.StatusHistorySlice, error)
+}
+
+type StaffRepository interface {
+	Assign(ctx context.Context, pvzID, userID, assignedBy string) (*models.PVZStaff, error)
+	Unassign(ctx context.Context, pvzID, userID string) error
+	IsAssigned(ctx context.Context, pvzID, userID string) (bool, error)
+	ListByPVZ(ctx context.Context, pvzID string) ([]*models.PVZStaff, error)
+}
+
+// EventHub — подписка на живую ленту событий ПВЗ.
+type EventHub interface {
+	Subscribe(pvzID int64) *events.Subscription
 }
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"PVZ/pkg/auth"
+	"PVZ/pkg/metrics"
+	"PVZ/pkg/pagination"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+const (
+	pickupCodeLen = 6
+	// pickupCodeAttempts — сколько раз генерировать код, если он уже занят в ПВЗ.
+	pickupCodeAttempts = 20
+)
+
+type IssuanceService struct {
+	products ProductLifecycleRepository
+	pvzs     PVZRepository
+	staff    StaffRepository
+	audit    AuditRepository
+	outbox   OutboxRepository
+	tx       TxManager
+}
+
+func NewIssuanceService(products ProductLifecycleRepository, pvzs PVZRepository, staff StaffRepository, audit AuditRepository, outbox OutboxRepository, tx TxManager) *IssuanceService {
+	return &IssuanceService{products: products, pvzs: pvzs, staff: staff, audit: audit, outbox: outbox, tx: tx}
+}
+
+// IssueInput — что выдать по коду. Пустой ProductIDs — все посылки с этим
+// кодом, иначе только перечисленные (частичная выдача).
+type IssueInput struct {
+	PickupCode string
+	ProductIDs []string
+}
+
+// Issue отмечает посылки выданными клиенту. Выдаёт только сотрудник ПВЗ.
+func (s *IssuanceService) Issue(ctx context.Context, pvzID, userRole string, in IssueInput) (models.ProductSlice, error) {
+	if userRole != constants.RoleEmployee {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if !validPickupCode(in.PickupCode) {
+		return nil, errs.Validation("invalid pickup code")
+	}
+
+	id, err := s.requirePVZ(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+		return nil, err
+	}
+
+	var issued models.ProductSlice
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		ready, err := s.products.ListForPickupForUpdate(ctx, id, in.PickupCode)
+		if err != nil {
+			return errs.Wrap(err, "failed to get products for pickup")
+		}
+		if len(ready) == 0 {
+			return errs.NotFound("no parcels for this pickup code")
+		}
+
+		issued, err = selectProducts(ready, in.ProductIDs)
+		if err != nil {
+			return err
+		}
+
+		actorID := auth.UserIDFromContext(ctx)
+		now := time.Now()
+		for _, p := range issued {
+			p.Status = constants.ProductIssued
+			p.StatusChangedAt = null.TimeFrom(now)
+			p.IssuedAt = null.TimeFrom(now)
+			p.IssuedBy = nullString(actorID)
+			if err := s.products.UpdateLifecycle(ctx, p); err != nil {
+				return errs.Wrap(err, "failed to issue product")
+			}
+
+			err := s.audit.RecordProductAction(ctx, &models.ProductAuditLog{
+				Action:      constants.ProductActionIssued,
+				ProductID:   p.ID,
+				ReceptionID: p.ReceptionID,
+				ProductType: p.Type,
+				Barcode:     p.Barcode,
+				ActorID:     nullString(actorID),
+			})
+			if err != nil {
+				return errs.Wrap(err, "failed to record product issue")
+			}
+
+			if err := addEvent(ctx, s.outbox, events.ProductIssued, events.AggregateProduct, p.ID, productPayload(p, id, actorID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ProductsIssued.Add(float64(len(issued)))
+	return issued, nil
+}
+
+// ListStored отдаёт товары, которые сейчас хранятся в ПВЗ: модератору —
+// любого ПВЗ, сотруднику — своего. cell сужает выборку до одной ячейки.
+func (s *IssuanceService) ListStored(ctx context.Context, pvzID, status, pickupCode, cell, cursor string, limit int, userRole string) (models.ProductSlice, string, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, "", errs.Forbidden("access denied")
+	}
+
+	if status != "" && status != constants.ProductReceived && status != constants.ProductReadyForPickup {
+		return nil, "", errs.Validation("invalid status")
+	}
+	if pickupCode != "" && !validPickupCode(pickupCode) {
+		return nil, "", errs.Validation("invalid pickup code")
+	}
+	if cell != "" {
+		var ok bool
+		if cell, ok = normalizeCellCode(cell); !ok {
+			return nil, "", errs.Validation("invalid cell code")
+		}
+	}
+
+	var after *pagination.Cursor
+	if cursor != "" {
+		var err error
+		after, err = pagination.DecodeCursor(cursor)
+		if err != nil {
+			return nil, "", errs.Validation("invalid cursor")
+		}
+	}
+
+	id, err := s.requirePVZ(ctx, pvzID)
+	if err != nil {
+		return nil, "", err
+	}
+	if userRole == constants.RoleEmployee {
+		if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	products, err := s.products.ListStored(ctx, id, status, pickupCode, cell, after, limit+1)
+	if err != nil {
+		return nil, "", errs.Wrap(err, "failed to list stored products")
+	}
+
+	var next string
+	if len(products) > limit {
+		products = products[:limit]
+		last := products[len(products)-1]
+		next = pagination.Cursor{Time: last.AddedAt, ID: last.ID}.Encode()
+	}
+
+	return products, next, nil
+}
+
+func (s *IssuanceService) requirePVZ(ctx context.Context, pvzID string) (int64, error) {
+	id, err := strconv.ParseInt(pvzID, 10, 64)
+	if err != nil {
+		return 0, errs.Validation("invalid PVZ id")
+	}
+
+	pvz, err := s.pvzs.GetByID(ctx, pvzID)
+	if err != nil {
+		return 0, errs.Wrap(err, "failed to get PVZ")
+	}
+	if pvz == nil {
+		return 0, errs.NotFound("PVZ not found")
+	}
+
+	return id, nil
+}
+
+// selectProducts оставляет из готовых к выдаче посылок запрошенные.
+func selectProducts(ready models.ProductSlice, ids []string) (models.ProductSlice, error) {
+	if len(ids) == 0 {
+		return ready, nil
+	}
+
+	byID := make(map[string]*models.Product, len(ready))
+	for _, p := range ready {
+		byID[p.ID] = p
+	}
+
+	selected := make(models.ProductSlice, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		p, ok := byID[id]
+		if !ok {
+			return nil, errs.NotFound("product " + id + " is not ready for pickup with this code")
+		}
+		if !seen[id] {
+			seen[id] = true
+			selected = append(selected, p)
+		}
+	}
+
+	return selected, nil
+}
+
+// preparePickup переводит товары закрытой приёмки в ready_for_pickup и
+// назначает коды выдачи: общий для товаров одного заказа в ПВЗ, отдельный
+// для товара без номера заказа. Код, полученный до reopen, сохраняется.
+// Срок хранения отсчитывается от закрытия приёмки.
+func preparePickup(ctx context.Context, products ProductLifecycleRepository, types ProductTypeCatalog, rec *models.Reception) error {
+	list, err := products.ListByReception(ctx, rec.ID)
+	if err != nil {
+		return errs.Wrap(err, "failed to get reception products")
+	}
+
+	// Коды, назначенные заказам в этой приёмке.
+	orderCodes := make(map[string]string)
+	now := time.Now()
+	for _, p := range list {
+		if p.Status != constants.ProductReceived {
+			continue
+		}
+
+		if !p.PickupCode.Valid {
+			code, err := pickupCodeFor(ctx, products, rec.PVZID, p.OrderNumber.String, orderCodes)
+			if err != nil {
+				return err
+			}
+			p.PickupCode = null.StringFrom(code)
+		}
+
+		period, err := types.StoragePeriod(ctx, p.Type)
+		if err != nil {
+			return errs.Wrap(err, "failed to get storage period")
+		}
+
+		p.Status = constants.ProductReadyForPickup
+		p.StatusChangedAt = null.TimeFrom(now)
+		p.StorageExpiresAt = null.TimeFrom(rec.ClosedAt.Time.Add(period))
+		if err := products.UpdateLifecycle(ctx, p); err != nil {
+			return errs.Wrap(err, "failed to prepare product for pickup")
+		}
+	}
+
+	return nil
+}
+
+// revertPickup возвращает товары возобновлённой приёмки в received. Если
+// что-то уже выдано или возвращено, приёмку возобновлять нельзя.
+func revertPickup(ctx context.Context, products ProductLifecycleRepository, rec *models.Reception) error {
+	list, err := products.ListByReception(ctx, rec.ID)
+	if err != nil {
+		return errs.Wrap(err, "failed to get reception products")
+	}
+
+	for _, p := range list {
+		if p.Status == constants.ProductIssued || p.Status == constants.ProductReturned {
+			return errs.Conflict("reception has issued products")
+		}
+	}
+
+	now := time.Now()
+	for _, p := range list {
+		if p.Status != constants.ProductReadyForPickup {
+			continue
+		}
+		p.Status = constants.ProductReceived
+		p.StatusChangedAt = null.TimeFrom(now)
+		p.StorageExpiresAt = null.Time{}
+		p.OverdueReportedAt = null.Time{}
+		if err := products.UpdateLifecycle(ctx, p); err != nil {
+			return errs.Wrap(err, "failed to revert product status")
+		}
+	}
+
+	return nil
+}
+
+func pickupCodeFor(ctx context.Context, products ProductLifecycleRepository, pvzID int64, orderNumber string, orderCodes map[string]string) (string, error) {
+	if orderNumber != "" {
+		if code, ok := orderCodes[orderNumber]; ok {
+			return code, nil
+		}
+
+		code, err := products.FindPickupCode(ctx, pvzID, orderNumber)
+		if err != nil {
+			return "", errs.Wrap(err, "failed to find pickup code")
+		}
+		if code != "" {
+			orderCodes[orderNumber] = code
+			return code, nil
+		}
+	}
+
+	code, err := newPickupCode(ctx, products, pvzID)
+	if err != nil {
+		return "", err
+	}
+	if orderNumber != "" {
+		orderCodes[orderNumber] = code
+	}
+	return code, nil
+}
+
+// newPickupCode генерирует случайный код, не занятый посылками, ожидающими
+// выдачи в ПВЗ. Товары приёмки сохраняются по одному, поэтому коды, уже
+// выданные в этой же приёмке, тоже видны; параллельные закрытия других
+// приёмок ПВЗ исключает блокировка строки ПВЗ в transition.
+func newPickupCode(ctx context.Context, products ProductLifecycleRepository, pvzID int64) (string, error) {
+	limit := big.NewInt(1_000_000)
+	for range pickupCodeAttempts {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", errs.Internal("failed to generate pickup code", err)
+		}
+		code := fmt.Sprintf("%0*d", pickupCodeLen, n.Int64())
+
+		used, err := products.IsPickupCodeInUse(ctx, pvzID, code)
+		if err != nil {
+			return "", errs.Wrap(err, "failed to check pickup code")
+		}
+		if !used {
+			return code, nil
+		}
+	}
+
+	return "", errs.Internal("failed to generate unique pickup code", nil)
+}
+
+func validPickupCode(code string) bool {
+	if len(code) != pickupCodeLen {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
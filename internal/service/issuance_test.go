@@ -0,0 +1,246 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+type issuanceTestEnv struct {
+	store      *fakeStore
+	products   *ProductService
+	receptions *ReceptionService
+	issuance   *IssuanceService
+	staff      *fakeStaffRepo
+}
+
+// newIssuanceTestEnv: testEmployeeID закреплён за ПВЗ "1", в ПВЗ закрыта
+// приёмка с двумя товарами заказа ORD-1 и одним товаром без заказа.
+func newIssuanceTestEnv(t *testing.T) *issuanceTestEnv {
+	t.Helper()
+
+	store := newFakeStore()
+	tx := &fakeTx{store: store}
+	receptionRepo := &fakeReceptionRepo{store: store}
+	productRepo := &fakeProductRepo{store: store}
+	staffRepo := newFakeStaffRepo()
+	_, _ = staffRepo.Assign(context.Background(), "1", testEmployeeID, "")
+	types := NewProductTypeService(newFakeProductTypeRepo("обувь", "одежда"), time.Minute)
+	audit := &fakeAuditRepo{store: store}
+	outbox := &fakeOutboxRepo{store: store}
+	pvzRepo := &fakePVZRepo{pvz: []*models.PVZ{{ID: 1}, {ID: 2}}, store: store}
+
+	env := &issuanceTestEnv{
+		store:      store,
+		products:   NewProductService(productRepo, receptionRepo, pvzRepo, &fakeCellRepo{store: store}, staffRepo, types, outbox, tx),
+		receptions: NewReceptionService(receptionRepo, pvzRepo, productRepo, staffRepo, types, audit, outbox, tx, time.Hour),
+		issuance:   NewIssuanceService(productRepo, pvzRepo, staffRepo, audit, outbox, tx),
+		staff:      staffRepo,
+	}
+
+	ctx := employeeCtx(testEmployeeID)
+	if _, err := env.receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatal(err)
+	}
+	for _, in := range []ProductInput{
+		{Type: "обувь", Barcode: "100", OrderNumber: "ORD-1"},
+		{Type: "одежда", Barcode: "101", OrderNumber: "ORD-1"},
+		{Type: "обувь", Barcode: "102"},
+	} {
+		if _, _, err := env.products.ScanProduct(ctx, "1", constants.RoleEmployee, in); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := env.receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatal(err)
+	}
+
+	return env
+}
+
+func TestCloseReceptionPreparesPickup(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+
+	order1, order2, single := env.store.products[0], env.store.products[1], env.store.products[2]
+	for _, p := range env.store.products {
+		if p.Status != constants.ProductReadyForPickup {
+			t.Fatalf("product %s status = %q, want %q", p.ID, p.Status, constants.ProductReadyForPickup)
+		}
+		if !validPickupCode(p.PickupCode.String) {
+			t.Fatalf("product %s has invalid pickup code %q", p.ID, p.PickupCode.String)
+		}
+	}
+	if order1.PickupCode != order2.PickupCode {
+		t.Fatalf("products of one order must share a pickup code: %q and %q", order1.PickupCode.String, order2.PickupCode.String)
+	}
+	if single.PickupCode == order1.PickupCode {
+		t.Fatal("product without order must get its own pickup code")
+	}
+}
+
+func TestIssue(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+	code := env.store.products[0].PickupCode.String
+
+	issued, err := env.issuance.Issue(ctx, "1", constants.RoleEmployee, IssueInput{PickupCode: code})
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	if len(issued) != 2 {
+		t.Fatalf("issued %d products, want 2", len(issued))
+	}
+	for _, p := range env.store.products[:2] {
+		if p.Status != constants.ProductIssued || !p.IssuedAt.Valid || p.IssuedBy.String != testEmployeeID {
+			t.Fatalf("product %s not issued: %+v", p.ID, p)
+		}
+	}
+	if env.store.products[2].Status != constants.ProductReadyForPickup {
+		t.Fatal("product with another code must stay ready for pickup")
+	}
+
+	issuedEvents := 0
+	for _, e := range env.store.eventTypes() {
+		if e == events.ProductIssued {
+			issuedEvents++
+		}
+	}
+	if issuedEvents != 2 {
+		t.Fatalf("product.issued events = %d, want 2", issuedEvents)
+	}
+
+	if _, err := env.issuance.Issue(ctx, "1", constants.RoleEmployee, IssueInput{PickupCode: code}); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("second issue: expected not found, got %v", err)
+	}
+}
+
+func TestIssuePartial(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+	first := env.store.products[0]
+
+	if _, err := env.issuance.Issue(ctx, "1", constants.RoleEmployee, IssueInput{
+		PickupCode: first.PickupCode.String,
+		ProductIDs: []string{env.store.products[2].ID},
+	}); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("product with another code: expected not found, got %v", err)
+	}
+
+	issued, err := env.issuance.Issue(ctx, "1", constants.RoleEmployee, IssueInput{
+		PickupCode: first.PickupCode.String,
+		ProductIDs: []string{first.ID},
+	})
+	if err != nil {
+		t.Fatalf("partial issue: %v", err)
+	}
+	if len(issued) != 1 || env.store.products[1].Status != constants.ProductReadyForPickup {
+		t.Fatalf("only the requested product must be issued, got %d", len(issued))
+	}
+}
+
+func TestIssueAccess(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	code := env.store.products[0].PickupCode.String
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		pvzID string
+		role  string
+		code  string
+		kind  error
+	}{
+		{"moderator", context.Background(), "1", constants.RoleModerator, code, errs.ErrForbidden},
+		{"not assigned", employeeCtx("stranger"), "1", constants.RoleEmployee, code, errs.ErrForbidden},
+		{"other PVZ", employeeCtx(testEmployeeID), "2", constants.RoleEmployee, code, errs.ErrForbidden},
+		{"invalid code", employeeCtx(testEmployeeID), "1", constants.RoleEmployee, "12ab", errs.ErrValidation},
+		{"missing PVZ", employeeCtx(testEmployeeID), "3", constants.RoleEmployee, code, errs.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := env.issuance.Issue(tt.ctx, tt.pvzID, tt.role, IssueInput{PickupCode: tt.code}); !errors.Is(err, tt.kind) {
+				t.Fatalf("err = %v, want %v", err, tt.kind)
+			}
+		})
+	}
+}
+
+func TestReopenReceptionRevertsPickup(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	receptionID := env.store.products[0].ReceptionID
+	codes := []string{env.store.products[0].PickupCode.String, env.store.products[2].PickupCode.String}
+
+	if _, err := env.receptions.ReopenReception(context.Background(), receptionID, constants.RoleModerator); err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	for _, p := range env.store.products {
+		if p.Status != constants.ProductReceived {
+			t.Fatalf("product %s status = %q, want %q", p.ID, p.Status, constants.ProductReceived)
+		}
+	}
+
+	if _, err := env.receptions.CloseReception(employeeCtx(testEmployeeID), "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if got := []string{env.store.products[0].PickupCode.String, env.store.products[2].PickupCode.String}; !slices.Equal(got, codes) {
+		t.Fatalf("pickup codes changed after reopen: %v, want %v", got, codes)
+	}
+}
+
+func TestReopenReceptionWithIssuedProducts(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	p := env.store.products[2]
+
+	if _, err := env.issuance.Issue(employeeCtx(testEmployeeID), "1", constants.RoleEmployee, IssueInput{PickupCode: p.PickupCode.String}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := env.receptions.ReopenReception(context.Background(), p.ReceptionID, constants.RoleModerator); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+	if env.store.receptions[p.ReceptionID].Status != constants.ReceptionClosed {
+		t.Fatal("reception must stay closed")
+	}
+}
+
+func TestListStored(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+	p := env.store.products[2]
+
+	if _, err := env.issuance.Issue(ctx, "1", constants.RoleEmployee, IssueInput{PickupCode: p.PickupCode.String}); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, _, err := env.issuance.ListStored(ctx, "1", "", "", "", "", 10, constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(stored) != 2 {
+		t.Fatalf("stored = %d, want 2", len(stored))
+	}
+	for _, s := range stored {
+		if s.ID == p.ID {
+			t.Fatal("issued product must not be listed as stored")
+		}
+	}
+
+	// Полная последняя страница не даёт курсора на пустую.
+	if _, next, err := env.issuance.ListStored(ctx, "1", "", "", "", "", 2, constants.RoleEmployee); err != nil || next != "" {
+		t.Fatalf("exact page: next = %q, err %v; want no cursor", next, err)
+	}
+	if _, next, err := env.issuance.ListStored(ctx, "1", "", "", "", "", 1, constants.RoleEmployee); err != nil || next == "" {
+		t.Fatalf("partial page: next = %q, err %v; want cursor", next, err)
+	}
+
+	if _, _, err := env.issuance.ListStored(ctx, "1", constants.ProductIssued, "", "", "", 10, constants.RoleEmployee); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error for issued status, got %v", err)
+	}
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"PVZ/pkg/auth"
+	"PVZ/pkg/metrics"
+	"PVZ/pkg/pagination"
+	"PVZ/pkg/uuid"
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+// ListOverdue отдаёт посылки ПВЗ, срок хранения которых истёк: сначала самые
+// просроченные.
+func (s *IssuanceService) ListOverdue(ctx context.Context, pvzID, cursor string, limit int, userRole string) (models.ProductSlice, string, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, "", errs.Forbidden("access denied")
+	}
+
+	var after *pagination.Cursor
+	if cursor != "" {
+		var err error
+		after, err = pagination.DecodeCursor(cursor)
+		if err != nil {
+			return nil, "", errs.Validation("invalid cursor")
+		}
+	}
+
+	id, err := s.requirePVZ(ctx, pvzID)
+	if err != nil {
+		return nil, "", err
+	}
+	if userRole == constants.RoleEmployee {
+		if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	products, err := s.products.ListOverdue(ctx, id, time.Now(), after, limit+1)
+	if err != nil {
+		return nil, "", errs.Wrap(err, "failed to list overdue products")
+	}
+
+	var next string
+	if len(products) > limit {
+		products = products[:limit]
+		last := products[len(products)-1]
+		next = pagination.Cursor{Time: last.StorageExpiresAt.Time, ID: last.ID}.Encode()
+	}
+
+	return products, next, nil
+}
+
+// ReturnToSenderInput — какие невостребованные посылки вернуть отправителю.
+// Пустой ProductIDs — просроченные посылки ПВЗ, не больше MaxBatchSize за
+// раз, начиная с самых просроченных.
+type ReturnToSenderInput struct {
+	ProductIDs []string
+}
+
+// ReturnToSender отправляет невостребованные посылки обратно отправителю:
+// ready_for_pickup → returned. Посылка покидает ПВЗ и перестаёт занимать
+// место и числиться просроченной. Выполняет только сотрудник ПВЗ.
+func (s *IssuanceService) ReturnToSender(ctx context.Context, pvzID, userRole string, in ReturnToSenderInput) (models.ProductSlice, error) {
+	if userRole != constants.RoleEmployee {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if len(in.ProductIDs) > MaxBatchSize {
+		return nil, errs.Validation("too many products in one request")
+	}
+	ids := make([]string, 0, len(in.ProductIDs))
+	seen := make(map[string]bool, len(in.ProductIDs))
+	for _, id := range in.ProductIDs {
+		if !uuid.IsValid(id) {
+			return nil, errs.Validation("invalid product ID format")
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	id, err := s.requirePVZ(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+		return nil, err
+	}
+
+	var returned models.ProductSlice
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		var expiredBefore *time.Time
+		if len(ids) == 0 {
+			now := time.Now()
+			expiredBefore = &now
+		}
+
+		list, err := s.products.ListReadyForUpdate(ctx, id, ids, expiredBefore, MaxBatchSize)
+		if err != nil {
+			return errs.Wrap(err, "failed to get products ready for pickup")
+		}
+		if len(ids) > 0 && len(list) != len(ids) {
+			found := make(map[string]bool, len(list))
+			for _, p := range list {
+				found[p.ID] = true
+			}
+			for _, id := range ids {
+				if !found[id] {
+					return errs.NotFound("product " + id + " is not waiting for pickup in this PVZ")
+				}
+			}
+		}
+		if len(list) == 0 {
+			return errs.NotFound("no overdue parcels")
+		}
+
+		actorID := auth.UserIDFromContext(ctx)
+		now := time.Now()
+		for _, p := range list {
+			p.Status = constants.ProductReturned
+			p.StatusChangedAt = null.TimeFrom(now)
+			if err := s.products.UpdateLifecycle(ctx, p); err != nil {
+				return errs.Wrap(err, "failed to return product to sender")
+			}
+
+			err := s.audit.RecordProductAction(ctx, &models.ProductAuditLog{
+				Action:      constants.ProductActionReturnedToSender,
+				ProductID:   p.ID,
+				ReceptionID: p.ReceptionID,
+				ProductType: p.Type,
+				Barcode:     p.Barcode,
+				ActorID:     nullString(actorID),
+			})
+			if err != nil {
+				return errs.Wrap(err, "failed to record product return to sender")
+			}
+
+			if err := addEvent(ctx, s.outbox, events.ProductReturnedToSender, events.AggregateProduct, p.ID, productPayload(p, id, actorID)); err != nil {
+				return err
+			}
+		}
+
+		returned = list
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ProductsReturnedToSender.Add(float64(len(returned)))
+	return returned, nil
+}
+
+// ReportOverdue отмечает посылки, чей срок хранения истёк к now, и пишет
+// по событию products.overdue на каждый ПВЗ. О каждой посылке сообщается
+// один раз; возвращает число отмеченных посылок.
+func (s *IssuanceService) ReportOverdue(ctx context.Context, now time.Time, limit int) (int, error) {
+	var reported int
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		list, err := s.products.ListUnreportedOverdueForUpdate(ctx, now, limit)
+		if err != nil {
+			return errs.Wrap(err, "failed to list overdue products")
+		}
+
+		byPVZ := make(map[int64][]string)
+		var order []int64
+		for _, p := range list {
+			pvzID := p.R.GetReception().PVZID
+			if _, ok := byPVZ[pvzID]; !ok {
+				order = append(order, pvzID)
+			}
+			byPVZ[pvzID] = append(byPVZ[pvzID], p.ID)
+
+			p.OverdueReportedAt = null.TimeFrom(now)
+			if err := s.products.UpdateLifecycle(ctx, p); err != nil {
+				return errs.Wrap(err, "failed to mark product as overdue")
+			}
+		}
+
+		// Большой ПВЗ даёт несколько событий: иначе оно не пройдёт через NOTIFY.
+		for _, pvzID := range order {
+			for ids := range slices.Chunk(byPVZ[pvzID], events.MaxOverdueProducts) {
+				err := addEvent(ctx, s.outbox, events.ProductsOverdue, events.AggregatePVZ, strconv.FormatInt(pvzID, 10), events.OverduePayload{
+					PVZID:      pvzID,
+					ProductIDs: ids,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		reported = len(list)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	metrics.OverdueReported.Add(float64(reported))
+	return reported, nil
+}
+
+// RefreshOverdueMetrics выставляет products_overdue по всем ПВЗ: ПВЗ, где
+// просроченных посылок не осталось, из метрики пропадают.
+func (s *IssuanceService) RefreshOverdueMetrics(ctx context.Context, now time.Time) error {
+	counts, err := s.products.CountOverdueByPVZ(ctx, now)
+	if err != nil {
+		return errs.Wrap(err, "failed to count overdue products")
+	}
+
+	metrics.ProductsOverdue.Reset()
+	for pvzID, n := range counts {
+		metrics.ProductsOverdue.WithLabelValues(strconv.FormatInt(pvzID, 10)).Set(float64(n))
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+func TestClosedReceptionSetsStorageExpiry(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+
+	closedAt := env.store.receptions[env.store.products[0].ReceptionID].ClosedAt.Time
+	want := closedAt.Add(defaultStorageDays * 24 * time.Hour)
+	for _, p := range env.store.products {
+		if !p.StorageExpiresAt.Valid || !p.StorageExpiresAt.Time.Equal(want) {
+			t.Fatalf("product %s storage expires at %v, want %v", p.ID, p.StorageExpiresAt.Time, want)
+		}
+	}
+}
+
+func TestReportOverdueOncePerProduct(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+
+	before := len(env.store.outbox)
+	now := time.Now()
+	if n, err := env.issuance.ReportOverdue(ctx, now, 100); err != nil || n != 0 {
+		t.Fatalf("report before expiry: n=%d err=%v", n, err)
+	}
+
+	later := now.Add((defaultStorageDays + 1) * 24 * time.Hour)
+	n, err := env.issuance.ReportOverdue(ctx, later, 100)
+	if err != nil {
+		t.Fatalf("report overdue: %v", err)
+	}
+	if n != len(env.store.products) {
+		t.Fatalf("reported %d products, want %d", n, len(env.store.products))
+	}
+
+	added := env.store.outbox[before:]
+	if len(added) != 1 || added[0].EventType != events.ProductsOverdue {
+		t.Fatalf("expected one %s event, got %v", events.ProductsOverdue, env.store.eventTypes()[before:])
+	}
+	var payload events.OverduePayload
+	if err := json.Unmarshal(added[0].Payload, &payload); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	if payload.PVZID != 1 || len(payload.ProductIDs) != n {
+		t.Fatalf("unexpected payload %+v", payload)
+	}
+
+	if n, err := env.issuance.ReportOverdue(ctx, later, 100); err != nil || n != 0 {
+		t.Fatalf("second report: n=%d err=%v", n, err)
+	}
+
+}
+
+func TestReportOverdueSplitsLargePVZ(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+
+	// Дописываем посылки прямо в хранилище: через сканер их слишком много.
+	template := env.store.products[2]
+	for range events.MaxOverdueProducts {
+		p := *template
+		p.ID = env.store.nextID()
+		env.store.products = append(env.store.products, &p)
+	}
+
+	before := len(env.store.outbox)
+	later := time.Now().Add((defaultStorageDays + 1) * 24 * time.Hour)
+	n, err := env.issuance.ReportOverdue(ctx, later, 1000)
+	if err != nil {
+		t.Fatalf("report overdue: %v", err)
+	}
+
+	added := env.store.outbox[before:]
+	if len(added) != 2 {
+		t.Fatalf("expected 2 events for %d products, got %d", n, len(added))
+	}
+	total := 0
+	for _, e := range added {
+		var payload events.OverduePayload
+		if err := json.Unmarshal(e.Payload, &payload); err != nil {
+			t.Fatalf("unmarshal payload: %v", err)
+		}
+		if len(payload.ProductIDs) > events.MaxOverdueProducts {
+			t.Fatalf("event carries %d products, limit %d", len(payload.ProductIDs), events.MaxOverdueProducts)
+		}
+		total += len(payload.ProductIDs)
+	}
+	if total != n {
+		t.Fatalf("events carry %d products, reported %d", total, n)
+	}
+}
+
+func TestListOverdue(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+
+	overdue := env.store.products[2]
+	overdue.StorageExpiresAt.Time = time.Now().Add(-time.Hour)
+
+	list, next, err := env.issuance.ListOverdue(ctx, "1", "", 1, constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("list overdue: %v", err)
+	}
+	if len(list) != 1 || list[0].ID != overdue.ID {
+		t.Fatalf("expected only product %s to be overdue, got %d products", overdue.ID, len(list))
+	}
+	if next != "" {
+		t.Fatalf("next = %q, want no cursor after the last page", next)
+	}
+
+	if _, _, err := env.issuance.ListOverdue(employeeCtx("stranger"), "1", "", 10, constants.RoleEmployee); err == nil {
+		t.Fatal("expected error for unassigned employee")
+	}
+}
+
+func TestReturnToSender(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+
+	if _, err := env.issuance.ReturnToSender(ctx, "1", constants.RoleEmployee, ReturnToSenderInput{}); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected not found without overdue parcels, got %v", err)
+	}
+
+	// Первая посылка возвращается по ID, хотя срок хранения ещё не истёк.
+	first := env.store.products[0]
+	returned, err := env.issuance.ReturnToSender(ctx, "1", constants.RoleEmployee, ReturnToSenderInput{ProductIDs: []string{first.ID}})
+	if err != nil {
+		t.Fatalf("return by id: %v", err)
+	}
+	if len(returned) != 1 || first.Status != constants.ProductReturned {
+		t.Fatalf("expected %s returned, got %d products, status %s", first.ID, len(returned), first.Status)
+	}
+	if got := env.store.eventTypes(); got[len(got)-1] != events.ProductReturnedToSender {
+		t.Fatalf("events = %v, want %s last", got, events.ProductReturnedToSender)
+	}
+	if a := env.store.audit[len(env.store.audit)-1]; a.Action != constants.ProductActionReturnedToSender || a.ProductID != first.ID {
+		t.Fatalf("audit = %s %s, want returned_to_sender %s", a.Action, a.ProductID, first.ID)
+	}
+
+	// Уже возвращённую посылку повторно вернуть нельзя.
+	if _, err := env.issuance.ReturnToSender(ctx, "1", constants.RoleEmployee, ReturnToSenderInput{ProductIDs: []string{first.ID}}); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected not found for returned parcel, got %v", err)
+	}
+
+	// Без списка возвращаются только просроченные.
+	env.store.products[1].StorageExpiresAt = null.TimeFrom(time.Now().Add(-time.Hour))
+	returned, err = env.issuance.ReturnToSender(ctx, "1", constants.RoleEmployee, ReturnToSenderInput{})
+	if err != nil {
+		t.Fatalf("return overdue: %v", err)
+	}
+	if len(returned) != 1 || returned[0].ID != env.store.products[1].ID {
+		t.Fatalf("expected only the overdue parcel, got %d", len(returned))
+	}
+
+	overdue, _, err := env.issuance.ListOverdue(ctx, "1", "", 10, constants.RoleEmployee)
+	if err != nil || len(overdue) != 0 {
+		t.Fatalf("overdue after return: %d, err %v", len(overdue), err)
+	}
+	stored, _, err := env.issuance.ListStored(ctx, "1", "", "", "", "", 10, constants.RoleEmployee)
+	if err != nil || len(stored) != 1 {
+		t.Fatalf("stored after return: %d, err %v", len(stored), err)
+	}
+
+	if _, err := env.issuance.ReturnToSender(ctx, "1", constants.RoleEmployee, ReturnToSenderInput{ProductIDs: []string{"bad"}}); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	if _, err := env.issuance.ReturnToSender(ctx, "1", constants.RoleModerator, ReturnToSenderInput{}); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for moderator, got %v", err)
+	}
+	if _, err := env.issuance.ReturnToSender(employeeCtx("stranger"), "1", constants.RoleEmployee, ReturnToSenderInput{}); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for unassigned employee, got %v", err)
+	}
+}
@@ -2,58 +2,224 @@ package service
 
 import (
 	"PVZ/internal/constants"
-	"PVZ/internal/repository"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
 	"PVZ/models"
+	"PVZ/pkg/auth"
 	"PVZ/pkg/metrics"
 	"context"
-	"errors"
+	"strings"
+
+	"github.com/aarondl/null/v8"
 )
 
 type ProductService struct {
 	productRepo   ProductRepository
 	receptionRepo ReceptionRepository
+	pvzs          PVZRepository
+	cells         CellRepository
+	staff         StaffRepository
+	types         ProductTypeCatalog
+	outbox        OutboxRepository
+	tx            TxManager
 }
 
-func NewProductService(pRepo *repository.ProductRepo, rRepo *repository.ReceptionRepo) *ProductService {
+func NewProductService(pRepo ProductRepository, rRepo ReceptionRepository, pvzs PVZRepository, cells CellRepository, staff StaffRepository, types ProductTypeCatalog, outbox OutboxRepository, tx TxManager) *ProductService {
 	return &ProductService{
 		productRepo:   pRepo,
 		receptionRepo: rRepo,
+		pvzs:          pvzs,
+		cells:         cells,
+		staff:         staff,
+		types:         types,
+		outbox:        outbox,
+		tx:            tx,
+	}
+}
+
+// ProductInput — данные принимаемого товара. Всё, кроме типа, необязательно,
+// но при приёмке по скану штрихкод обязателен. Cell — код ячейки, куда
+// сотрудник кладёт товар; без него ячейка подбирается автоматически.
+type ProductInput struct {
+	Type        string
+	Barcode     string
+	SKU         string
+	OrderNumber string
+	WeightGrams *int
+	LengthMM    *int
+	WidthMM     *int
+	HeightMM    *int
+	Cell        string
+}
+
+const maxProductCodeLen = 64
+
+// AddProduct принимает товар без штрихкода. warning непустой, если товар
+// принят сверх вместимости ПВЗ (политика warn).
+func (s *ProductService) AddProduct(ctx context.Context, pvzID, userRole string, productType string) (*models.Product, string, error) {
+	return s.addProduct(ctx, pvzID, userRole, ProductInput{Type: productType})
+}
+
+// ScanProduct принимает товар по штрихкоду в активную приёмку ПВЗ.
+// warning — как у AddProduct.
+func (s *ProductService) ScanProduct(ctx context.Context, pvzID, userRole string, in ProductInput) (*models.Product, string, error) {
+	if strings.TrimSpace(in.Barcode) == "" {
+		return nil, "", errs.Validation("barcode is required")
 	}
+	return s.addProduct(ctx, pvzID, userRole, in)
 }
 
-func (s *ProductService) AddProduct(ctx context.Context, pvzID, userRole string, productType string) (*models.Product, error) {
+func (s *ProductService) addProduct(ctx context.Context, pvzID, userRole string, in ProductInput) (*models.Product, string, error) {
 	if userRole != constants.RoleEmployee {
-		return nil, errors.New("access denied")
+		return nil, "", errs.Forbidden("access denied")
 	}
 
-	validTypes := map[string]bool{
-		"электроника": true,
-		"одежда":      true,
-		"обувь":       true,
+	if err := s.validateInput(ctx, &in); err != nil {
+		return nil, "", err
 	}
 
-	if !validTypes[productType] {
-		return nil, errors.New("invalid product type")
+	if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+		return nil, "", err
 	}
 
-	reception, err := s.receptionRepo.GetActiveByPVZ(ctx, pvzID)
+	var (
+		product *models.Product
+		warning string
+	)
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		reception, err := s.receptionRepo.GetActiveByPVZForUpdate(ctx, pvzID, constants.ReceptionKindDelivery)
+		if err != nil {
+			return errs.Wrap(err, "failed to get active reception")
+		}
+
+		if reception == nil {
+			return errs.NotFound("no active reception found")
+		}
+
+		if reception.Status != constants.ReceptionInProgress {
+			return errs.Conflict("reception is not active")
+		}
+
+		if warning, err = s.reserveCapacity(ctx, pvzID, 1); err != nil {
+			return err
+		}
+
+		plan, err := s.planCells(ctx, reception.PVZID)
+		if err != nil {
+			return err
+		}
+		cell, err := plan.place(in.Cell)
+		if err != nil {
+			return err
+		}
+
+		product, err = s.insertProduct(ctx, reception, in, withCell(cell))
+		return err
+	})
 	if err != nil {
-		return nil, errors.New("failed to get active reception")
+		return nil, "", err
 	}
 
-	if reception == nil {
-		return nil, errors.New("no active reception found")
+	metrics.ProductAdded.Inc()
+	return product, warning, nil
+}
+
+// insertProduct добавляет товар в заблокированную приёмку; вызывается внутри tx.
+// opts дополняют товар перед сохранением.
+func (s *ProductService) insertProduct(ctx context.Context, reception *models.Reception, in ProductInput, opts ...func(*models.Product)) (*models.Product, error) {
+	if in.Barcode != "" {
+		if err := s.productRepo.LockBarcode(ctx, in.Barcode); err != nil {
+			return nil, errs.Wrap(err, "failed to lock barcode")
+		}
+
+		dups, err := s.productRepo.FindOpenByBarcodes(ctx, []string{in.Barcode})
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to check barcode")
+		}
+		if len(dups) > 0 {
+			return nil, duplicateBarcodeError(dups[0], reception.ID)
+		}
 	}
 
-	if reception.Status != constants.ReceptionInProgress {
-		return nil, errors.New("reception is not active. Status")
+	product := newProduct(ctx, reception.ID, in)
+	for _, opt := range opts {
+		opt(product)
+	}
+	if err := s.productRepo.AddProduct(ctx, product); err != nil {
+		return nil, errs.Wrap(err, "failed to add product to reception")
 	}
 
-	product, err := s.productRepo.AddProduct(ctx, reception.ID, productType)
-	if err != nil {
-		return nil, errors.New("failed to add product to reception")
+	if err := s.addProductEvent(ctx, product, reception.PVZID); err != nil {
+		return nil, err
 	}
 
-	metrics.ProductAdded.Inc()
 	return product, nil
 }
+
+func (s *ProductService) addProductEvent(ctx context.Context, p *models.Product, pvzID int64) error {
+	return addEvent(ctx, s.outbox, events.ProductAdded, events.AggregateProduct, p.ID, productPayload(p, pvzID, p.CreatedBy.String))
+}
+
+func (s *ProductService) validateInput(ctx context.Context, in *ProductInput) error {
+	valid, err := s.types.IsActive(ctx, in.Type)
+	if err != nil {
+		return errs.Wrap(err, "failed to check product type")
+	}
+	if !valid {
+		return errs.Validation("invalid product type")
+	}
+
+	in.Barcode = strings.TrimSpace(in.Barcode)
+	if len(in.Barcode) > maxProductCodeLen || strings.ContainsAny(in.Barcode, " \t\n") {
+		return errs.Validation("invalid barcode")
+	}
+	if len(in.SKU) > maxProductCodeLen {
+		return errs.Validation("invalid sku")
+	}
+	if len(in.OrderNumber) > maxProductCodeLen {
+		return errs.Validation("invalid order number")
+	}
+
+	for _, v := range []*int{in.WeightGrams, in.LengthMM, in.WidthMM, in.HeightMM} {
+		if v != nil && *v <= 0 {
+			return errs.Validation("weight and dimensions must be positive")
+		}
+	}
+
+	if in.Cell != "" {
+		code, ok := normalizeCellCode(in.Cell)
+		if !ok {
+			return errs.Validation("invalid cell code")
+		}
+		in.Cell = code
+	}
+
+	return nil
+}
+
+func newProduct(ctx context.Context, receptionID string, in ProductInput) *models.Product {
+	return &models.Product{
+		ReceptionID: receptionID,
+		Type:        in.Type,
+		CreatedBy:   nullString(auth.UserIDFromContext(ctx)),
+		Barcode:     nullString(in.Barcode),
+		Sku:         nullString(in.SKU),
+		OrderNumber: nullString(in.OrderNumber),
+		WeightGrams: null.IntFromPtr(in.WeightGrams),
+		LengthMM:    null.IntFromPtr(in.LengthMM),
+		WidthMM:     null.IntFromPtr(in.WidthMM),
+		HeightMM:    null.IntFromPtr(in.HeightMM),
+		Status:      constants.ProductReceived,
+	}
+}
+
+func duplicateBarcodeError(dup *models.Product, receptionID string) error {
+	if dup.ReceptionID == receptionID {
+		return errs.Conflict("product with this barcode is already in this reception")
+	}
+	return errs.Conflict("product with this barcode is already in another open reception")
+}
+
+func nullString(s string) null.String {
+	return null.NewString(s, s != "")
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/metrics"
+	"context"
+	"errors"
+	"sort"
+)
+
+// MaxBatchSize ограничивает размер одного батча: 14 параметров на товар
+// (500 × 14 = 7000) должны укладываться в лимит Postgres в 65535 параметров
+// на запрос.
+const MaxBatchSize = 500
+
+// BatchItemResult — итог по одному товару батча: Product заполнен, если
+// батч сохранён, Err — если именно этот товар не прошёл проверку.
+type BatchItemResult struct {
+	Product *models.Product
+	Err     error
+}
+
+var errBatchRejected = errors.New("batch rejected")
+
+// AddProducts добавляет товары в активную приёмку ПВЗ одной транзакцией:
+// либо сохраняются все, либо ни одного. Если отклонены отдельные товары,
+// возвращаются результаты с ошибкой у каждого из них и общая ошибка
+// Validation (или Conflict, если все отказы — дубликаты штрихкодов).
+// warning — как у AddProduct, общий для всего батча.
+func (s *ProductService) AddProducts(ctx context.Context, pvzID, userRole string, items []ProductInput) ([]BatchItemResult, string, error) {
+	if userRole != constants.RoleEmployee {
+		return nil, "", errs.Forbidden("access denied")
+	}
+
+	if len(items) == 0 {
+		return nil, "", errs.Validation("products list is empty")
+	}
+	if len(items) > MaxBatchSize {
+		return nil, "", errs.Validation("too many products in one batch")
+	}
+
+	if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+		return nil, "", err
+	}
+
+	results := make([]BatchItemResult, len(items))
+	seen := make(map[string]int, len(items))
+	for i := range items {
+		if err := s.validateInput(ctx, &items[i]); err != nil {
+			if !errors.Is(err, errs.ErrValidation) {
+				return nil, "", err
+			}
+			results[i].Err = err
+			continue
+		}
+
+		if bc := items[i].Barcode; bc != "" {
+			if _, ok := seen[bc]; ok {
+				results[i].Err = errs.Conflict("duplicate barcode in batch")
+				continue
+			}
+			seen[bc] = i
+		}
+	}
+	if err := batchError(results); err != nil {
+		return results, "", err
+	}
+
+	var (
+		products []*models.Product
+		warning  string
+	)
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		reception, err := s.receptionRepo.GetActiveByPVZForUpdate(ctx, pvzID, constants.ReceptionKindDelivery)
+		if err != nil {
+			return errs.Wrap(err, "failed to get active reception")
+		}
+		if reception == nil {
+			return errs.NotFound("no active reception found")
+		}
+
+		if warning, err = s.reserveCapacity(ctx, pvzID, len(items)); err != nil {
+			return err
+		}
+
+		if len(seen) > 0 {
+			barcodes := make([]string, 0, len(seen))
+			for bc := range seen {
+				barcodes = append(barcodes, bc)
+			}
+			// Одинаковый порядок блокировок в параллельных батчах не даёт им сцепиться.
+			sort.Strings(barcodes)
+
+			for _, bc := range barcodes {
+				if err := s.productRepo.LockBarcode(ctx, bc); err != nil {
+					return errs.Wrap(err, "failed to lock barcode")
+				}
+			}
+
+			dups, err := s.productRepo.FindOpenByBarcodes(ctx, barcodes)
+			if err != nil {
+				return errs.Wrap(err, "failed to check barcodes")
+			}
+			for _, dup := range dups {
+				results[seen[dup.Barcode.String]].Err = duplicateBarcodeError(dup, reception.ID)
+			}
+			if len(dups) > 0 {
+				return errBatchRejected
+			}
+		}
+
+		// Ячейки раздаются по порядку товаров в батче.
+		plan, err := s.planCells(ctx, reception.PVZID)
+		if err != nil {
+			return err
+		}
+		cells := make([]*models.PVZCell, len(items))
+		for i, in := range items {
+			if cells[i], err = plan.place(in.Cell); err != nil {
+				results[i].Err = err
+			}
+		}
+		if batchError(results) != nil {
+			return errBatchRejected
+		}
+
+		products = make([]*models.Product, len(items))
+		for i, in := range items {
+			products[i] = newProduct(ctx, reception.ID, in)
+			withCell(cells[i])(products[i])
+		}
+		if err := s.productRepo.AddProducts(ctx, products); err != nil {
+			return errs.Wrap(err, "failed to add products to reception")
+		}
+
+		for _, p := range products {
+			if err := s.addProductEvent(ctx, p, reception.PVZID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if errors.Is(err, errBatchRejected) {
+		return results, "", batchError(results)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i, p := range products {
+		results[i].Product = p
+	}
+
+	metrics.ProductAdded.Add(float64(len(products)))
+	return results, warning, nil
+}
+
+func batchError(results []BatchItemResult) error {
+	failed, conflicts := 0, 0
+	for _, r := range results {
+		if r.Err == nil {
+			continue
+		}
+		failed++
+		if errors.Is(r.Err, errs.ErrConflict) {
+			conflicts++
+		}
+	}
+
+	switch {
+	case failed == 0:
+		return nil
+	case failed == conflicts:
+		return errs.Conflict("some products have duplicate barcodes")
+	default:
+		return errs.Validation("some products are invalid")
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"errors"
+	"testing"
+)
+
+func TestAddProducts_InsertsWholeBatch(t *testing.T) {
+	store, products, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	if _, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+
+	results, _, err := products.AddProducts(ctx, "1", constants.RoleEmployee, []ProductInput{
+		{Type: "обувь", Barcode: "111"},
+		{Type: "одежда"},
+		{Type: "электроника", Barcode: "222"},
+	})
+	if err != nil {
+		t.Fatalf("add products: %v", err)
+	}
+
+	for i, r := range results {
+		if r.Err != nil || r.Product == nil {
+			t.Fatalf("item %d: product=%v err=%v", i, r.Product, r.Err)
+		}
+	}
+	if len(store.products) != 3 {
+		t.Fatalf("stored %d products, want 3", len(store.products))
+	}
+}
+
+func TestAddProducts_RejectsWholeBatchOnInvalidItem(t *testing.T) {
+	store, products, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	if _, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	if _, _, err := products.ScanProduct(ctx, "1", constants.RoleEmployee, ProductInput{Type: "обувь", Barcode: "111"}); err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		items    []ProductInput
+		kind     error
+		rejected []int
+	}{
+		{
+			name:     "unknown type",
+			items:    []ProductInput{{Type: "обувь"}, {Type: "мебель"}},
+			kind:     errs.ErrValidation,
+			rejected: []int{1},
+		},
+		{
+			name:     "duplicate inside batch",
+			items:    []ProductInput{{Type: "обувь", Barcode: "333"}, {Type: "обувь", Barcode: "333"}},
+			kind:     errs.ErrConflict,
+			rejected: []int{1},
+		},
+		{
+			name:     "barcode already in open reception",
+			items:    []ProductInput{{Type: "обувь", Barcode: "444"}, {Type: "обувь", Barcode: "111"}},
+			kind:     errs.ErrConflict,
+			rejected: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, _, err := products.AddProducts(ctx, "1", constants.RoleEmployee, tt.items)
+			if !errors.Is(err, tt.kind) {
+				t.Fatalf("expected %v, got %v", tt.kind, err)
+			}
+			if len(results) != len(tt.items) {
+				t.Fatalf("got %d results, want %d", len(results), len(tt.items))
+			}
+
+			rejected := map[int]bool{}
+			for _, i := range tt.rejected {
+				rejected[i] = true
+			}
+			for i, r := range results {
+				if r.Product != nil {
+					t.Fatalf("item %d was saved in a rejected batch", i)
+				}
+				if (r.Err != nil) != rejected[i] {
+					t.Fatalf("item %d: err = %v, rejected = %v", i, r.Err, rejected[i])
+				}
+			}
+
+			if len(store.products) != 1 {
+				t.Fatalf("stored %d products, want only the first scan", len(store.products))
+			}
+		})
+	}
+}
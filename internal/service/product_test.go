@@ -0,0 +1,197 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testEmployeeID = "employee-1"
+
+// newProductTestServices собирает сервисы поверх общего fakeStore;
+// testEmployeeID закреплён за ПВЗ "1".
+func newProductTestServices() (*fakeStore, *ProductService, *ReceptionService) {
+	store, products, receptions, _ := newCapacityTestServices()
+	return store, products, receptions
+}
+
+// newCapacityTestServices дополнительно отдаёт ПВЗ "1", чтобы тесты могли
+// задать его вместимость.
+func newCapacityTestServices() (*fakeStore, *ProductService, *ReceptionService, *models.PVZ) {
+	store := newFakeStore()
+	tx := &fakeTx{store: store}
+	receptionRepo := &fakeReceptionRepo{store: store}
+	productRepo := &fakeProductRepo{store: store}
+	staffRepo := newFakeStaffRepo()
+	_, _ = staffRepo.Assign(context.Background(), "1", testEmployeeID, "")
+	types := NewProductTypeService(newFakeProductTypeRepo("электроника", "одежда", "обувь"), time.Minute)
+	pvz := &models.PVZ{ID: 1}
+	pvzRepo := &fakePVZRepo{pvz: []*models.PVZ{pvz}, store: store}
+
+	return store,
+		NewProductService(productRepo, receptionRepo, pvzRepo, &fakeCellRepo{store: store}, staffRepo, types, &fakeOutboxRepo{store: store}, tx),
+		NewReceptionService(receptionRepo, pvzRepo, productRepo, staffRepo, types, &fakeAuditRepo{store: store}, &fakeOutboxRepo{store: store}, tx, time.Hour),
+		pvz
+}
+
+func TestAddProduct_NoActiveReception(t *testing.T) {
+	store, products, _ := newProductTestServices()
+
+	if _, _, err := products.AddProduct(employeeCtx(testEmployeeID), "1", constants.RoleEmployee, "обувь"); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected not found error without active reception, got %v", err)
+	}
+	if len(store.products) != 0 {
+		t.Fatalf("expected no products, got %d", len(store.products))
+	}
+}
+
+func TestAddProduct_AfterCloseIsRejected(t *testing.T) {
+	ctx := employeeCtx(testEmployeeID)
+	store, products, receptions := newProductTestServices()
+
+	if _, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	if _, _, err := products.AddProduct(ctx, "1", constants.RoleEmployee, "одежда"); err != nil {
+		t.Fatalf("add product: %v", err)
+	}
+	if _, err := receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close reception: %v", err)
+	}
+
+	if _, _, err := products.AddProduct(ctx, "1", constants.RoleEmployee, "одежда"); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected not found error when adding to a closed reception, got %v", err)
+	}
+	if len(store.products) != 1 {
+		t.Fatalf("expected 1 product, got %d", len(store.products))
+	}
+	if store.productsInClosed != 0 {
+		t.Fatalf("%d products landed in a closed reception", store.productsInClosed)
+	}
+}
+
+// Проверка на уровне сервиса: fakeTx выполняет транзакции по одной, поэтому
+// тест ловит только ошибки порядка действий — статус приёмки должен читаться
+// внутри tx, а не до неё. Блокировки строк Postgres (SELECT ... FOR UPDATE)
+// он не проверяет.
+func TestAddProduct_ServiceChecksStatusInsideTx(t *testing.T) {
+	ctx := employeeCtx(testEmployeeID)
+	store, products, receptions := newProductTestServices()
+
+	if _, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+
+	const workers = 50
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		accepted int
+	)
+
+	start := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			if _, _, err := products.AddProduct(ctx, "1", constants.RoleEmployee, "электроника"); err == nil {
+				mu.Lock()
+				accepted++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-start
+		if _, err := receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+			t.Errorf("close reception: %v", err)
+		}
+	}()
+
+	close(start)
+	wg.Wait()
+
+	if store.productsInClosed != 0 {
+		t.Fatalf("%d products landed in a closed reception", store.productsInClosed)
+	}
+	if accepted != len(store.products) {
+		t.Fatalf("accepted %d products, stored %d", accepted, len(store.products))
+	}
+}
+
+func TestAddProduct_UnassignedEmployeeIsForbidden(t *testing.T) {
+	_, products, receptions := newProductTestServices()
+
+	if _, err := receptions.CreateReception(employeeCtx(testEmployeeID), "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+
+	if _, _, err := products.AddProduct(employeeCtx("stranger"), "1", constants.RoleEmployee, "обувь"); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for unassigned employee, got %v", err)
+	}
+}
+
+func TestScanProduct_RejectsDuplicateBarcodeInOpenReceptions(t *testing.T) {
+	_, products, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	if _, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+
+	scan := ProductInput{Type: "обувь", Barcode: "4601234567893"}
+	product, _, err := products.ScanProduct(ctx, "1", constants.RoleEmployee, scan)
+	if err != nil {
+		t.Fatalf("first scan: %v", err)
+	}
+	if product.Barcode.String != scan.Barcode {
+		t.Fatalf("barcode = %q, want %q", product.Barcode.String, scan.Barcode)
+	}
+
+	if _, _, err := products.ScanProduct(ctx, "1", constants.RoleEmployee, scan); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict for duplicate barcode, got %v", err)
+	}
+
+	// После закрытия приёмки тот же штрихкод можно принять снова.
+	if _, err := receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close reception: %v", err)
+	}
+	if _, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	if _, _, err := products.ScanProduct(ctx, "1", constants.RoleEmployee, scan); err != nil {
+		t.Fatalf("scan after close: %v", err)
+	}
+}
+
+func TestScanProduct_ValidatesInput(t *testing.T) {
+	_, products, _ := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+	zero := 0
+
+	tests := []struct {
+		name string
+		in   ProductInput
+	}{
+		{"missing barcode", ProductInput{Type: "обувь"}},
+		{"barcode with spaces", ProductInput{Type: "обувь", Barcode: "460 123"}},
+		{"non-positive weight", ProductInput{Type: "обувь", Barcode: "4601234567893", WeightGrams: &zero}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := products.ScanProduct(ctx, "1", constants.RoleEmployee, tt.in); !errors.Is(err, errs.ErrValidation) {
+				t.Fatalf("expected validation error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"context"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const maxProductTypeCodeLen = 20
+
+// Срок хранения посылки в ПВЗ по умолчанию и верхняя граница, дней.
+const (
+	defaultStorageDays = 7
+	maxStorageDays     = 365
+)
+
+type ProductTypeService struct {
+	repo  ProductTypeRepository
+	cache *productTypeCache
+}
+
+// NewProductTypeService: cacheTTL ограничивает, как долго другие экземпляры
+// сервиса видят устаревший справочник; свои изменения сбрасывают кеш сразу.
+func NewProductTypeService(repo ProductTypeRepository, cacheTTL time.Duration) *ProductTypeService {
+	return &ProductTypeService{
+		repo:  repo,
+		cache: &productTypeCache{ttl: cacheTTL},
+	}
+}
+
+// List отдаёт модератору весь справочник, сотруднику — только активные типы.
+func (s *ProductTypeService) List(ctx context.Context, userRole string) (models.ProductTypeSlice, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	types, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list product types")
+	}
+
+	if userRole == constants.RoleModerator {
+		return types, nil
+	}
+
+	active := make(models.ProductTypeSlice, 0, len(types))
+	for _, pt := range types {
+		if pt.IsActive {
+			active = append(active, pt)
+		}
+	}
+	return active, nil
+}
+
+// Create добавляет тип; storageDays == nil — срок хранения по умолчанию.
+func (s *ProductTypeService) Create(ctx context.Context, code, nameRu, nameEn string, isActive *bool, storageDays *int, userRole string) (*models.ProductType, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	code = strings.TrimSpace(code)
+	if code == "" || utf8.RuneCountInString(code) > maxProductTypeCodeLen {
+		return nil, errs.Validation("invalid product type code")
+	}
+	if strings.TrimSpace(nameRu) == "" {
+		return nil, errs.Validation("nameRu is required")
+	}
+	if !validStorageDays(storageDays) {
+		return nil, errs.Validation("invalid storageDays")
+	}
+
+	pt := &models.ProductType{
+		Code:        code,
+		NameRu:      nameRu,
+		NameEn:      nameEn,
+		IsActive:    isActive == nil || *isActive,
+		StorageDays: defaultStorageDays,
+	}
+	if storageDays != nil {
+		pt.StorageDays = *storageDays
+	}
+	if err := s.repo.Create(ctx, pt); err != nil {
+		return nil, errs.Wrap(err, "failed to create product type")
+	}
+
+	s.cache.invalidate()
+	return pt, nil
+}
+
+// Update меняет названия, флаг активности и срок хранения; nil оставляет
+// поле как есть. Новый срок действует для приёмок, закрытых после изменения.
+func (s *ProductTypeService) Update(ctx context.Context, code string, nameRu, nameEn *string, isActive *bool, storageDays *int, userRole string) (*models.ProductType, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if nameRu != nil && strings.TrimSpace(*nameRu) == "" {
+		return nil, errs.Validation("nameRu must not be empty")
+	}
+	if !validStorageDays(storageDays) {
+		return nil, errs.Validation("invalid storageDays")
+	}
+
+	pt, err := s.repo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get product type")
+	}
+	if pt == nil {
+		return nil, errs.NotFound("product type not found")
+	}
+
+	if nameRu != nil {
+		pt.NameRu = *nameRu
+	}
+	if nameEn != nil {
+		pt.NameEn = *nameEn
+	}
+	if isActive != nil {
+		pt.IsActive = *isActive
+	}
+	if storageDays != nil {
+		pt.StorageDays = *storageDays
+	}
+	if err := s.repo.Update(ctx, pt); err != nil {
+		return nil, errs.Wrap(err, "failed to update product type")
+	}
+
+	s.cache.invalidate()
+	return pt, nil
+}
+
+func (s *ProductTypeService) Delete(ctx context.Context, code, userRole string) error {
+	if userRole != constants.RoleModerator {
+		return errs.Forbidden("access denied")
+	}
+
+	if err := s.repo.Delete(ctx, code); err != nil {
+		return errs.Wrap(err, "failed to delete product type")
+	}
+
+	s.cache.invalidate()
+	return nil
+}
+
+// IsActive проверяет код по закешированному справочнику.
+func (s *ProductTypeService) IsActive(ctx context.Context, code string) (bool, error) {
+	types, err := s.catalog(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	pt, ok := types[code]
+	return ok && pt.IsActive, nil
+}
+
+// StoragePeriod отдаёт срок хранения посылки типа code, в том числе
+// выключенного: товары такого типа могут ещё лежать в ПВЗ.
+func (s *ProductTypeService) StoragePeriod(ctx context.Context, code string) (time.Duration, error) {
+	types, err := s.catalog(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	pt, ok := types[code]
+	if !ok {
+		return 0, errs.NotFound("product type not found")
+	}
+	return time.Duration(pt.StorageDays) * 24 * time.Hour, nil
+}
+
+func (s *ProductTypeService) catalog(ctx context.Context) (map[string]models.ProductType, error) {
+	types, gen, ok := s.cache.get()
+	if !ok {
+		list, err := s.repo.List(ctx)
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to load product types")
+		}
+		types = s.cache.set(list, gen)
+	}
+	return types, nil
+}
+
+func validStorageDays(days *int) bool {
+	return days == nil || (*days > 0 && *days <= maxStorageDays)
+}
+
+// productTypeCache хранит копию справочника по кодам. gen растёт при каждом
+// сбросе: справочник, загруженный до сброса, в кеш уже не попадает.
+type productTypeCache struct {
+	mu       sync.RWMutex
+	ttl      time.Duration
+	types    map[string]models.ProductType
+	loadedAt time.Time
+	gen      uint64
+}
+
+// get отдаёт справочник или, если его нет, поколение для последующего set.
+func (c *productTypeCache) get() (map[string]models.ProductType, uint64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.types == nil || time.Since(c.loadedAt) > c.ttl {
+		return nil, c.gen, false
+	}
+	return c.types, c.gen, true
+}
+
+// set сохраняет справочник, только если с начала загрузки (поколение gen)
+// кеш не сбрасывали; сам справочник отдаётся в любом случае.
+func (c *productTypeCache) set(list models.ProductTypeSlice, gen uint64) map[string]models.ProductType {
+	types := make(map[string]models.ProductType, len(list))
+	for _, pt := range list {
+		types[pt.Code] = *pt
+	}
+
+	c.mu.Lock()
+	if c.gen == gen {
+		c.types = types
+		c.loadedAt = time.Now()
+	}
+	c.mu.Unlock()
+
+	return types
+}
+
+func (c *productTypeCache) invalidate() {
+	c.mu.Lock()
+	c.types = nil
+	c.gen++
+	c.mu.Unlock()
+}
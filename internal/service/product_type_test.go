@@ -0,0 +1,95 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestProductType_CatalogIsCached(t *testing.T) {
+	repo := newFakeProductTypeRepo("обувь")
+	svc := NewProductTypeService(repo, time.Minute)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		ok, err := svc.IsActive(ctx, "обувь")
+		if err != nil || !ok {
+			t.Fatalf("IsActive = %v, %v; want true", ok, err)
+		}
+	}
+	if repo.lists != 1 {
+		t.Fatalf("catalog loaded %d times, want 1", repo.lists)
+	}
+}
+
+func TestProductType_ChangesInvalidateCache(t *testing.T) {
+	svc := NewProductTypeService(newFakeProductTypeRepo("обувь"), time.Hour)
+	ctx := context.Background()
+
+	if ok, _ := svc.IsActive(ctx, "книги"); ok {
+		t.Fatal("unknown type reported as active")
+	}
+
+	if _, err := svc.Create(ctx, "книги", "Книги", "Books", nil, nil, constants.RoleModerator); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if ok, _ := svc.IsActive(ctx, "книги"); !ok {
+		t.Fatal("new type is not visible after create")
+	}
+
+	inactive := false
+	if _, err := svc.Update(ctx, "книги", nil, nil, &inactive, nil, constants.RoleModerator); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if ok, _ := svc.IsActive(ctx, "книги"); ok {
+		t.Fatal("deactivated type is still active")
+	}
+	pt, err := svc.Update(ctx, "книги", nil, nil, nil, nil, constants.RoleModerator)
+	if err != nil {
+		t.Fatalf("empty update: %v", err)
+	}
+	if pt.NameRu != "Книги" || pt.NameEn != "Books" || pt.IsActive {
+		t.Fatalf("empty update changed the type: %+v", pt)
+	}
+	empty := " "
+	if _, err := svc.Update(ctx, "книги", &empty, nil, nil, nil, constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error for empty nameRu, got %v", err)
+	}
+}
+
+func TestProductTypeCache_StaleLoadIsNotStored(t *testing.T) {
+	cache := &productTypeCache{ttl: time.Hour}
+
+	_, gen, ok := cache.get()
+	if ok {
+		t.Fatal("empty cache reported a hit")
+	}
+
+	// Справочник изменили, пока шла загрузка: старый список не кешируется.
+	cache.invalidate()
+	types := cache.set(models.ProductTypeSlice{{Code: "обувь", IsActive: true}}, gen)
+	if _, ok := types["обувь"]; !ok {
+		t.Fatal("loaded catalog must still be returned to the caller")
+	}
+	if _, _, ok := cache.get(); ok {
+		t.Fatal("catalog loaded before invalidation was stored")
+	}
+
+	_, gen, _ = cache.get()
+	cache.set(models.ProductTypeSlice{{Code: "обувь", IsActive: true}}, gen)
+	if _, _, ok := cache.get(); !ok {
+		t.Fatal("fresh catalog was not stored")
+	}
+}
+
+func TestProductType_OnlyModeratorCanEdit(t *testing.T) {
+	svc := NewProductTypeService(newFakeProductTypeRepo(), time.Minute)
+
+	if _, err := svc.Create(context.Background(), "книги", "Книги", "", nil, nil, constants.RoleEmployee); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden, got %v", err)
+	}
+}
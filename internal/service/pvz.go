@@ -2,47 +2,165 @@ package service
 
 import (
 	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
 	"PVZ/models"
+	"PVZ/pkg/auth"
 	"PVZ/pkg/metrics"
+	"PVZ/pkg/uuid"
 	"context"
-	"errors"
+	"strconv"
+	"time"
 )
 
 type PVZService struct {
-	repo PVZRepository
+	repo   PVZRepository
+	staff  StaffRepository
+	users  UserRepository
+	cities CityRepository
+	outbox OutboxRepository
+	tx     TxManager
 }
 
-func NewPVZService(repo PVZRepository) *PVZService {
-	return &PVZService{repo: repo}
+func NewPVZService(repo PVZRepository, staff StaffRepository, users UserRepository, cities CityRepository, outbox OutboxRepository, tx TxManager) *PVZService {
+	return &PVZService{repo: repo, staff: staff, users: users, cities: cities, outbox: outbox, tx: tx}
 }
 
 func (s *PVZService) CreatePVZ(ctx context.Context, name string, city string, userRole string) (*models.PVZ, error) {
-	if userRole != "moderator" {
-		return nil, errors.New("access denied")
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
 	}
 
-	if city != constants.CityKazan && city != constants.CityMoscow && city != constants.CitySpb {
-		return nil, errors.New("invalid city")
+	c, err := s.cities.GetByName(ctx, city)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get city")
+	}
+	if c == nil || !c.Enabled {
+		return nil, errs.Validation("invalid city")
 	}
 
-	pvz, err := s.repo.CreatePVZ(ctx, name, city)
+	var pvz *models.PVZ
+	err = s.tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		pvz, err = s.repo.CreatePVZ(ctx, name, city)
+		if err != nil {
+			return errs.Wrap(err, "failed to create PVZ")
+		}
+
+		return addEvent(ctx, s.outbox, events.PVZCreated, events.AggregatePVZ, strconv.FormatInt(pvz.ID, 10), events.PVZPayload{
+			ID:        pvz.ID,
+			Name:      pvz.Name,
+			City:      pvz.City,
+			CreatedAt: pvz.CreatedAt,
+		})
+	})
 	if err != nil {
-		return nil, errors.New("failed to add product to reception")
+		return nil, err
 	}
 
 	metrics.PVZCreated.Inc()
 	return pvz, nil
 }
 
-func (s *PVZService) GetPVZList(ctx context.Context, offset, limit int, city string, userRole string) ([]*models.PVZ, error) {
-	if userRole != "employee" && userRole != "moderator" {
-		return nil, errors.New("access denied")
+func (s *PVZService) GetPVZList(ctx context.Context, offset, limit int, city string, startDate, endDate *time.Time, userRole string) ([]*models.PVZ, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if startDate != nil && endDate != nil && startDate.After(*endDate) {
+		return nil, errs.Validation("startDate must not be after endDate")
+	}
+
+	if city != "" {
+		c, err := s.cities.GetByName(ctx, city)
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to get city")
+		}
+		if c == nil {
+			return nil, errs.Validation("unknown city")
+		}
 	}
 
-	list, err := s.repo.GetPVZList(ctx, offset, limit, city)
+	list, err := s.repo.GetPVZList(ctx, offset, limit, city, startDate, endDate)
 	if err != nil {
-		return nil, errors.New("failed to get PVZ list")
+		return nil, errs.Wrap(err, "failed to get PVZ list")
 	}
 
 	return list, nil
 }
+
+func (s *PVZService) AssignStaff(ctx context.Context, pvzID, userID, userRole string) (*models.PVZStaff, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if !uuid.IsValid(userID) {
+		return nil, errs.Validation("invalid user ID format")
+	}
+
+	if err := s.ensurePVZExists(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return nil, errs.NotFound("user not found")
+	}
+	if user.Role != constants.RoleEmployee {
+		return nil, errs.Validation("only employees can be assigned to a PVZ")
+	}
+
+	staff, err := s.staff.Assign(ctx, pvzID, userID, auth.UserIDFromContext(ctx))
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to assign staff")
+	}
+
+	return staff, nil
+}
+
+func (s *PVZService) UnassignStaff(ctx context.Context, pvzID, userID, userRole string) error {
+	if userRole != constants.RoleModerator {
+		return errs.Forbidden("access denied")
+	}
+
+	if !uuid.IsValid(userID) {
+		return errs.Validation("invalid user ID format")
+	}
+
+	if err := s.staff.Unassign(ctx, pvzID, userID); err != nil {
+		return errs.Wrap(err, "failed to unassign staff")
+	}
+
+	return nil
+}
+
+func (s *PVZService) ListStaff(ctx context.Context, pvzID, userRole string) ([]*models.PVZStaff, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if err := s.ensurePVZExists(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	staff, err := s.staff.ListByPVZ(ctx, pvzID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list staff")
+	}
+
+	return staff, nil
+}
+
+func (s *PVZService) ensurePVZExists(ctx context.Context, pvzID string) error {
+	pvz, err := s.repo.GetByID(ctx, pvzID)
+	if err != nil {
+		return errs.Wrap(err, "failed to get PVZ")
+	}
+	if pvz == nil {
+		return errs.NotFound("PVZ not found")
+	}
+	return nil
+}
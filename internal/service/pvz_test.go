@@ -0,0 +1,74 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"context"
+	"errors"
+	"testing"
+)
+
+func newPVZTestService() *PVZService {
+	cities := newFakeCityRepo(
+		&models.City{ID: 1, Name: "Москва", Timezone: "Europe/Moscow", Enabled: true},
+		&models.City{ID: 2, Name: "Казань", Timezone: "Europe/Moscow", Enabled: false},
+	)
+	store := newFakeStore()
+	return NewPVZService(&fakePVZRepo{}, newFakeStaffRepo(), &fakeUserRepo{}, cities, &fakeOutboxRepo{store: store}, &fakeTx{store: store})
+}
+
+func TestCreatePVZ_ValidatesCityAgainstRegistry(t *testing.T) {
+	svc := newPVZTestService()
+	ctx := context.Background()
+
+	if _, err := svc.CreatePVZ(ctx, "ПВЗ №1", "Москва", constants.RoleModerator); err != nil {
+		t.Fatalf("enabled city: %v", err)
+	}
+
+	for _, city := range []string{"Казань", "Тверь"} {
+		if _, err := svc.CreatePVZ(ctx, "ПВЗ №2", city, constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+			t.Errorf("city %q: expected validation error, got %v", city, err)
+		}
+	}
+}
+
+func TestGetPVZList_RejectsUnknownCity(t *testing.T) {
+	svc := newPVZTestService()
+
+	if _, err := svc.GetPVZList(context.Background(), 0, 10, "Тверь", nil, nil, constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	// Выключенный город по-прежнему можно использовать как фильтр.
+	if _, err := svc.GetPVZList(context.Background(), 0, 10, "Казань", nil, nil, constants.RoleModerator); err != nil {
+		t.Fatalf("disabled city filter: %v", err)
+	}
+}
+
+func TestStaff_ValidatesUserID(t *testing.T) {
+	svc := newPVZTestService()
+	ctx := context.Background()
+
+	if _, err := svc.AssignStaff(ctx, "1", "not-a-uuid", constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("assign: expected validation error, got %v", err)
+	}
+	if err := svc.UnassignStaff(ctx, "1", "not-a-uuid", constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("unassign: expected validation error, got %v", err)
+	}
+}
+
+func TestCity_CreateValidatesTimezone(t *testing.T) {
+	svc := NewCityService(newFakeCityRepo())
+
+	if _, err := svc.Create(context.Background(), "Екатеринбург", "Свердловская область", "Mars/Olympus", nil, constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	city, err := svc.Create(context.Background(), "Екатеринбург", "Свердловская область", "Asia/Yekaterinburg", nil, constants.RoleModerator)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if !city.Enabled {
+		t.Fatal("new city should be enabled by default")
+	}
+}
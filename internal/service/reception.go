@@ -2,89 +2,280 @@ package service
 
 import (
 	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
 	"PVZ/models"
+	"PVZ/pkg/auth"
 	"PVZ/pkg/metrics"
+	"PVZ/pkg/pagination"
 	"context"
-	"errors"
-	"log/slog"
+	"strconv"
+	"time"
 )
 
 type ReceptionService struct {
-	repo ReceptionRepository
+	repo     ReceptionRepository
+	pvzs     PVZRepository
+	products ProductLifecycleRepository
+	staff    StaffRepository
+	types    ProductTypeCatalog
+	audit    AuditRepository
+	outbox   OutboxRepository
+	tx       TxManager
+
+	// reopenWindow — сколько после закрытия модератор может вернуть приёмку в работу.
+	reopenWindow time.Duration
 }
 
-func NewReceptionService(repo ReceptionRepository) *ReceptionService {
-	return &ReceptionService{repo: repo}
+func NewReceptionService(repo ReceptionRepository, pvzs PVZRepository, products ProductLifecycleRepository, staff StaffRepository, types ProductTypeCatalog, audit AuditRepository, outbox OutboxRepository, tx TxManager, reopenWindow time.Duration) *ReceptionService {
+	return &ReceptionService{repo: repo, pvzs: pvzs, products: products, staff: staff, types: types, audit: audit, outbox: outbox, tx: tx, reopenWindow: reopenWindow}
 }
 
 func (s *ReceptionService) CreateReception(ctx context.Context, pvzID, userRole string) (*models.Reception, error) {
+	rec, err := s.createReception(ctx, pvzID, constants.ReceptionKindDelivery, userRole)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ReceptionCreated.Inc()
+	return rec, nil
+}
+
+func (s *ReceptionService) createReception(ctx context.Context, pvzID, kind, userRole string) (*models.Reception, error) {
 	if userRole != constants.RoleEmployee {
-		return nil, errors.New("Access denied")
+		return nil, errs.Forbidden("access denied")
 	}
 
-	active, err := s.repo.GetActiveByPVZ(ctx, pvzID)
-	if err != nil {
-		slog.Error("Failed to get active reception", err)
+	if err := requireStaff(ctx, s.staff, pvzID); err != nil {
 		return nil, err
 	}
-	if active != nil {
-		return nil, errors.New("There is already an active reception")
-	}
 
-	rec, err := s.repo.CreateReception(ctx, pvzID)
+	var rec *models.Reception
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		active, err := s.repo.GetActiveByPVZForUpdate(ctx, pvzID, kind)
+		if err != nil {
+			return errs.Wrap(err, "failed to get active reception")
+		}
+		if active != nil {
+			return errs.Conflict("there is already an active " + receptionNoun(kind))
+		}
+
+		rec, err = s.repo.CreateReception(ctx, pvzID, kind, auth.UserIDFromContext(ctx))
+		if err != nil {
+			return errs.Wrap(err, "failed to create reception")
+		}
+
+		if err := s.recordStatusChange(ctx, rec.ID, "", constants.ReceptionInProgress); err != nil {
+			return err
+		}
+
+		return addEvent(ctx, s.outbox, events.ReceptionCreated, events.AggregateReception, rec.ID, events.ReceptionPayload{
+			ID:        rec.ID,
+			PVZID:     rec.PVZID,
+			Kind:      kind,
+			Status:    constants.ReceptionInProgress,
+			ChangedBy: auth.UserIDFromContext(ctx),
+		})
+	})
 	if err != nil {
-		slog.Error("Failed to create reception", err)
 		return nil, err
 	}
 
 	rec.Status = constants.ReceptionInProgress
-
-	metrics.ReceptionCreated.Inc()
 	return rec, nil
 }
 
 func (s *ReceptionService) CloseReception(ctx context.Context, pvzID, userRole string) (*models.Reception, error) {
+	return s.closeReception(ctx, pvzID, constants.ReceptionKindDelivery, userRole)
+}
+
+func (s *ReceptionService) closeReception(ctx context.Context, pvzID, kind, userRole string) (*models.Reception, error) {
 	if userRole != constants.RoleEmployee {
-		return nil, errors.New("Access denied")
+		return nil, errs.Forbidden("access denied")
 	}
 
-	active, err := s.repo.GetActiveByPVZ(ctx, pvzID)
-	if err != nil {
-		slog.Error("Failed to get active reception for PVZ %s: %v", pvzID, err)
+	if err := requireStaff(ctx, s.staff, pvzID); err != nil {
 		return nil, err
 	}
-	if active == nil {
-		return nil, errors.New("no active reception to close")
-	}
 
-	if err := s.repo.CloseReception(ctx, active.ID); err != nil {
-		slog.Error("Failed to close reception %s: %v", active.ID, err)
+	var active *models.Reception
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		active, err = s.repo.GetActiveByPVZForUpdate(ctx, pvzID, kind)
+		if err != nil {
+			return errs.Wrap(err, "failed to get active reception")
+		}
+		if active == nil {
+			return errs.NotFound("no active " + receptionNoun(kind) + " to close")
+		}
+
+		return s.transition(ctx, active, constants.ReceptionClosed)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	active.Status = constants.ReceptionClosed
 	return active, nil
 }
 
 func (s *ReceptionService) DeleteLastProduct(ctx context.Context, pvzID, userRole string) (*models.Reception, error) {
 	if userRole != constants.RoleEmployee {
-		return nil, errors.New("Access denied")
+		return nil, errs.Forbidden("access denied")
 	}
 
-	active, err := s.repo.GetActiveByPVZ(ctx, pvzID)
+	if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+		return nil, err
+	}
+
+	var rec *models.Reception
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		active, err := s.repo.GetActiveByPVZForUpdate(ctx, pvzID, constants.ReceptionKindDelivery)
+		if err != nil {
+			return errs.Wrap(err, "failed to get active reception")
+		}
+		if active == nil {
+			return errs.NotFound("no active reception")
+		}
+
+		product, err := s.repo.DeleteLastProduct(ctx, active.ID)
+		if err != nil {
+			return errs.Wrap(err, "failed to delete last product")
+		}
+
+		if err := s.recordDeletion(ctx, product, active.PVZID); err != nil {
+			return err
+		}
+
+		rec, err = s.repo.GetByID(ctx, active.ID)
+		if err != nil {
+			return errs.Wrap(err, "failed to get reception")
+		}
+
+		return nil
+	})
 	if err != nil {
-		slog.Error("Failed to get active reception for PVZ %s: %v", pvzID, err)
 		return nil, err
 	}
-	if active == nil {
-		return nil, errors.New("no active reception")
+
+	return rec, nil
+}
+
+// DeleteProduct удаляет конкретный товар из открытой приёмки, в отличие от
+// DeleteLastProduct порядок добавления не важен.
+func (s *ReceptionService) DeleteProduct(ctx context.Context, receptionID, productID, userRole string) error {
+	if userRole != constants.RoleEmployee {
+		return errs.Forbidden("access denied")
 	}
 
-	rec, err := s.repo.DeleteLastProduct(ctx, active.ID)
+	if err := s.requireReceptionStaff(ctx, receptionID); err != nil {
+		return err
+	}
+
+	return s.tx.Do(ctx, func(ctx context.Context) error {
+		locked, err := s.lockReception(ctx, receptionID)
+		if err != nil {
+			return err
+		}
+		if locked.Status != constants.ReceptionInProgress {
+			return errs.Conflict("reception is not in progress")
+		}
+
+		product, err := s.repo.DeleteProduct(ctx, receptionID, productID)
+		if err != nil {
+			return errs.Wrap(err, "failed to delete product")
+		}
+
+		return s.recordDeletion(ctx, product, locked.PVZID)
+	})
+}
+
+// recordDeletion пишет удаление в журнал аудита и outbox. Для позиции возврата
+// исходный товар снова считается выданным.
+func (s *ReceptionService) recordDeletion(ctx context.Context, product *models.Product, pvzID int64) error {
+	if err := restoreOriginal(ctx, s.products, product); err != nil {
+		return err
+	}
+
+	actorID := auth.UserIDFromContext(ctx)
+	err := s.audit.RecordProductAction(ctx, &models.ProductAuditLog{
+		Action:      constants.ProductActionDeleted,
+		ProductID:   product.ID,
+		ReceptionID: product.ReceptionID,
+		ProductType: product.Type,
+		Barcode:     product.Barcode,
+		ActorID:     nullString(actorID),
+	})
 	if err != nil {
-		slog.Error("Failed to delete last product for reception %s: %v", active.ID, err)
-		return nil, err
+		return errs.Wrap(err, "failed to record product deletion")
+	}
+
+	return addEvent(ctx, s.outbox, events.ProductDeleted, events.AggregateProduct, product.ID, productPayload(product, pvzID, actorID))
+}
+
+// GetReception доступен модератору и сотрудникам ПВЗ, которому принадлежит приёмка.
+func (s *ReceptionService) GetReception(ctx context.Context, receptionID, userRole string) (*models.Reception, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	rec, err := s.repo.GetByID(ctx, receptionID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get reception")
+	}
+	if rec == nil {
+		return nil, errs.NotFound("reception not found")
+	}
+
+	if userRole == constants.RoleEmployee {
+		if err := requireStaff(ctx, s.staff, strconv.FormatInt(rec.PVZID, 10)); err != nil {
+			return nil, err
+		}
 	}
 
 	return rec, nil
 }
+
+// ListReceptions отдаёт страницу истории приёмок ПВЗ и курсор следующей
+// страницы (пустой, если страница последняя).
+func (s *ReceptionService) ListReceptions(ctx context.Context, pvzID, status string, startDate, endDate *time.Time, cursor string, limit int, userRole string) (models.ReceptionSlice, string, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, "", errs.Forbidden("access denied")
+	}
+
+	if status != "" && status != constants.ReceptionInProgress && status != constants.ReceptionClosed && status != constants.ReceptionCancelled {
+		return nil, "", errs.Validation("invalid status")
+	}
+	if startDate != nil && endDate != nil && startDate.After(*endDate) {
+		return nil, "", errs.Validation("startDate must not be after endDate")
+	}
+
+	var after *pagination.Cursor
+	if cursor != "" {
+		var err error
+		after, err = pagination.DecodeCursor(cursor)
+		if err != nil {
+			return nil, "", errs.Validation("invalid cursor")
+		}
+	}
+
+	if userRole == constants.RoleEmployee {
+		if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	// Берём на одну запись больше, чтобы понять, есть ли следующая страница.
+	list, err := s.repo.ListByPVZ(ctx, pvzID, status, startDate, endDate, after, limit+1)
+	if err != nil {
+		return nil, "", errs.Wrap(err, "failed to list receptions")
+	}
+
+	var next string
+	if len(list) > limit {
+		list = list[:limit]
+		last := list[len(list)-1]
+		next = pagination.Cursor{Time: last.DateTime, ID: last.ID}.Encode()
+	}
+
+	return list, next, nil
+}
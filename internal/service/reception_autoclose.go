@@ -0,0 +1,78 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"context"
+	"errors"
+	"time"
+)
+
+// StaleReceptions — итог одного прохода по зависшим приёмкам.
+type StaleReceptions struct {
+	Stale  models.ReceptionSlice
+	Closed int
+}
+
+// CloseStaleReceptions закрывает приёмки, открытые раньше olderThan; для
+// возобновлённой приёмки возраст считается от возобновления. Вызывается
+// фоновым воркером, поэтому автора у перехода нет. При flagOnly статус не
+// меняется — приёмки только возвращаются в Stale. Ошибка одной приёмки не
+// останавливает проход: итог возвращается вместе с объединённой ошибкой.
+func (s *ReceptionService) CloseStaleReceptions(ctx context.Context, olderThan time.Time, limit int, flagOnly bool) (StaleReceptions, error) {
+	var res StaleReceptions
+
+	stale, err := s.repo.ListStaleInProgress(ctx, olderThan, limit)
+	if err != nil {
+		return res, errs.Wrap(err, "failed to list stale receptions")
+	}
+	res.Stale = stale
+	if flagOnly {
+		return res, nil
+	}
+
+	var failed []error
+	for _, rec := range stale {
+		closed, err := s.closeStale(ctx, rec.ID, olderThan)
+		if err != nil {
+			failed = append(failed, err)
+			continue
+		}
+		if closed {
+			res.Closed++
+		}
+	}
+
+	return res, errors.Join(failed...)
+}
+
+// closeStale перепроверяет приёмку под блокировкой: пока шёл проход, её
+// могли закрыть или отменить вручную.
+func (s *ReceptionService) closeStale(ctx context.Context, receptionID string, olderThan time.Time) (bool, error) {
+	var closed bool
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		rec, err := s.lockReception(ctx, receptionID)
+		if err != nil {
+			return err
+		}
+		if rec.Status != constants.ReceptionInProgress || !openedAt(rec).Before(olderThan) {
+			return nil
+		}
+
+		if err := s.transition(ctx, rec, constants.ReceptionClosed); err != nil {
+			return err
+		}
+		closed = true
+		return nil
+	})
+	return closed, err
+}
+
+// openedAt — когда приёмка последний раз перешла в работу.
+func openedAt(rec *models.Reception) time.Time {
+	if rec.ReopenedAt.Valid {
+		return rec.ReopenedAt.Time
+	}
+	return rec.DateTime
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCloseStaleReceptions(t *testing.T) {
+	store, _, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	rec, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	store.receptions[rec.ID].DateTime = time.Now().Add(-13 * time.Hour)
+	olderThan := time.Now().Add(-12 * time.Hour)
+
+	res, err := receptions.CloseStaleReceptions(context.Background(), olderThan, 10, true)
+	if err != nil {
+		t.Fatalf("flag stale receptions: %v", err)
+	}
+	if len(res.Stale) != 1 || res.Closed != 0 {
+		t.Fatalf("flag mode: got %d stale, %d closed", len(res.Stale), res.Closed)
+	}
+	if store.receptions[rec.ID].Status != constants.ReceptionInProgress {
+		t.Fatalf("flag mode must not change status, got %q", store.receptions[rec.ID].Status)
+	}
+
+	res, err = receptions.CloseStaleReceptions(context.Background(), olderThan, 10, false)
+	if err != nil {
+		t.Fatalf("close stale receptions: %v", err)
+	}
+	if res.Closed != 1 {
+		t.Fatalf("expected 1 closed reception, got %d", res.Closed)
+	}
+
+	stored := store.receptions[rec.ID]
+	if stored.Status != constants.ReceptionClosed || !stored.ClosedAt.Valid {
+		t.Fatalf("expected closed reception with closed_at, got %q", stored.Status)
+	}
+	if stored.ClosedBy.Valid {
+		t.Fatalf("auto-closed reception should have no closed_by, got %q", stored.ClosedBy.String)
+	}
+
+	last := store.history[len(store.history)-1]
+	if last.ToStatus != constants.ReceptionClosed || last.ChangedBy.Valid {
+		t.Fatalf("unexpected history entry: to=%q changedBy=%v", last.ToStatus, last.ChangedBy)
+	}
+}
+
+func TestCloseStaleReceptions_SkipsFreshReceptions(t *testing.T) {
+	store, _, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	rec, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+
+	res, err := receptions.CloseStaleReceptions(context.Background(), time.Now().Add(-12*time.Hour), 10, false)
+	if err != nil {
+		t.Fatalf("close stale receptions: %v", err)
+	}
+	if len(res.Stale) != 0 || res.Closed != 0 {
+		t.Fatalf("expected nothing to close, got %d stale, %d closed", len(res.Stale), res.Closed)
+	}
+	if store.receptions[rec.ID].Status != constants.ReceptionInProgress {
+		t.Fatalf("fresh reception must stay in progress")
+	}
+}
+
+func TestCloseStaleReceptions_CountsFromReopen(t *testing.T) {
+	store, _, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	rec, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	store.receptions[rec.ID].DateTime = time.Now().Add(-13 * time.Hour)
+	if _, err := receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close reception: %v", err)
+	}
+	if _, err := receptions.ReopenReception(context.Background(), rec.ID, constants.RoleModerator); err != nil {
+		t.Fatalf("reopen reception: %v", err)
+	}
+
+	olderThan := time.Now().Add(-12 * time.Hour)
+	res, err := receptions.CloseStaleReceptions(context.Background(), olderThan, 10, false)
+	if err != nil {
+		t.Fatalf("close stale receptions: %v", err)
+	}
+	if len(res.Stale) != 0 || res.Closed != 0 {
+		t.Fatalf("reopened reception must not be auto-closed, got %d stale, %d closed", len(res.Stale), res.Closed)
+	}
+	if store.receptions[rec.ID].Status != constants.ReceptionInProgress {
+		t.Fatalf("status = %q, want in_progress", store.receptions[rec.ID].Status)
+	}
+
+	// Возобновлённая приёмка всё равно закрывается, если простояла дольше срока.
+	store.receptions[rec.ID].ReopenedAt.Time = time.Now().Add(-13 * time.Hour)
+	if res, err = receptions.CloseStaleReceptions(context.Background(), olderThan, 10, false); err != nil || res.Closed != 1 {
+		t.Fatalf("expected stale reopened reception to be closed, got %d closed, err %v", res.Closed, err)
+	}
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"PVZ/pkg/auth"
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+// receptionTransitions — допустимые переходы статуса приёмки. Закрытую приёмку
+// можно вернуть в работу (reopen), отменённая — конечное состояние.
+var receptionTransitions = map[string][]string{
+	constants.ReceptionInProgress: {constants.ReceptionClosed, constants.ReceptionCancelled},
+	constants.ReceptionClosed:     {constants.ReceptionInProgress},
+}
+
+// receptionStatusEvents — событие outbox для каждого целевого статуса перехода.
+var receptionStatusEvents = map[string]string{
+	constants.ReceptionClosed:     events.ReceptionClosed,
+	constants.ReceptionCancelled:  events.ReceptionCancelled,
+	constants.ReceptionInProgress: events.ReceptionReopened,
+}
+
+func canTransition(from, to string) bool {
+	for _, next := range receptionTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CancelReception отменяет приёмку в работе. Сотрудник может отменить только
+// приёмку своего ПВЗ, модератор — любую.
+func (s *ReceptionService) CancelReception(ctx context.Context, receptionID, userRole string) (*models.Reception, error) {
+	if userRole != constants.RoleEmployee && userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if userRole == constants.RoleEmployee {
+		if err := s.requireReceptionStaff(ctx, receptionID); err != nil {
+			return nil, err
+		}
+	}
+
+	var rec *models.Reception
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		rec, err = s.lockReception(ctx, receptionID)
+		if err != nil {
+			return err
+		}
+
+		return s.transition(ctx, rec, constants.ReceptionCancelled)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+// ReopenReception возвращает закрытую приёмку в работу; только модератор
+// и только в пределах reopenWindow после закрытия.
+func (s *ReceptionService) ReopenReception(ctx context.Context, receptionID, userRole string) (*models.Reception, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	var rec *models.Reception
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		var err error
+		rec, err = s.lockReception(ctx, receptionID)
+		if err != nil {
+			return err
+		}
+
+		if rec.Status == constants.ReceptionClosed &&
+			(!rec.ClosedAt.Valid || time.Since(rec.ClosedAt.Time) > s.reopenWindow) {
+			return errs.Conflict("reopen window has expired")
+		}
+
+		active, err := s.repo.GetActiveByPVZForUpdate(ctx, strconv.FormatInt(rec.PVZID, 10), rec.Kind)
+		if err != nil {
+			return errs.Wrap(err, "failed to get active reception")
+		}
+		if active != nil {
+			return errs.Conflict("there is already an active " + receptionNoun(rec.Kind))
+		}
+
+		return s.transition(ctx, rec, constants.ReceptionInProgress)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rec, nil
+}
+
+// StatusHistory отдаёт историю статусов приёмки с теми же правами, что и GetReception.
+func (s *ReceptionService) StatusHistory(ctx context.Context, receptionID, userRole string) (models.StatusHistorySlice, error) {
+	if _, err := s.GetReception(ctx, receptionID, userRole); err != nil {
+		return nil, err
+	}
+
+	history, err := s.audit.ListStatusHistory(ctx, receptionID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get status history")
+	}
+
+	return history, nil
+}
+
+// transition — единственное место, где меняется статус приёмки: проверяет
+// переход, сохраняет его и пишет status_history. Вызывается внутри tx.
+func (s *ReceptionService) transition(ctx context.Context, rec *models.Reception, to string) error {
+	from := rec.Status
+	if !canTransition(from, to) {
+		return errs.Conflict("cannot change reception status from " + from + " to " + to)
+	}
+
+	rec.Status = to
+	switch to {
+	case constants.ReceptionClosed:
+		rec.ClosedBy = nullString(auth.UserIDFromContext(ctx))
+		rec.ClosedAt = null.TimeFrom(time.Now())
+	case constants.ReceptionInProgress:
+		rec.ClosedBy = null.String{}
+		rec.ClosedAt = null.Time{}
+		rec.ReopenedAt = null.TimeFrom(time.Now())
+	}
+
+	// Товары закрытой приёмки поставки доступны к выдаче, после возврата
+	// в работу — нет. Возвраты покупателям не выдаются.
+	delivery := rec.Kind != constants.ReceptionKindReturn
+	if delivery && from == constants.ReceptionClosed {
+		if err := revertPickup(ctx, s.products, rec); err != nil {
+			return err
+		}
+	}
+
+	if err := s.repo.UpdateStatus(ctx, rec); err != nil {
+		return errs.Wrap(err, "failed to update reception status")
+	}
+
+	switch {
+	case delivery && to == constants.ReceptionClosed:
+		// Коды выдачи уникальны в пределах ПВЗ: блокировка строки ПВЗ не даёт
+		// двум закрывающимся приёмкам выбрать один и тот же свободный код.
+		if _, err := s.pvzs.GetByIDForUpdate(ctx, strconv.FormatInt(rec.PVZID, 10)); err != nil {
+			return errs.Wrap(err, "failed to lock PVZ")
+		}
+		if err := preparePickup(ctx, s.products, s.types, rec); err != nil {
+			return err
+		}
+	case !delivery && to == constants.ReceptionCancelled:
+		if err := restoreOriginals(ctx, s.products, rec); err != nil {
+			return err
+		}
+	}
+
+	if err := s.recordStatusChange(ctx, rec.ID, from, to); err != nil {
+		return err
+	}
+
+	return addEvent(ctx, s.outbox, receptionStatusEvents[to], events.AggregateReception, rec.ID, events.ReceptionPayload{
+		ID:         rec.ID,
+		PVZID:      rec.PVZID,
+		Kind:       rec.Kind,
+		FromStatus: from,
+		Status:     to,
+		ChangedBy:  auth.UserIDFromContext(ctx),
+	})
+}
+
+func (s *ReceptionService) recordStatusChange(ctx context.Context, receptionID, from, to string) error {
+	err := s.audit.RecordStatusChange(ctx, &models.StatusHistory{
+		ReceptionID: receptionID,
+		FromStatus:  nullString(from),
+		ToStatus:    to,
+		ChangedBy:   nullString(auth.UserIDFromContext(ctx)),
+	})
+	if err != nil {
+		return errs.Wrap(err, "failed to record status change")
+	}
+	return nil
+}
+
+func (s *ReceptionService) lockReception(ctx context.Context, receptionID string) (*models.Reception, error) {
+	rec, err := s.repo.GetByIDForUpdate(ctx, receptionID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to lock reception")
+	}
+	if rec == nil {
+		return nil, errs.NotFound("reception not found")
+	}
+	return rec, nil
+}
+
+func (s *ReceptionService) requireReceptionStaff(ctx context.Context, receptionID string) error {
+	rec, err := s.repo.GetByID(ctx, receptionID)
+	if err != nil {
+		return errs.Wrap(err, "failed to get reception")
+	}
+	if rec == nil {
+		return errs.NotFound("reception not found")
+	}
+
+	return requireStaff(ctx, s.staff, strconv.FormatInt(rec.PVZID, 10))
+}
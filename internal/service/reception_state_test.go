@@ -0,0 +1,113 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{constants.ReceptionInProgress, constants.ReceptionClosed, true},
+		{constants.ReceptionInProgress, constants.ReceptionCancelled, true},
+		{constants.ReceptionClosed, constants.ReceptionInProgress, true},
+		{constants.ReceptionClosed, constants.ReceptionCancelled, false},
+		{constants.ReceptionCancelled, constants.ReceptionInProgress, false},
+		{constants.ReceptionCancelled, constants.ReceptionClosed, false},
+		{"active", constants.ReceptionClosed, false},
+	}
+
+	for _, tt := range tests {
+		if got := canTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("canTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestReception_StatusHistoryRecordsTransitions(t *testing.T) {
+	_, _, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	rec, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	if _, err := receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close reception: %v", err)
+	}
+	if _, err := receptions.ReopenReception(context.Background(), rec.ID, constants.RoleModerator); err != nil {
+		t.Fatalf("reopen reception: %v", err)
+	}
+	if _, err := receptions.CancelReception(ctx, rec.ID, constants.RoleEmployee); err != nil {
+		t.Fatalf("cancel reception: %v", err)
+	}
+
+	history, err := receptions.StatusHistory(ctx, rec.ID, constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+
+	want := []string{
+		constants.ReceptionInProgress,
+		constants.ReceptionClosed,
+		constants.ReceptionInProgress,
+		constants.ReceptionCancelled,
+	}
+	if len(history) != len(want) {
+		t.Fatalf("got %d history entries, want %d", len(history), len(want))
+	}
+	for i, h := range history {
+		if h.ToStatus != want[i] {
+			t.Fatalf("entry %d: to = %q, want %q", i, h.ToStatus, want[i])
+		}
+	}
+	if history[0].FromStatus.Valid {
+		t.Fatalf("creation entry should have no from status, got %q", history[0].FromStatus.String)
+	}
+
+	// Отменённая приёмка — конечное состояние.
+	if _, err := receptions.ReopenReception(context.Background(), rec.ID, constants.RoleModerator); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict reopening cancelled reception, got %v", err)
+	}
+}
+
+func TestReception_ReopenRules(t *testing.T) {
+	store, _, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	rec, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	if _, err := receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close reception: %v", err)
+	}
+
+	if _, err := receptions.ReopenReception(ctx, rec.ID, constants.RoleEmployee); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for employee, got %v", err)
+	}
+
+	// Пока в ПВЗ есть другая активная приёмка, вернуть закрытую нельзя.
+	if _, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create second reception: %v", err)
+	}
+	if _, err := receptions.ReopenReception(context.Background(), rec.ID, constants.RoleModerator); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict with active reception, got %v", err)
+	}
+	if _, err := receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close second reception: %v", err)
+	}
+
+	store.receptions[rec.ID].ClosedAt = null.TimeFrom(time.Now().Add(-2 * time.Hour))
+	if _, err := receptions.ReopenReception(context.Background(), rec.ID, constants.RoleModerator); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict after reopen window, got %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/auth"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReception_RecordsActors(t *testing.T) {
+	store, products, receptions := newProductTestServices()
+
+	opener := employeeCtx(testEmployeeID)
+	rec, err := receptions.CreateReception(opener, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	if rec.CreatedBy.String != testEmployeeID {
+		t.Fatalf("created_by = %q, want %q", rec.CreatedBy.String, testEmployeeID)
+	}
+
+	product, _, err := products.AddProduct(opener, "1", constants.RoleEmployee, "обувь")
+	if err != nil {
+		t.Fatalf("add product: %v", err)
+	}
+	if product.CreatedBy.String != testEmployeeID {
+		t.Fatalf("product created_by = %q, want %q", product.CreatedBy.String, testEmployeeID)
+	}
+
+	if _, err := receptions.CloseReception(opener, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close reception: %v", err)
+	}
+	if got := store.receptions[rec.ID].ClosedBy.String; got != testEmployeeID {
+		t.Fatalf("closed_by = %q, want %q", got, testEmployeeID)
+	}
+}
+
+func TestReception_RequiresAssignedEmployee(t *testing.T) {
+	_, _, receptions := newProductTestServices()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"dummy token without user", auth.WithPrincipal(context.Background(), auth.Principal{Role: constants.RoleEmployee})},
+		{"employee of another PVZ", employeeCtx("stranger")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := receptions.CreateReception(tt.ctx, "1", constants.RoleEmployee); !errors.Is(err, errs.ErrForbidden) {
+				t.Fatalf("expected forbidden, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReception_GetRequiresStaffOfItsPVZ(t *testing.T) {
+	_, _, receptions := newProductTestServices()
+
+	rec, err := receptions.CreateReception(employeeCtx(testEmployeeID), "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+
+	if _, err := receptions.GetReception(employeeCtx(testEmployeeID), rec.ID, constants.RoleEmployee); err != nil {
+		t.Fatalf("assigned employee: %v", err)
+	}
+	if _, err := receptions.GetReception(employeeCtx("stranger"), rec.ID, constants.RoleEmployee); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for unassigned employee, got %v", err)
+	}
+	if _, err := receptions.GetReception(context.Background(), rec.ID, constants.RoleModerator); err != nil {
+		t.Fatalf("moderator: %v", err)
+	}
+	if _, err := receptions.GetReception(context.Background(), "missing", constants.RoleModerator); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestReception_ListPaginatesByCursor(t *testing.T) {
+	store, _, receptions := newProductTestServices()
+
+	base := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		id := store.nextID()
+		store.receptions[id] = &models.Reception{
+			ID:       id,
+			PVZID:    1,
+			Status:   constants.ReceptionClosed,
+			DateTime: base.Add(time.Duration(i) * time.Hour),
+		}
+	}
+
+	var (
+		seen   []string
+		cursor string
+	)
+	for page := 0; page < 5; page++ {
+		list, next, err := receptions.ListReceptions(context.Background(), "1", constants.ReceptionClosed, nil, nil, cursor, 2, constants.RoleModerator)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		for _, rec := range list {
+			seen = append(seen, rec.ID)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	if len(seen) != 5 {
+		t.Fatalf("got %d receptions across pages, want 5", len(seen))
+	}
+	for i := 1; i < len(seen); i++ {
+		if store.receptions[seen[i-1]].DateTime.Before(store.receptions[seen[i]].DateTime) {
+			t.Fatalf("receptions are not ordered newest first: %v", seen)
+		}
+	}
+
+	if _, _, err := receptions.ListReceptions(context.Background(), "1", "", nil, nil, "garbage", 2, constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+		t.Fatalf("expected validation error for bad cursor, got %v", err)
+	}
+}
+
+func TestReception_DeleteProductRecordsAudit(t *testing.T) {
+	store, products, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	rec, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+
+	var added []*models.Product
+	for _, bc := range []string{"111", "222", "333"} {
+		p, _, err := products.ScanProduct(ctx, "1", constants.RoleEmployee, ProductInput{Type: "обувь", Barcode: bc})
+		if err != nil {
+			t.Fatalf("scan %s: %v", bc, err)
+		}
+		added = append(added, p)
+	}
+
+	// Удаляем товар из середины, а не последний.
+	if err := receptions.DeleteProduct(ctx, rec.ID, added[0].ID, constants.RoleEmployee); err != nil {
+		t.Fatalf("delete product: %v", err)
+	}
+	if len(store.products) != 2 || store.products[0].ID != added[1].ID {
+		t.Fatalf("unexpected products after delete: %d left", len(store.products))
+	}
+
+	if len(store.audit) != 1 {
+		t.Fatalf("got %d audit entries, want 1", len(store.audit))
+	}
+	entry := store.audit[0]
+	if entry.ProductID != added[0].ID || entry.Action != constants.ProductActionDeleted || entry.ActorID.String != testEmployeeID {
+		t.Fatalf("unexpected audit entry: %+v", entry)
+	}
+
+	if err := receptions.DeleteProduct(ctx, rec.ID, added[0].ID, constants.RoleEmployee); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected not found for already deleted product, got %v", err)
+	}
+}
+
+func TestReception_DeleteProductRequiresOpenReception(t *testing.T) {
+	_, products, receptions := newProductTestServices()
+	ctx := employeeCtx(testEmployeeID)
+
+	rec, err := receptions.CreateReception(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create reception: %v", err)
+	}
+	p, _, err := products.AddProduct(ctx, "1", constants.RoleEmployee, "обувь")
+	if err != nil {
+		t.Fatalf("add product: %v", err)
+	}
+	if _, err := receptions.CloseReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close reception: %v", err)
+	}
+
+	if err := receptions.DeleteProduct(ctx, rec.ID, p.ID, constants.RoleEmployee); !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict for closed reception, got %v", err)
+	}
+}
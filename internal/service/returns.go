@@ -0,0 +1,223 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/metrics"
+	"PVZ/pkg/uuid"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+// returnReasons — допустимые причины возврата товара покупателем.
+var returnReasons = map[string]bool{
+	constants.ReturnReasonDamaged:        true,
+	constants.ReturnReasonWrongItem:      true,
+	constants.ReturnReasonNotAsDescribed: true,
+	constants.ReturnReasonChangedMind:    true,
+	constants.ReturnReasonOther:          true,
+}
+
+const maxReturnCommentLen = 1000
+
+// ReturnItemInput — товар, который покупатель вернул в ПВЗ. OriginalProductID
+// необязателен: без него исходный товар ищется среди выданных по штрихкоду.
+type ReturnItemInput struct {
+	ProductInput
+	Reason            string
+	Comment           string
+	OriginalProductID string
+}
+
+// CreateReturn открывает приёмку возвратов. Она живёт независимо от приёмки
+// поставки: в ПВЗ может быть открыто по одной приёмке каждого вида.
+func (s *ReceptionService) CreateReturn(ctx context.Context, pvzID, userRole string) (*models.Reception, error) {
+	rec, err := s.createReception(ctx, pvzID, constants.ReceptionKindReturn, userRole)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ReturnsCreated.Inc()
+	return rec, nil
+}
+
+func (s *ReceptionService) CloseReturn(ctx context.Context, pvzID, userRole string) (*models.Reception, error) {
+	return s.closeReception(ctx, pvzID, constants.ReceptionKindReturn, userRole)
+}
+
+// AddReturnItem принимает возвращённый товар в открытую приёмку возвратов ПВЗ.
+// Найденный исходный товар переводится в returned. warning — как у AddProduct.
+func (s *ProductService) AddReturnItem(ctx context.Context, pvzID, userRole string, in ReturnItemInput) (*models.Product, string, error) {
+	if userRole != constants.RoleEmployee {
+		return nil, "", errs.Forbidden("access denied")
+	}
+
+	if err := s.validateReturnInput(ctx, &in); err != nil {
+		return nil, "", err
+	}
+
+	if err := requireStaff(ctx, s.staff, pvzID); err != nil {
+		return nil, "", err
+	}
+
+	var (
+		product *models.Product
+		warning string
+	)
+	err := s.tx.Do(ctx, func(ctx context.Context) error {
+		reception, err := s.receptionRepo.GetActiveByPVZForUpdate(ctx, pvzID, constants.ReceptionKindReturn)
+		if err != nil {
+			return errs.Wrap(err, "failed to get active reception")
+		}
+		if reception == nil {
+			return errs.NotFound("no active return reception found")
+		}
+
+		if warning, err = s.reserveCapacity(ctx, pvzID, 1); err != nil {
+			return err
+		}
+
+		original, err := s.findReturnOriginal(ctx, reception.PVZID, in)
+		if err != nil {
+			return err
+		}
+
+		// Исходный товар меняем до вставки позиции: её событие уже содержит ссылку.
+		if original != nil {
+			original.Status = constants.ProductReturned
+			original.StatusChangedAt = null.TimeFrom(time.Now())
+			if err := s.productRepo.UpdateLifecycle(ctx, original); err != nil {
+				return errs.Wrap(err, "failed to mark product as returned")
+			}
+			in.OriginalProductID = original.ID
+		}
+
+		plan, err := s.planCells(ctx, reception.PVZID)
+		if err != nil {
+			return err
+		}
+		cell, err := plan.place(in.Cell)
+		if err != nil {
+			return err
+		}
+
+		product, err = s.insertProduct(ctx, reception, in.ProductInput, withCell(cell), func(p *models.Product) {
+			p.ReturnReason = null.StringFrom(in.Reason)
+			p.ReturnComment = nullString(in.Comment)
+			p.OriginalProductID = nullString(in.OriginalProductID)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	metrics.ReturnItems.WithLabelValues(in.Reason).Inc()
+	return product, warning, nil
+}
+
+func (s *ProductService) validateReturnInput(ctx context.Context, in *ReturnItemInput) error {
+	if !returnReasons[in.Reason] {
+		return errs.Validation("invalid return reason")
+	}
+
+	in.Comment = strings.TrimSpace(in.Comment)
+	if in.Reason == constants.ReturnReasonOther && in.Comment == "" {
+		return errs.Validation("comment is required for return reason other")
+	}
+	if len(in.Comment) > maxReturnCommentLen {
+		return errs.Validation("comment is too long")
+	}
+
+	if in.OriginalProductID != "" && !uuid.IsValid(in.OriginalProductID) {
+		return errs.Validation("invalid original product ID format")
+	}
+
+	return s.validateInput(ctx, &in.ProductInput)
+}
+
+// findReturnOriginal блокирует исходный товар возврата. Возврат принимает
+// только ПВЗ, который выдал товар: товар другого ПВЗ считается ненайденным.
+// Явно указанный товар должен быть выдан, а поиск по штрихкоду без результата
+// не ошибка — связь ставится, только если она известна.
+func (s *ProductService) findReturnOriginal(ctx context.Context, pvzID int64, in ReturnItemInput) (*models.Product, error) {
+	if in.OriginalProductID == "" {
+		if in.Barcode == "" {
+			return nil, nil
+		}
+		original, err := s.productRepo.FindIssuedByBarcodeForUpdate(ctx, pvzID, in.Barcode)
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to find original product")
+		}
+		return original, nil
+	}
+
+	original, err := s.productRepo.GetByIDForUpdate(ctx, in.OriginalProductID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get original product")
+	}
+	if original == nil {
+		return nil, errs.NotFound("original product not found")
+	}
+
+	rec, err := s.receptionRepo.GetByID(ctx, original.ReceptionID)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get original reception")
+	}
+	if rec == nil || rec.PVZID != pvzID {
+		return nil, errs.NotFound("original product not found")
+	}
+	if original.Status != constants.ProductIssued {
+		return nil, errs.Conflict("original product has not been issued")
+	}
+
+	return original, nil
+}
+
+// restoreOriginal снова считает исходный товар выданным, когда позиция
+// возврата удалена или её приёмка отменена.
+func restoreOriginal(ctx context.Context, products ProductLifecycleRepository, item *models.Product) error {
+	if !item.OriginalProductID.Valid {
+		return nil
+	}
+
+	original, err := products.GetByIDForUpdate(ctx, item.OriginalProductID.String)
+	if err != nil {
+		return errs.Wrap(err, "failed to get original product")
+	}
+	if original == nil || original.Status != constants.ProductReturned {
+		return nil
+	}
+
+	original.Status = constants.ProductIssued
+	original.StatusChangedAt = null.TimeFrom(time.Now())
+	if err := products.UpdateLifecycle(ctx, original); err != nil {
+		return errs.Wrap(err, "failed to restore original product")
+	}
+	return nil
+}
+
+func restoreOriginals(ctx context.Context, products ProductLifecycleRepository, rec *models.Reception) error {
+	list, err := products.ListByReception(ctx, rec.ID)
+	if err != nil {
+		return errs.Wrap(err, "failed to get reception products")
+	}
+
+	for _, p := range list {
+		if err := restoreOriginal(ctx, products, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func receptionNoun(kind string) string {
+	if kind == constants.ReceptionKindReturn {
+		return "return reception"
+	}
+	return "reception"
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"context"
+	"errors"
+	"testing"
+)
+
+// issueOrder выдаёт заказ ORD-1 из newIssuanceTestEnv.
+func issueOrder(t *testing.T, env *issuanceTestEnv) {
+	t.Helper()
+	code := env.store.products[0].PickupCode.String
+	if _, err := env.issuance.Issue(employeeCtx(testEmployeeID), "1", constants.RoleEmployee, IssueInput{PickupCode: code}); err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+}
+
+func TestAddReturnItemLinksIssuedProduct(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+	issueOrder(t, env)
+
+	rec, err := env.receptions.CreateReturn(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create return: %v", err)
+	}
+	if rec.Kind != constants.ReceptionKindReturn {
+		t.Fatalf("kind = %q, want %q", rec.Kind, constants.ReceptionKindReturn)
+	}
+
+	// Приёмка поставки открывается независимо от приёмки возвратов.
+	if _, err := env.receptions.CreateReception(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create delivery reception: %v", err)
+	}
+
+	original := env.store.products[0]
+	item, _, err := env.products.AddReturnItem(ctx, "1", constants.RoleEmployee, ReturnItemInput{
+		ProductInput: ProductInput{Type: "обувь", Barcode: original.Barcode.String},
+		Reason:       constants.ReturnReasonDamaged,
+	})
+	if err != nil {
+		t.Fatalf("add return item: %v", err)
+	}
+	if item.ReceptionID != rec.ID {
+		t.Fatalf("item added to reception %s, want return reception %s", item.ReceptionID, rec.ID)
+	}
+	if item.OriginalProductID.String != original.ID {
+		t.Fatalf("original product = %q, want %q", item.OriginalProductID.String, original.ID)
+	}
+	if original.Status != constants.ProductReturned {
+		t.Fatalf("original status = %q, want %q", original.Status, constants.ProductReturned)
+	}
+
+	if _, err := env.receptions.CloseReturn(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close return: %v", err)
+	}
+	stored := env.store.products[len(env.store.products)-1]
+	if stored.Status != constants.ProductReceived || stored.PickupCode.Valid {
+		t.Fatalf("returned item must not be prepared for pickup: status %q, code %q", stored.Status, stored.PickupCode.String)
+	}
+}
+
+func TestAddReturnItemWithoutKnownOriginal(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+
+	if _, err := env.receptions.CreateReturn(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("create return: %v", err)
+	}
+
+	item, _, err := env.products.AddReturnItem(ctx, "1", constants.RoleEmployee, ReturnItemInput{
+		ProductInput: ProductInput{Type: "одежда", Barcode: "999"},
+		Reason:       constants.ReturnReasonChangedMind,
+	})
+	if err != nil {
+		t.Fatalf("add return item: %v", err)
+	}
+	if item.OriginalProductID.Valid {
+		t.Fatalf("unexpected original product %q", item.OriginalProductID.String)
+	}
+
+	// Явно указанный исходный товар должен быть выдан.
+	_, _, err = env.products.AddReturnItem(ctx, "1", constants.RoleEmployee, ReturnItemInput{
+		ProductInput:      ProductInput{Type: "обувь"},
+		Reason:            constants.ReturnReasonDamaged,
+		OriginalProductID: env.store.products[2].ID,
+	})
+	if !errors.Is(err, errs.ErrConflict) {
+		t.Fatalf("expected conflict for product that was not issued, got %v", err)
+	}
+}
+
+func TestDeleteReturnItemRestoresOriginal(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+	issueOrder(t, env)
+
+	rec, err := env.receptions.CreateReturn(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create return: %v", err)
+	}
+	original := env.store.products[1]
+	item, _, err := env.products.AddReturnItem(ctx, "1", constants.RoleEmployee, ReturnItemInput{
+		ProductInput:      ProductInput{Type: "одежда"},
+		Reason:            constants.ReturnReasonWrongItem,
+		OriginalProductID: original.ID,
+	})
+	if err != nil {
+		t.Fatalf("add return item: %v", err)
+	}
+
+	if err := env.receptions.DeleteProduct(ctx, rec.ID, item.ID, constants.RoleEmployee); err != nil {
+		t.Fatalf("delete return item: %v", err)
+	}
+	if original.Status != constants.ProductIssued {
+		t.Fatalf("original status = %q, want %q", original.Status, constants.ProductIssued)
+	}
+}
+
+func TestAddReturnItemValidation(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+
+	tests := []struct {
+		name string
+		in   ReturnItemInput
+		want error
+	}{
+		{"unknown reason", ReturnItemInput{ProductInput: ProductInput{Type: "обувь"}, Reason: "broken"}, errs.ErrValidation},
+		{"other without comment", ReturnItemInput{ProductInput: ProductInput{Type: "обувь"}, Reason: constants.ReturnReasonOther}, errs.ErrValidation},
+		{"invalid original id", ReturnItemInput{ProductInput: ProductInput{Type: "обувь"}, Reason: constants.ReturnReasonDamaged, OriginalProductID: "x"}, errs.ErrValidation},
+		{"no return reception", ReturnItemInput{ProductInput: ProductInput{Type: "обувь"}, Reason: constants.ReturnReasonDamaged}, errs.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := env.products.AddReturnItem(ctx, "1", constants.RoleEmployee, tt.in); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	if _, _, err := env.products.AddReturnItem(context.Background(), "1", constants.RoleModerator, tests[0].in); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for moderator, got %v", err)
+	}
+}
+
+func TestClosedReturnReceptionFreesSpace(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+
+	rec, err := env.receptions.CreateReturn(ctx, "1", constants.RoleEmployee)
+	if err != nil {
+		t.Fatalf("create return: %v", err)
+	}
+	if _, _, err := env.products.AddReturnItem(ctx, "1", constants.RoleEmployee, ReturnItemInput{
+		ProductInput: ProductInput{Type: "одежда"},
+		Reason:       constants.ReturnReasonChangedMind,
+	}); err != nil {
+		t.Fatalf("add return item: %v", err)
+	}
+
+	storedCount := func() int {
+		t.Helper()
+		list, _, err := env.issuance.ListStored(ctx, "1", "", "", "", "", 10, constants.RoleEmployee)
+		if err != nil {
+			t.Fatalf("list stored: %v", err)
+		}
+		return len(list)
+	}
+
+	if got := storedCount(); got != 4 {
+		t.Fatalf("stored with open return reception = %d, want 4", got)
+	}
+
+	// Закрытая приёмка возвратов передана отправителю и места не занимает.
+	if _, err := env.receptions.CloseReturn(ctx, "1", constants.RoleEmployee); err != nil {
+		t.Fatalf("close return: %v", err)
+	}
+	if got := storedCount(); got != 3 {
+		t.Fatalf("stored after closing return reception = %d, want 3", got)
+	}
+
+	if _, err := env.receptions.ReopenReception(ctx, rec.ID, constants.RoleModerator); err != nil {
+		t.Fatalf("reopen return: %v", err)
+	}
+	if got := storedCount(); got != 4 {
+		t.Fatalf("stored after reopening return reception = %d, want 4", got)
+	}
+}
+
+func TestReturnOriginalIsScopedToPVZ(t *testing.T) {
+	env := newIssuanceTestEnv(t)
+	ctx := employeeCtx(testEmployeeID)
+	issueOrder(t, env)
+	_, _ = env.staff.Assign(context.Background(), "2", testEmployeeID, "")
+
+	if _, err := env.receptions.CreateReturn(ctx, "2", constants.RoleEmployee); err != nil {
+		t.Fatalf("create return: %v", err)
+	}
+
+	// Товар, выданный в ПВЗ "1", по штрихкоду в ПВЗ "2" не находится.
+	original := env.store.products[0]
+	item, _, err := env.products.AddReturnItem(ctx, "2", constants.RoleEmployee, ReturnItemInput{
+		ProductInput: ProductInput{Type: "обувь", Barcode: original.Barcode.String},
+		Reason:       constants.ReturnReasonDamaged,
+	})
+	if err != nil {
+		t.Fatalf("add return item: %v", err)
+	}
+	if item.OriginalProductID.Valid {
+		t.Fatalf("linked to product %q of another PVZ", item.OriginalProductID.String)
+	}
+
+	_, _, err = env.products.AddReturnItem(ctx, "2", constants.RoleEmployee, ReturnItemInput{
+		ProductInput:      ProductInput{Type: "обувь"},
+		Reason:            constants.ReturnReasonDamaged,
+		OriginalProductID: original.ID,
+	})
+	if !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected not found for product of another PVZ, got %v", err)
+	}
+	if original.Status != constants.ProductIssued {
+		t.Fatalf("original status = %q, want %q", original.Status, constants.ProductIssued)
+	}
+}
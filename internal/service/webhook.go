@@ -0,0 +1,263 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/internal/webhook"
+	"PVZ/models"
+	"PVZ/pkg/auth"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/types"
+)
+
+// webhookEventTypes — события, на которые можно подписаться вебхуком.
+var webhookEventTypes = map[string]bool{
+	events.ReceptionCreated:   true,
+	events.ReceptionClosed:    true,
+	events.ReceptionCancelled: true,
+	events.ReceptionReopened:  true,
+	events.ProductsOverdue:    true,
+}
+
+const (
+	minWebhookSecretLen = 16
+	maxWebhookSecretLen = 128
+)
+
+type WebhookService struct {
+	repo WebhookRepository
+	pvzs PVZRepository
+	// allowInternal разрешает URL на внутренние адреса — для локальной разработки.
+	allowInternal bool
+}
+
+func NewWebhookService(repo WebhookRepository, pvzs PVZRepository, allowInternal bool) *WebhookService {
+	return &WebhookService{repo: repo, pvzs: pvzs, allowInternal: allowInternal}
+}
+
+// WebhookInput — параметры подписки. Пустой PvzID — события всех ПВЗ,
+// пустой Secret — секрет генерируется.
+type WebhookInput struct {
+	URL        string
+	EventTypes []string
+	PvzID      string
+	Secret     string
+}
+
+func (s *WebhookService) Create(ctx context.Context, in WebhookInput, userRole string) (*models.Webhook, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if err := validateWebhookURL(in.URL, s.allowInternal); err != nil {
+		return nil, err
+	}
+
+	eventTypes, err := normalizeEventTypes(in.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	hook := &models.Webhook{
+		URL:        in.URL,
+		Secret:     in.Secret,
+		EventTypes: types.JSON(eventTypes),
+		Enabled:    true,
+		CreatedBy:  nullString(auth.UserIDFromContext(ctx)),
+	}
+
+	if in.PvzID != "" {
+		if _, err := strconv.ParseInt(in.PvzID, 10, 64); err != nil {
+			return nil, errs.Validation("invalid PVZ ID format")
+		}
+		pvz, err := s.pvzs.GetByID(ctx, in.PvzID)
+		if err != nil {
+			return nil, errs.Wrap(err, "failed to get PVZ")
+		}
+		if pvz == nil {
+			return nil, errs.NotFound("PVZ not found")
+		}
+		hook.PVZID = null.Int64From(pvz.ID)
+	}
+
+	if hook.Secret == "" {
+		if hook.Secret, err = generateWebhookSecret(); err != nil {
+			return nil, errs.Wrap(err, "failed to generate webhook secret")
+		}
+	}
+	if len(hook.Secret) < minWebhookSecretLen || len(hook.Secret) > maxWebhookSecretLen {
+		return nil, errs.Validation("secret must be 16 to 128 characters long")
+	}
+
+	if err := s.repo.Create(ctx, hook); err != nil {
+		return nil, errs.Wrap(err, "failed to create webhook")
+	}
+
+	return hook, nil
+}
+
+func (s *WebhookService) List(ctx context.Context, userRole string) (models.WebhookSlice, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	hooks, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list webhooks")
+	}
+
+	return hooks, nil
+}
+
+func (s *WebhookService) Get(ctx context.Context, id, userRole string) (*models.Webhook, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	return s.get(ctx, id)
+}
+
+func (s *WebhookService) Delete(ctx context.Context, id, userRole string) error {
+	if userRole != constants.RoleModerator {
+		return errs.Forbidden("access denied")
+	}
+
+	deleted, err := s.repo.Delete(ctx, id)
+	if err != nil {
+		return errs.Wrap(err, "failed to delete webhook")
+	}
+	if !deleted {
+		return errs.NotFound("webhook not found")
+	}
+
+	return nil
+}
+
+// ListDeliveries отдаёт последние доставки подписки, от новых к старым.
+func (s *WebhookService) ListDeliveries(ctx context.Context, id string, limit int, userRole string) (models.WebhookDeliverySlice, error) {
+	if userRole != constants.RoleModerator {
+		return nil, errs.Forbidden("access denied")
+	}
+
+	if _, err := s.get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.repo.ListDeliveries(ctx, id, limit)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to list webhook deliveries")
+	}
+
+	return deliveries, nil
+}
+
+// Publish реализует events.EventPublisher: ставит событие в очередь доставки
+// каждой подходящей подписке. Релей outbox вызывает его внутри своей
+// транзакции, поэтому доставки создаются атомарно с отметкой о публикации.
+func (s *WebhookService) Publish(ctx context.Context, event events.Event) error {
+	if !webhookEventTypes[event.Type] {
+		return nil
+	}
+
+	hooks, err := s.repo.ListSubscribed(ctx, event.Type)
+	if err != nil {
+		return errs.Wrap(err, "failed to list webhooks")
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return errs.Wrap(err, "failed to marshal event")
+	}
+
+	var scope struct {
+		PVZID int64 `json:"pvzId"`
+	}
+	_ = json.Unmarshal(event.Payload, &scope)
+
+	for _, hook := range hooks {
+		if hook.PVZID.Valid && hook.PVZID.Int64 != scope.PVZID {
+			continue
+		}
+
+		err := s.repo.AddDelivery(ctx, &models.WebhookDelivery{
+			WebhookID: hook.ID,
+			EventID:   event.ID,
+			EventType: event.Type,
+			Payload:   types.JSON(body),
+		})
+		if err != nil {
+			return errs.Wrap(err, "failed to add webhook delivery")
+		}
+	}
+
+	return nil
+}
+
+func (s *WebhookService) get(ctx context.Context, id string) (*models.Webhook, error) {
+	hook, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to get webhook")
+	}
+	if hook == nil {
+		return nil, errs.NotFound("webhook not found")
+	}
+	return hook, nil
+}
+
+// validateWebhookURL проверяет схему и не даёт направить доставки на сам
+// сервер или в его окружение. Имена, которые резолвятся во внутренние
+// адреса, дополнительно отсекает webhook.Sender при подключении.
+func validateWebhookURL(raw string, allowInternal bool) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return errs.Validation("invalid webhook URL")
+	}
+	if !allowInternal && webhook.IsInternalHost(u.Hostname()) {
+		return errs.Validation("webhook URL points to an internal address")
+	}
+	return nil
+}
+
+// normalizeEventTypes проверяет типы событий и убирает повторы.
+func normalizeEventTypes(list []string) ([]byte, error) {
+	if len(list) == 0 {
+		return nil, errs.Validation("event types are required")
+	}
+
+	seen := make(map[string]bool, len(list))
+	unique := make([]string, 0, len(list))
+	for _, t := range list {
+		if !webhookEventTypes[t] {
+			return nil, errs.Validation("unsupported event type: " + t)
+		}
+		if !seen[t] {
+			seen[t] = true
+			unique = append(unique, t)
+		}
+	}
+
+	data, err := json.Marshal(unique)
+	if err != nil {
+		return nil, errs.Wrap(err, "failed to marshal event types")
+	}
+	return data, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/events"
+	"PVZ/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestWebhookCreate_Validation(t *testing.T) {
+	svc := NewWebhookService(newFakeWebhookRepo(), &fakePVZRepo{pvz: []*models.PVZ{{ID: 1}}}, false)
+	ctx := context.Background()
+
+	valid := WebhookInput{URL: "https://wms.example.com/hook", EventTypes: []string{events.ReceptionClosed}}
+	if _, err := svc.Create(ctx, valid, constants.RoleEmployee); !errors.Is(err, errs.ErrForbidden) {
+		t.Fatalf("expected forbidden for employee, got %v", err)
+	}
+
+	invalid := []WebhookInput{
+		{URL: "ftp://wms.example.com", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "https://", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "https://wms.example.com/hook"},
+		{URL: "https://wms.example.com/hook", EventTypes: []string{events.ProductAdded}},
+		{URL: "https://wms.example.com/hook", EventTypes: []string{events.ReceptionClosed}, Secret: "short"},
+		{URL: "https://wms.example.com/hook", EventTypes: []string{events.ReceptionClosed}, PvzID: "abc"},
+		{URL: "http://localhost:8080/hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://api.localhost./hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://127.0.0.1/hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://[::1]/hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://[::ffff:127.0.0.1]/hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://0.0.0.0/hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://169.254.169.254/latest/meta-data", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://[fe80::1]/hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://metadata.google.internal/computeMetadata/v1", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://[fd00:ec2::254]/hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://10.0.0.5/hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://192.168.1.10:8080/hook", EventTypes: []string{events.ReceptionClosed}},
+		{URL: "http://172.16.0.1/hook", EventTypes: []string{events.ReceptionClosed}},
+	}
+	for _, in := range invalid {
+		if _, err := svc.Create(ctx, in, constants.RoleModerator); !errors.Is(err, errs.ErrValidation) {
+			t.Errorf("%+v: expected validation error, got %v", in, err)
+		}
+	}
+
+	unknownPVZ := WebhookInput{URL: "https://wms.example.com/hook", EventTypes: []string{events.ReceptionClosed}, PvzID: "42"}
+	if _, err := svc.Create(ctx, unknownPVZ, constants.RoleModerator); !errors.Is(err, errs.ErrNotFound) {
+		t.Fatalf("expected not found for unknown PVZ, got %v", err)
+	}
+
+	hook, err := svc.Create(ctx, WebhookInput{
+		URL:        "https://wms.example.com/hook",
+		EventTypes: []string{events.ReceptionClosed, events.ReceptionClosed},
+	}, constants.RoleModerator)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if len(hook.Secret) != 64 {
+		t.Fatalf("expected generated secret, got %q", hook.Secret)
+	}
+
+	var types []string
+	if err := json.Unmarshal(hook.EventTypes, &types); err != nil || len(types) != 1 {
+		t.Fatalf("event types = %s", hook.EventTypes)
+	}
+}
+
+func TestWebhookPublish_FansOutBySubscription(t *testing.T) {
+	repo := newFakeWebhookRepo()
+	svc := NewWebhookService(repo, &fakePVZRepo{pvz: []*models.PVZ{{ID: 1}, {ID: 2}}}, false)
+	ctx := context.Background()
+
+	create := func(pvzID string, types ...string) string {
+		hook, err := svc.Create(ctx, WebhookInput{URL: "https://wms.example.com/hook", EventTypes: types, PvzID: pvzID}, constants.RoleModerator)
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		return hook.ID
+	}
+	all := create("", events.ReceptionClosed)
+	pvz1 := create("1", events.ReceptionClosed)
+	create("2", events.ReceptionClosed)
+	create("", events.ReceptionCreated)
+
+	payload, _ := json.Marshal(events.ReceptionPayload{ID: "r1", PVZID: 1, Status: constants.ReceptionClosed})
+	event := events.Event{ID: "e1", Type: events.ReceptionClosed, Payload: payload}
+
+	if err := svc.Publish(ctx, event); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+	// Повтор события от релея не создаёт новых доставок.
+	if err := svc.Publish(ctx, event); err != nil {
+		t.Fatalf("publish again: %v", err)
+	}
+
+	if len(repo.deliveries) != 2 {
+		t.Fatalf("expected 2 deliveries, got %d", len(repo.deliveries))
+	}
+	got := map[string]bool{}
+	for _, d := range repo.deliveries {
+		got[d.WebhookID] = true
+		var body events.Event
+		if err := json.Unmarshal(d.Payload, &body); err != nil || body.ID != "e1" {
+			t.Fatalf("delivery payload = %s", d.Payload)
+		}
+	}
+	if !got[all] || !got[pvz1] {
+		t.Fatalf("unexpected deliveries: %v", got)
+	}
+
+	if err := svc.Publish(ctx, events.Event{ID: "e2", Type: events.ProductAdded}); err != nil {
+		t.Fatalf("publish product event: %v", err)
+	}
+	if len(repo.deliveries) != 2 {
+		t.Fatalf("product events must not be delivered to webhooks")
+	}
+}
@@ -0,0 +1,1046 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: pvz/v1/pvz.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PVZ struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZ) Reset() {
+	*x = PVZ{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZ) ProtoMessage() {}
+
+func (x *PVZ) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZ.ProtoReflect.Descriptor instead.
+func (*PVZ) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PVZ) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PVZ) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PVZ) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PVZ) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Reception struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PvzId         int64                  `protobuf:"varint,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reception) Reset() {
+	*x = Reception{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reception) ProtoMessage() {}
+
+func (x *Reception) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reception.ProtoReflect.Descriptor instead.
+func (*Reception) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Reception) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reception) GetPvzId() int64 {
+	if x != nil {
+		return x.PvzId
+	}
+	return 0
+}
+
+func (x *Reception) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Reception) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Reception) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,2,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	Barcode       string                 `protobuf:"bytes,5,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	Sku           string                 `protobuf:"bytes,6,opt,name=sku,proto3" json:"sku,omitempty"`
+	OrderNumber   string                 `protobuf:"bytes,7,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	WeightGrams   *int32                 `protobuf:"varint,8,opt,name=weight_grams,json=weightGrams,proto3,oneof" json:"weight_grams,omitempty"`
+	LengthMm      *int32                 `protobuf:"varint,9,opt,name=length_mm,json=lengthMm,proto3,oneof" json:"length_mm,omitempty"`
+	WidthMm       *int32                 `protobuf:"varint,10,opt,name=width_mm,json=widthMm,proto3,oneof" json:"width_mm,omitempty"`
+	HeightMm      *int32                 `protobuf:"varint,11,opt,name=height_mm,json=heightMm,proto3,oneof" json:"height_mm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *Product) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Product) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *Product) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *Product) GetWeightGrams() int32 {
+	if x != nil && x.WeightGrams != nil {
+		return *x.WeightGrams
+	}
+	return 0
+}
+
+func (x *Product) GetLengthMm() int32 {
+	if x != nil && x.LengthMm != nil {
+		return *x.LengthMm
+	}
+	return 0
+}
+
+func (x *Product) GetWidthMm() int32 {
+	if x != nil && x.WidthMm != nil {
+		return *x.WidthMm
+	}
+	return 0
+}
+
+func (x *Product) GetHeightMm() int32 {
+	if x != nil && x.HeightMm != nil {
+		return *x.HeightMm
+	}
+	return 0
+}
+
+type ReceptionWithProducts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionWithProducts) Reset() {
+	*x = ReceptionWithProducts{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionWithProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionWithProducts) ProtoMessage() {}
+
+func (x *ReceptionWithProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionWithProducts.ProtoReflect.Descriptor instead.
+func (*ReceptionWithProducts) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReceptionWithProducts) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ReceptionWithProducts) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type PVZWithReceptions struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pvz           *PVZ                     `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Receptions    []*ReceptionWithProducts `protobuf:"bytes,2,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZWithReceptions) Reset() {
+	*x = PVZWithReceptions{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZWithReceptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZWithReceptions) ProtoMessage() {}
+
+func (x *PVZWithReceptions) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZWithReceptions.ProtoReflect.Descriptor instead.
+func (*PVZWithReceptions) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PVZWithReceptions) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *PVZWithReceptions) GetReceptions() []*ReceptionWithProducts {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+type CreatePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZRequest) Reset() {
+	*x = CreatePVZRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZRequest) ProtoMessage() {}
+
+func (x *CreatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZRequest.ProtoReflect.Descriptor instead.
+func (*CreatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreatePVZRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type GetPVZListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Номер страницы с 1; по умолчанию 1.
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// От 1 до 30; по умолчанию 10.
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListRequest) Reset() {
+	*x = GetPVZListRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListRequest) ProtoMessage() {}
+
+func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPVZListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPVZListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPVZListRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *GetPVZListRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetPVZListRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetPVZListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*PVZWithReceptions   `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListResponse) Reset() {
+	*x = GetPVZListResponse{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListResponse) ProtoMessage() {}
+
+func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPVZListResponse) GetPvzs() []*PVZWithReceptions {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+func (x *GetPVZListResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type CreateReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionRequest) Reset() {
+	*x = CreateReceptionRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionRequest) ProtoMessage() {}
+
+func (x *CreateReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CloseReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseReceptionRequest) Reset() {
+	*x = CloseReceptionRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseReceptionRequest) ProtoMessage() {}
+
+func (x *CloseReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CloseReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CloseReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type GetReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionRequest) Reset() {
+	*x = GetReceptionRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionRequest) ProtoMessage() {}
+
+func (x *GetReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionRequest.ProtoReflect.Descriptor instead.
+func (*GetReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetReceptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteLastProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductRequest) Reset() {
+	*x = DeleteLastProductRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductRequest) ProtoMessage() {}
+
+func (x *DeleteLastProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteLastProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type AddProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	PvzId string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Type  string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Если штрихкод задан, товар принимается как по скану: повтор штрихкода в
+	// открытых приёмках отклоняется.
+	Barcode       string `protobuf:"bytes,3,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	Sku           string `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	OrderNumber   string `protobuf:"bytes,5,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`
+	WeightGrams   *int32 `protobuf:"varint,6,opt,name=weight_grams,json=weightGrams,proto3,oneof" json:"weight_grams,omitempty"`
+	LengthMm      *int32 `protobuf:"varint,7,opt,name=length_mm,json=lengthMm,proto3,oneof" json:"length_mm,omitempty"`
+	WidthMm       *int32 `protobuf:"varint,8,opt,name=width_mm,json=widthMm,proto3,oneof" json:"width_mm,omitempty"`
+	HeightMm      *int32 `protobuf:"varint,9,opt,name=height_mm,json=heightMm,proto3,oneof" json:"height_mm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductRequest) Reset() {
+	*x = AddProductRequest{}
+	mi := &file_pvz_v1_pvz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRequest) ProtoMessage() {}
+
+func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_v1_pvz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
+func (*AddProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_v1_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetWeightGrams() int32 {
+	if x != nil && x.WeightGrams != nil {
+		return *x.WeightGrams
+	}
+	return 0
+}
+
+func (x *AddProductRequest) GetLengthMm() int32 {
+	if x != nil && x.LengthMm != nil {
+		return *x.LengthMm
+	}
+	return 0
+}
+
+func (x *AddProductRequest) GetWidthMm() int32 {
+	if x != nil && x.WidthMm != nil {
+		return *x.WidthMm
+	}
+	return 0
+}
+
+func (x *AddProductRequest) GetHeightMm() int32 {
+	if x != nil && x.HeightMm != nil {
+		return *x.HeightMm
+	}
+	return 0
+}
+
+var File_pvz_v1_pvz_proto protoreflect.FileDescriptor
+
+const file_pvz_v1_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\x10pvz/v1/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"x\n" +
+	"\x03PVZ\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbc\x01\n" +
+	"\tReception\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06pvz_id\x18\x02 \x01(\x03R\x05pvzId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x127\n" +
+	"\tdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x127\n" +
+	"\tclosed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"\x9c\x03\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\freception_id\x18\x02 \x01(\tR\vreceptionId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x125\n" +
+	"\badded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12\x18\n" +
+	"\abarcode\x18\x05 \x01(\tR\abarcode\x12\x10\n" +
+	"\x03sku\x18\x06 \x01(\tR\x03sku\x12!\n" +
+	"\forder_number\x18\a \x01(\tR\vorderNumber\x12&\n" +
+	"\fweight_grams\x18\b \x01(\x05H\x00R\vweightGrams\x88\x01\x01\x12 \n" +
+	"\tlength_mm\x18\t \x01(\x05H\x01R\blengthMm\x88\x01\x01\x12\x1e\n" +
+	"\bwidth_mm\x18\n" +
+	" \x01(\x05H\x02R\awidthMm\x88\x01\x01\x12 \n" +
+	"\theight_mm\x18\v \x01(\x05H\x03R\bheightMm\x88\x01\x01B\x0f\n" +
+	"\r_weight_gramsB\f\n" +
+	"\n" +
+	"_length_mmB\v\n" +
+	"\t_width_mmB\f\n" +
+	"\n" +
+	"_height_mm\"u\n" +
+	"\x15ReceptionWithProducts\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12+\n" +
+	"\bproducts\x18\x02 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"q\n" +
+	"\x11PVZWithReceptions\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12=\n" +
+	"\n" +
+	"receptions\x18\x02 \x03(\v2\x1d.pvz.v1.ReceptionWithProductsR\n" +
+	"receptions\":\n" +
+	"\x10CreatePVZRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\"\xc3\x01\n" +
+	"\x11GetPVZListRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"W\n" +
+	"\x12GetPVZListResponse\x12-\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\x19.pvz.v1.PVZWithReceptionsR\x04pvzs\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\"/\n" +
+	"\x16CreateReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\".\n" +
+	"\x15CloseReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"%\n" +
+	"\x13GetReceptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x18DeleteLastProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"\xd3\x02\n" +
+	"\x11AddProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\abarcode\x18\x03 \x01(\tR\abarcode\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12!\n" +
+	"\forder_number\x18\x05 \x01(\tR\vorderNumber\x12&\n" +
+	"\fweight_grams\x18\x06 \x01(\x05H\x00R\vweightGrams\x88\x01\x01\x12 \n" +
+	"\tlength_mm\x18\a \x01(\x05H\x01R\blengthMm\x88\x01\x01\x12\x1e\n" +
+	"\bwidth_mm\x18\b \x01(\x05H\x02R\awidthMm\x88\x01\x01\x12 \n" +
+	"\theight_mm\x18\t \x01(\x05H\x03R\bheightMm\x88\x01\x01B\x0f\n" +
+	"\r_weight_gramsB\f\n" +
+	"\n" +
+	"_length_mmB\v\n" +
+	"\t_width_mmB\f\n" +
+	"\n" +
+	"_height_mm2\x85\x01\n" +
+	"\n" +
+	"PVZService\x122\n" +
+	"\tCreatePVZ\x12\x18.pvz.v1.CreatePVZRequest\x1a\v.pvz.v1.PVZ\x12C\n" +
+	"\n" +
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse2\xbe\x02\n" +
+	"\x10ReceptionService\x12D\n" +
+	"\x0fCreateReception\x12\x1e.pvz.v1.CreateReceptionRequest\x1a\x11.pvz.v1.Reception\x12B\n" +
+	"\x0eCloseReception\x12\x1d.pvz.v1.CloseReceptionRequest\x1a\x11.pvz.v1.Reception\x12J\n" +
+	"\fGetReception\x12\x1b.pvz.v1.GetReceptionRequest\x1a\x1d.pvz.v1.ReceptionWithProducts\x12T\n" +
+	"\x11DeleteLastProduct\x12 .pvz.v1.DeleteLastProductRequest\x1a\x1d.pvz.v1.ReceptionWithProducts2J\n" +
+	"\x0eProductService\x128\n" +
+	"\n" +
+	"AddProduct\x12\x19.pvz.v1.AddProductRequest\x1a\x0f.pvz.v1.ProductB#Z!PVZ/internal/transport/grpc/pb;pbb\x06proto3"
+
+var (
+	file_pvz_v1_pvz_proto_rawDescOnce sync.Once
+	file_pvz_v1_pvz_proto_rawDescData []byte
+)
+
+func file_pvz_v1_pvz_proto_rawDescGZIP() []byte {
+	file_pvz_v1_pvz_proto_rawDescOnce.Do(func() {
+		file_pvz_v1_pvz_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pvz_v1_pvz_proto_rawDesc), len(file_pvz_v1_pvz_proto_rawDesc)))
+	})
+	return file_pvz_v1_pvz_proto_rawDescData
+}
+
+var file_pvz_v1_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_pvz_v1_pvz_proto_goTypes = []any{
+	(*PVZ)(nil),                      // 0: pvz.v1.PVZ
+	(*Reception)(nil),                // 1: pvz.v1.Reception
+	(*Product)(nil),                  // 2: pvz.v1.Product
+	(*ReceptionWithProducts)(nil),    // 3: pvz.v1.ReceptionWithProducts
+	(*PVZWithReceptions)(nil),        // 4: pvz.v1.PVZWithReceptions
+	(*CreatePVZRequest)(nil),         // 5: pvz.v1.CreatePVZRequest
+	(*GetPVZListRequest)(nil),        // 6: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),       // 7: pvz.v1.GetPVZListResponse
+	(*CreateReceptionRequest)(nil),   // 8: pvz.v1.CreateReceptionRequest
+	(*CloseReceptionRequest)(nil),    // 9: pvz.v1.CloseReceptionRequest
+	(*GetReceptionRequest)(nil),      // 10: pvz.v1.GetReceptionRequest
+	(*DeleteLastProductRequest)(nil), // 11: pvz.v1.DeleteLastProductRequest
+	(*AddProductRequest)(nil),        // 12: pvz.v1.AddProductRequest
+	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
+}
+var file_pvz_v1_pvz_proto_depIdxs = []int32{
+	13, // 0: pvz.v1.PVZ.created_at:type_name -> google.protobuf.Timestamp
+	13, // 1: pvz.v1.Reception.date_time:type_name -> google.protobuf.Timestamp
+	13, // 2: pvz.v1.Reception.closed_at:type_name -> google.protobuf.Timestamp
+	13, // 3: pvz.v1.Product.added_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: pvz.v1.ReceptionWithProducts.reception:type_name -> pvz.v1.Reception
+	2,  // 5: pvz.v1.ReceptionWithProducts.products:type_name -> pvz.v1.Product
+	0,  // 6: pvz.v1.PVZWithReceptions.pvz:type_name -> pvz.v1.PVZ
+	3,  // 7: pvz.v1.PVZWithReceptions.receptions:type_name -> pvz.v1.ReceptionWithProducts
+	13, // 8: pvz.v1.GetPVZListRequest.start_date:type_name -> google.protobuf.Timestamp
+	13, // 9: pvz.v1.GetPVZListRequest.end_date:type_name -> google.protobuf.Timestamp
+	4,  // 10: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZWithReceptions
+	5,  // 11: pvz.v1.PVZService.CreatePVZ:input_type -> pvz.v1.CreatePVZRequest
+	6,  // 12: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	8,  // 13: pvz.v1.ReceptionService.CreateReception:input_type -> pvz.v1.CreateReceptionRequest
+	9,  // 14: pvz.v1.ReceptionService.CloseReception:input_type -> pvz.v1.CloseReceptionRequest
+	10, // 15: pvz.v1.ReceptionService.GetReception:input_type -> pvz.v1.GetReceptionRequest
+	11, // 16: pvz.v1.ReceptionService.DeleteLastProduct:input_type -> pvz.v1.DeleteLastProductRequest
+	12, // 17: pvz.v1.ProductService.AddProduct:input_type -> pvz.v1.AddProductRequest
+	0,  // 18: pvz.v1.PVZService.CreatePVZ:output_type -> pvz.v1.PVZ
+	7,  // 19: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	1,  // 20: pvz.v1.ReceptionService.CreateReception:output_type -> pvz.v1.Reception
+	1,  // 21: pvz.v1.ReceptionService.CloseReception:output_type -> pvz.v1.Reception
+	3,  // 22: pvz.v1.ReceptionService.GetReception:output_type -> pvz.v1.ReceptionWithProducts
+	3,  // 23: pvz.v1.ReceptionService.DeleteLastProduct:output_type -> pvz.v1.ReceptionWithProducts
+	2,  // 24: pvz.v1.ProductService.AddProduct:output_type -> pvz.v1.Product
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_pvz_v1_pvz_proto_init() }
+func file_pvz_v1_pvz_proto_init() {
+	if File_pvz_v1_pvz_proto != nil {
+		return
+	}
+	file_pvz_v1_pvz_proto_msgTypes[2].OneofWrappers = []any{}
+	file_pvz_v1_pvz_proto_msgTypes[12].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pvz_v1_pvz_proto_rawDesc), len(file_pvz_v1_pvz_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_pvz_v1_pvz_proto_goTypes,
+		DependencyIndexes: file_pvz_v1_pvz_proto_depIdxs,
+		MessageInfos:      file_pvz_v1_pvz_proto_msgTypes,
+	}.Build()
+	File_pvz_v1_pvz_proto = out.File
+	file_pvz_v1_pvz_proto_goTypes = nil
+	file_pvz_v1_pvz_proto_depIdxs = nil
+}
@@ -0,0 +1,489 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: pvz/v1/pvz.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PVZService_CreatePVZ_FullMethodName  = "/pvz.v1.PVZService/CreatePVZ"
+	PVZService_GetPVZList_FullMethodName = "/pvz.v1.PVZService/GetPVZList"
+)
+
+// PVZServiceClient is the client API for PVZService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Операции с ПВЗ (admin и moderator).
+type PVZServiceClient interface {
+	CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*PVZ, error)
+	GetPVZList(ctx context.Context, in *GetPVZListRequest, opts ...grpc.CallOption) (*GetPVZListResponse, error)
+}
+
+type pVZServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPVZServiceClient(cc grpc.ClientConnInterface) PVZServiceClient {
+	return &pVZServiceClient{cc}
+}
+
+func (c *pVZServiceClient) CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*PVZ, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PVZ)
+	err := c.cc.Invoke(ctx, PVZService_CreatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetPVZList(ctx context.Context, in *GetPVZListRequest, opts ...grpc.CallOption) (*GetPVZListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPVZListResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetPVZList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PVZServiceServer is the server API for PVZService service.
+// All implementations must embed UnimplementedPVZServiceServer
+// for forward compatibility.
+//
+// Операции с ПВЗ (admin и moderator).
+type PVZServiceServer interface {
+	CreatePVZ(context.Context, *CreatePVZRequest) (*PVZ, error)
+	GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error)
+	mustEmbedUnimplementedPVZServiceServer()
+}
+
+// UnimplementedPVZServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPVZServiceServer struct{}
+
+func (UnimplementedPVZServiceServer) CreatePVZ(context.Context, *CreatePVZRequest) (*PVZ, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPVZList not implemented")
+}
+func (UnimplementedPVZServiceServer) mustEmbedUnimplementedPVZServiceServer() {}
+func (UnimplementedPVZServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafePVZServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PVZServiceServer will
+// result in compilation errors.
+type UnsafePVZServiceServer interface {
+	mustEmbedUnimplementedPVZServiceServer()
+}
+
+func RegisterPVZServiceServer(s grpc.ServiceRegistrar, srv PVZServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPVZServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PVZService_ServiceDesc, srv)
+}
+
+func _PVZService_CreatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, req.(*CreatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetPVZList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPVZListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetPVZList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetPVZList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetPVZList(ctx, req.(*GetPVZListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PVZService_ServiceDesc is the grpc.ServiceDesc for PVZService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PVZService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pvz.v1.PVZService",
+	HandlerType: (*PVZServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePVZ",
+			Handler:    _PVZService_CreatePVZ_Handler,
+		},
+		{
+			MethodName: "GetPVZList",
+			Handler:    _PVZService_GetPVZList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pvz/v1/pvz.proto",
+}
+
+const (
+	ReceptionService_CreateReception_FullMethodName   = "/pvz.v1.ReceptionService/CreateReception"
+	ReceptionService_CloseReception_FullMethodName    = "/pvz.v1.ReceptionService/CloseReception"
+	ReceptionService_GetReception_FullMethodName      = "/pvz.v1.ReceptionService/GetReception"
+	ReceptionService_DeleteLastProduct_FullMethodName = "/pvz.v1.ReceptionService/DeleteLastProduct"
+)
+
+// ReceptionServiceClient is the client API for ReceptionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Операции с приёмками (employee и moderator).
+type ReceptionServiceClient interface {
+	CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*Reception, error)
+	CloseReception(ctx context.Context, in *CloseReceptionRequest, opts ...grpc.CallOption) (*Reception, error)
+	GetReception(ctx context.Context, in *GetReceptionRequest, opts ...grpc.CallOption) (*ReceptionWithProducts, error)
+	DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*ReceptionWithProducts, error)
+}
+
+type receptionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReceptionServiceClient(cc grpc.ClientConnInterface) ReceptionServiceClient {
+	return &receptionServiceClient{cc}
+}
+
+func (c *receptionServiceClient) CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*Reception, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reception)
+	err := c.cc.Invoke(ctx, ReceptionService_CreateReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receptionServiceClient) CloseReception(ctx context.Context, in *CloseReceptionRequest, opts ...grpc.CallOption) (*Reception, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reception)
+	err := c.cc.Invoke(ctx, ReceptionService_CloseReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receptionServiceClient) GetReception(ctx context.Context, in *GetReceptionRequest, opts ...grpc.CallOption) (*ReceptionWithProducts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceptionWithProducts)
+	err := c.cc.Invoke(ctx, ReceptionService_GetReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *receptionServiceClient) DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*ReceptionWithProducts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceptionWithProducts)
+	err := c.cc.Invoke(ctx, ReceptionService_DeleteLastProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReceptionServiceServer is the server API for ReceptionService service.
+// All implementations must embed UnimplementedReceptionServiceServer
+// for forward compatibility.
+//
+// Операции с приёмками (employee и moderator).
+type ReceptionServiceServer interface {
+	CreateReception(context.Context, *CreateReceptionRequest) (*Reception, error)
+	CloseReception(context.Context, *CloseReceptionRequest) (*Reception, error)
+	GetReception(context.Context, *GetReceptionRequest) (*ReceptionWithProducts, error)
+	DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*ReceptionWithProducts, error)
+	mustEmbedUnimplementedReceptionServiceServer()
+}
+
+// UnimplementedReceptionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReceptionServiceServer struct{}
+
+func (UnimplementedReceptionServiceServer) CreateReception(context.Context, *CreateReceptionRequest) (*Reception, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReception not implemented")
+}
+func (UnimplementedReceptionServiceServer) CloseReception(context.Context, *CloseReceptionRequest) (*Reception, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseReception not implemented")
+}
+func (UnimplementedReceptionServiceServer) GetReception(context.Context, *GetReceptionRequest) (*ReceptionWithProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReception not implemented")
+}
+func (UnimplementedReceptionServiceServer) DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*ReceptionWithProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLastProduct not implemented")
+}
+func (UnimplementedReceptionServiceServer) mustEmbedUnimplementedReceptionServiceServer() {}
+func (UnimplementedReceptionServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeReceptionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReceptionServiceServer will
+// result in compilation errors.
+type UnsafeReceptionServiceServer interface {
+	mustEmbedUnimplementedReceptionServiceServer()
+}
+
+func RegisterReceptionServiceServer(s grpc.ServiceRegistrar, srv ReceptionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReceptionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReceptionService_ServiceDesc, srv)
+}
+
+func _ReceptionService_CreateReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceptionServiceServer).CreateReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceptionService_CreateReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceptionServiceServer).CreateReception(ctx, req.(*CreateReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReceptionService_CloseReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceptionServiceServer).CloseReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceptionService_CloseReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceptionServiceServer).CloseReception(ctx, req.(*CloseReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReceptionService_GetReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceptionServiceServer).GetReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceptionService_GetReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceptionServiceServer).GetReception(ctx, req.(*GetReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReceptionService_DeleteLastProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLastProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceptionServiceServer).DeleteLastProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceptionService_DeleteLastProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceptionServiceServer).DeleteLastProduct(ctx, req.(*DeleteLastProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReceptionService_ServiceDesc is the grpc.ServiceDesc for ReceptionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReceptionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pvz.v1.ReceptionService",
+	HandlerType: (*ReceptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReception",
+			Handler:    _ReceptionService_CreateReception_Handler,
+		},
+		{
+			MethodName: "CloseReception",
+			Handler:    _ReceptionService_CloseReception_Handler,
+		},
+		{
+			MethodName: "GetReception",
+			Handler:    _ReceptionService_GetReception_Handler,
+		},
+		{
+			MethodName: "DeleteLastProduct",
+			Handler:    _ReceptionService_DeleteLastProduct_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pvz/v1/pvz.proto",
+}
+
+const (
+	ProductService_AddProduct_FullMethodName = "/pvz.v1.ProductService/AddProduct"
+)
+
+// ProductServiceClient is the client API for ProductService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Операции с товарами (employee и moderator).
+type ProductServiceClient interface {
+	AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*Product, error)
+}
+
+type productServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProductServiceClient(cc grpc.ClientConnInterface) ProductServiceClient {
+	return &productServiceClient{cc}
+}
+
+func (c *productServiceClient) AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, ProductService_AddProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServiceServer is the server API for ProductService service.
+// All implementations must embed UnimplementedProductServiceServer
+// for forward compatibility.
+//
+// Операции с товарами (employee и moderator).
+type ProductServiceServer interface {
+	AddProduct(context.Context, *AddProductRequest) (*Product, error)
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+// UnimplementedProductServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServiceServer struct{}
+
+func (UnimplementedProductServiceServer) AddProduct(context.Context, *AddProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProduct not implemented")
+}
+func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
+func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServiceServer will
+// result in compilation errors.
+type UnsafeProductServiceServer interface {
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProductServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductService_ServiceDesc, srv)
+}
+
+func _ProductService_AddProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddProduct(ctx, req.(*AddProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProductService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pvz.v1.ProductService",
+	HandlerType: (*ProductServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddProduct",
+			Handler:    _ProductService_AddProduct_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pvz/v1/pvz.proto",
+}
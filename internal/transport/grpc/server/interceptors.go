@@ -0,0 +1,102 @@
+package server
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/pkg/auth"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type RevocationList interface {
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// AuthInterceptor — аналог JWTMiddleware и RoleMiddleware: токен берётся из
+// метаданных authorization, роль сверяется с methodRoles. Метод, которого нет
+// в methodRoles, недоступен никому.
+func AuthInterceptor(jwtKey []byte, revocations RevocationList, methodRoles map[string][]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var token string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				token = values[0]
+			}
+		}
+		if token == "" {
+			return nil, status.Error(codes.Unauthenticated, "missing token")
+		}
+
+		claims, err := auth.ParseJWT(token, jwtKey)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+
+		if claims.Id != "" {
+			revoked, err := revocations.IsRevoked(ctx, claims.Id)
+			if err != nil {
+				slog.Error("Failed to check token revocation", "jti", claims.Id, "err", err)
+				return nil, status.Error(codes.Internal, "internal error")
+			}
+			if revoked {
+				return nil, status.Error(codes.Unauthenticated, "token revoked")
+			}
+		}
+
+		if !slices.Contains(methodRoles[info.FullMethod], claims.Role) {
+			return nil, status.Error(codes.PermissionDenied, "access denied for your role")
+		}
+
+		return handler(auth.WithPrincipal(ctx, auth.PrincipalFromClaims(claims)), req)
+	}
+}
+
+// ErrorInterceptor — аналог ErrorMiddleware: доменные ошибки превращаются в
+// gRPC-статусы, внутренние детали наружу не отдаются.
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+
+		code := codeFromError(err)
+		msg := errs.ErrInternal.Error()
+		var domainErr *errs.Error
+		if errors.As(err, &domainErr) {
+			msg = domainErr.Message()
+		}
+
+		if code == codes.Internal {
+			slog.Error("Request failed", "method", info.FullMethod, "err", err)
+		}
+
+		return nil, status.Error(code, msg)
+	}
+}
+
+func codeFromError(err error) codes.Code {
+	switch errs.Kind(err) {
+	case errs.ErrValidation:
+		return codes.InvalidArgument
+	case errs.ErrUnauthorized:
+		return codes.Unauthenticated
+	case errs.ErrForbidden:
+		return codes.PermissionDenied
+	case errs.ErrNotFound:
+		return codes.NotFound
+	case errs.ErrConflict:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/pkg/auth"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testKey = []byte("test-secret")
+
+type revocations map[string]bool
+
+func (r revocations) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	return r[jti], nil
+}
+
+func signToken(t *testing.T, key []byte, role, jti string) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.UserClaims{
+		Role: role,
+		StandardClaims: jwt.StandardClaims{
+			Id:        jti,
+			Subject:   "user-1",
+			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		},
+	})
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	const method = "/pvz.v1.ReceptionService/CreateReception"
+	interceptor := AuthInterceptor(testKey, revocations{"revoked": true}, map[string][]string{
+		method: {"employee"},
+	})
+
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		code   codes.Code
+	}{
+		{"missing token", "", method, codes.Unauthenticated},
+		{"invalid signature", "Bearer " + signToken(t, []byte("other"), "employee", ""), method, codes.Unauthenticated},
+		{"revoked", "Bearer " + signToken(t, testKey, "employee", "revoked"), method, codes.Unauthenticated},
+		{"wrong role", "Bearer " + signToken(t, testKey, "moderator", ""), method, codes.PermissionDenied},
+		{"unknown method", "Bearer " + signToken(t, testKey, "employee", ""), "/pvz.v1.Other/Call", codes.PermissionDenied},
+		{"ok", "Bearer " + signToken(t, testKey, "employee", "jti-1"), method, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.token))
+			}
+
+			var principal auth.Principal
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req any) (any, error) {
+				principal, _ = auth.PrincipalFromContext(ctx)
+				return nil, nil
+			})
+
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %v, want %v (err: %v)", code, tt.code, err)
+			}
+			if tt.code == codes.OK && principal.Role != "employee" {
+				t.Fatalf("principal role = %q, want employee", principal.Role)
+			}
+		})
+	}
+}
+
+func TestErrorInterceptor(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{"validation", errs.Validation("invalid city"), codes.InvalidArgument, "invalid city"},
+		{"forbidden", errs.Forbidden("access denied"), codes.PermissionDenied, "access denied"},
+		{"not found", errs.NotFound("no active reception"), codes.NotFound, "no active reception"},
+		{"conflict", errs.Conflict("there is already an active reception"), codes.FailedPrecondition, "there is already an active reception"},
+		{"unknown", errors.New("pq: relation does not exist"), codes.Internal, "internal error"},
+		{"status passthrough", status.Error(codes.Unauthenticated, "missing token"), codes.Unauthenticated, "missing token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ErrorInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test"}, func(ctx context.Context, req any) (any, error) {
+				return nil, tt.err
+			})
+
+			st := status.Convert(err)
+			if st.Code() != tt.code {
+				t.Fatalf("code = %v, want %v", st.Code(), tt.code)
+			}
+			if st.Message() != tt.message {
+				t.Fatalf("message = %q, want %q", st.Message(), tt.message)
+			}
+		})
+	}
+}
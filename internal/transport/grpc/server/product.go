@@ -0,0 +1,89 @@
+package server
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/service"
+	"PVZ/internal/transport/grpc/pb"
+	"PVZ/models"
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type productServer struct {
+	pb.UnimplementedProductServiceServer
+	svc *service.ProductService
+}
+
+// AddProduct со штрихкодом работает как POST /products/scan, без него — как
+// POST /products, где кроме типа ничего не передаётся.
+func (s *productServer) AddProduct(ctx context.Context, req *pb.AddProductRequest) (*pb.Product, error) {
+	var (
+		product *models.Product
+		warning string
+		err     error
+	)
+	if req.GetBarcode() != "" {
+		product, warning, err = s.svc.ScanProduct(ctx, req.GetPvzId(), userRole(ctx), service.ProductInput{
+			Type:        req.GetType(),
+			Barcode:     req.GetBarcode(),
+			SKU:         req.GetSku(),
+			OrderNumber: req.GetOrderNumber(),
+			WeightGrams: intPtr(req.WeightGrams),
+			LengthMM:    intPtr(req.LengthMm),
+			WidthMM:     intPtr(req.WidthMm),
+			HeightMM:    intPtr(req.HeightMm),
+		})
+	} else {
+		if req.GetSku() != "" || req.GetOrderNumber() != "" ||
+			req.WeightGrams != nil || req.LengthMm != nil || req.WidthMm != nil || req.HeightMm != nil {
+			return nil, errs.Validation("barcode is required for product details")
+		}
+		product, warning, err = s.svc.AddProduct(ctx, req.GetPvzId(), userRole(ctx), req.GetType())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Превышение вместимости при политике warn отдаётся в заголовке ответа.
+	if warning != "" {
+		_ = grpc.SetHeader(ctx, metadata.Pairs("capacity-warning", warning))
+	}
+
+	return toProduct(product), nil
+}
+
+func toProduct(p *models.Product) *pb.Product {
+	return &pb.Product{
+		Id:          p.ID,
+		ReceptionId: p.ReceptionID,
+		Type:        p.Type,
+		AddedAt:     timestamppb.New(p.AddedAt),
+		Barcode:     p.Barcode.String,
+		Sku:         p.Sku.String,
+		OrderNumber: p.OrderNumber.String,
+		WeightGrams: int32Ptr(p.WeightGrams),
+		LengthMm:    int32Ptr(p.LengthMM),
+		WidthMm:     int32Ptr(p.WidthMM),
+		HeightMm:    int32Ptr(p.HeightMM),
+	}
+}
+
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+func int32Ptr(v null.Int) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	n := int32(v.Int)
+	return &n
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/service"
+	"PVZ/internal/transport/grpc/pb"
+	"PVZ/models"
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type pvzServer struct {
+	pb.UnimplementedPVZServiceServer
+	svc *service.PVZService
+}
+
+func (s *pvzServer) CreatePVZ(ctx context.Context, req *pb.CreatePVZRequest) (*pb.PVZ, error) {
+	pvz, err := s.svc.CreatePVZ(ctx, req.GetName(), req.GetCity(), userRole(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return toPVZ(pvz), nil
+}
+
+// pageWindow приводит страницу и размер к тем же правилам, что и в HTTP:
+// page от 1, limit от 1 до 30 (по умолчанию 10). Смещение считается в int,
+// чтобы большой номер страницы не переполнил int32.
+func pageWindow(page, limit int32) (int32, int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 30 {
+		limit = 10
+	}
+	return page, (int(page) - 1) * int(limit), int(limit)
+}
+
+func (s *pvzServer) GetPVZList(ctx context.Context, req *pb.GetPVZListRequest) (*pb.GetPVZListResponse, error) {
+	page, offset, limit := pageWindow(req.GetPage(), req.GetLimit())
+
+	startDate, err := optionalTime(req.GetStartDate())
+	if err != nil {
+		return nil, errs.Validation("invalid startDate")
+	}
+	endDate, err := optionalTime(req.GetEndDate())
+	if err != nil {
+		return nil, errs.Validation("invalid endDate")
+	}
+
+	list, err := s.svc.GetPVZList(ctx, offset, limit, req.GetCity(), startDate, endDate, userRole(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetPVZListResponse{
+		Pvzs: make([]*pb.PVZWithReceptions, 0, len(list)),
+		Page: page,
+	}
+	for _, pvz := range list {
+		item := &pb.PVZWithReceptions{
+			Pvz:        toPVZ(pvz),
+			Receptions: make([]*pb.ReceptionWithProducts, 0, len(pvz.R.GetReceptions())),
+		}
+		for _, rec := range pvz.R.GetReceptions() {
+			item.Receptions = append(item.Receptions, toReceptionWithProducts(rec))
+		}
+		resp.Pvzs = append(resp.Pvzs, item)
+	}
+
+	return resp, nil
+}
+
+func toPVZ(pvz *models.PVZ) *pb.PVZ {
+	return &pb.PVZ{
+		Id:        pvz.ID,
+		Name:      pvz.Name,
+		City:      pvz.City,
+		CreatedAt: timestamppb.New(pvz.CreatedAt),
+	}
+}
+
+// optionalTime возвращает nil для незаданного фильтра.
+func optionalTime(ts *timestamppb.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return nil, err
+	}
+	t := ts.AsTime()
+	return &t, nil
+}
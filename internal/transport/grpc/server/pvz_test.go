@@ -0,0 +1,31 @@
+package server
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPageWindow(t *testing.T) {
+	tests := []struct {
+		name        string
+		page, limit int32
+		wantPage    int32
+		wantOffset  int
+		wantLimit   int
+	}{
+		{"defaults", 0, 0, 1, 0, 10},
+		{"negative", -5, -1, 1, 0, 10},
+		{"limit above max", 2, 31, 2, 10, 10},
+		{"max limit", 3, 30, 3, 60, 30},
+		{"huge page", math.MaxInt32, 30, math.MaxInt32, (math.MaxInt32 - 1) * 30, 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, offset, limit := pageWindow(tt.page, tt.limit)
+			if page != tt.wantPage || offset != tt.wantOffset || limit != tt.wantLimit {
+				t.Fatalf("pageWindow(%d, %d) = %d, %d, %d; want %d, %d, %d",
+					tt.page, tt.limit, page, offset, limit, tt.wantPage, tt.wantOffset, tt.wantLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"PVZ/internal/service"
+	"PVZ/internal/transport/grpc/pb"
+	"PVZ/models"
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type receptionServer struct {
+	pb.UnimplementedReceptionServiceServer
+	svc *service.ReceptionService
+}
+
+func (s *receptionServer) CreateReception(ctx context.Context, req *pb.CreateReceptionRequest) (*pb.Reception, error) {
+	rec, err := s.svc.CreateReception(ctx, req.GetPvzId(), userRole(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return toReception(rec), nil
+}
+
+func (s *receptionServer) CloseReception(ctx context.Context, req *pb.CloseReceptionRequest) (*pb.Reception, error) {
+	rec, err := s.svc.CloseReception(ctx, req.GetPvzId(), userRole(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return toReception(rec), nil
+}
+
+func (s *receptionServer) GetReception(ctx context.Context, req *pb.GetReceptionRequest) (*pb.ReceptionWithProducts, error) {
+	rec, err := s.svc.GetReception(ctx, req.GetId(), userRole(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return toReceptionWithProducts(rec), nil
+}
+
+func (s *receptionServer) DeleteLastProduct(ctx context.Context, req *pb.DeleteLastProductRequest) (*pb.ReceptionWithProducts, error) {
+	rec, err := s.svc.DeleteLastProduct(ctx, req.GetPvzId(), userRole(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return toReceptionWithProducts(rec), nil
+}
+
+func toReception(rec *models.Reception) *pb.Reception {
+	resp := &pb.Reception{
+		Id:       rec.ID,
+		PvzId:    rec.PVZID,
+		Status:   rec.Status,
+		DateTime: timestamppb.New(rec.DateTime),
+	}
+	if rec.ClosedAt.Valid {
+		resp.ClosedAt = timestamppb.New(rec.ClosedAt.Time)
+	}
+	return resp
+}
+
+func toReceptionWithProducts(rec *models.Reception) *pb.ReceptionWithProducts {
+	resp := &pb.ReceptionWithProducts{
+		Reception: toReception(rec),
+		Products:  make([]*pb.Product, 0, len(rec.R.GetProducts())),
+	}
+	for _, p := range rec.R.GetProducts() {
+		resp.Products = append(resp.Products, toProduct(p))
+	}
+	return resp
+}
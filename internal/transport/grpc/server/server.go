@@ -0,0 +1,48 @@
+package server
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/service"
+	"PVZ/internal/transport/grpc/pb"
+	"PVZ/pkg/auth"
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// methodRoles — роли, которым доступен метод; совпадают с ролями
+// соответствующих HTTP-маршрутов.
+var methodRoles = map[string][]string{
+	pb.PVZService_CreatePVZ_FullMethodName:               {"admin", constants.RoleModerator},
+	pb.PVZService_GetPVZList_FullMethodName:              {"admin", constants.RoleModerator},
+	pb.ReceptionService_CreateReception_FullMethodName:   {constants.RoleEmployee, constants.RoleModerator},
+	pb.ReceptionService_CloseReception_FullMethodName:    {constants.RoleEmployee, constants.RoleModerator},
+	pb.ReceptionService_GetReception_FullMethodName:      {constants.RoleEmployee, constants.RoleModerator},
+	pb.ReceptionService_DeleteLastProduct_FullMethodName: {constants.RoleEmployee, constants.RoleModerator},
+	pb.ProductService_AddProduct_FullMethodName:          {constants.RoleEmployee, constants.RoleModerator},
+}
+
+// New собирает gRPC-сервер поверх тех же сервисов, что и HTTP API.
+func New(
+	pvzService *service.PVZService,
+	receptionService *service.ReceptionService,
+	productService *service.ProductService,
+	jwtKey []byte,
+	revocations RevocationList,
+) *grpc.Server {
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		ErrorInterceptor(),
+		AuthInterceptor(jwtKey, revocations, methodRoles),
+	))
+
+	pb.RegisterPVZServiceServer(srv, &pvzServer{svc: pvzService})
+	pb.RegisterReceptionServiceServer(srv, &receptionServer{svc: receptionService})
+	pb.RegisterProductServiceServer(srv, &productServer{svc: productService})
+
+	return srv
+}
+
+func userRole(ctx context.Context) string {
+	p, _ := auth.PrincipalFromContext(ctx)
+	return p.Role
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"PVZ/internal/domain/errs"
 	"PVZ/internal/service"
 	"net/http"
 
@@ -24,6 +25,8 @@ func NewAuthHandler(svc *service.UserService) *AuthHandler {
 // @Param request body RegisterRequest true "Данные для регистрации"
 // @Success 201 {object} RegisterResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /auth/register [post]
 func (h *AuthHandler) Register(c *gin.Context) {
 	var req struct {
@@ -33,13 +36,13 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		_ = c.Error(errs.Validation("invalid request"))
 		return
 	}
 
-	user, err := h.svc.Register(c, req.Email, req.Password, req.Role)
+	user, err := h.svc.Register(c.Request.Context(), req.Email, req.Password, req.Role)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -60,6 +63,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 // @Success 200 {object} LoginResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req struct {
@@ -68,18 +72,90 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		_ = c.Error(errs.Validation("invalid request"))
 		return
 	}
 
 	ctx := c.Request.Context()
-	token, err := h.svc.Login(ctx, req.Email, req.Password)
+	pair, err := h.svc.Login(ctx, req.Email, req.Password)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"token": token})
+	c.JSON(http.StatusOK, gin.H{
+		"token":        pair.AccessToken,
+		"refreshToken": pair.RefreshToken,
+		"expiresIn":    pair.ExpiresIn,
+	})
+}
+
+// Refresh godoc
+// @Summary Обновление токена
+// @Description Обмен refresh token на новую пару токенов; старый refresh token отзывается
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body RefreshRequest true "Refresh token"
+// @Success 200 {object} LoginResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/refresh [post]
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refreshToken"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		_ = c.Error(errs.Validation("invalid request"))
+		return
+	}
+
+	pair, err := h.svc.Refresh(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"token":        pair.AccessToken,
+		"refreshToken": pair.RefreshToken,
+		"expiresIn":    pair.ExpiresIn,
+	})
+}
+
+// Logout godoc
+// @Summary Выход из системы
+// @Description Отзыв текущего access token и переданного refresh token
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body LogoutRequest false "Refresh token"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refreshToken"`
+	}
+
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+	}
+
+	if err := h.svc.Logout(c.Request.Context(), req.RefreshToken); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
 }
 
 // DummyLogin godoc
@@ -91,6 +167,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 // @Param request body DummyLoginRequest true "Роль для тестового входа"
 // @Success 200 {object} LoginResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /auth/dummy [post]
 func (h *AuthHandler) DummyLogin(c *gin.Context) {
 	var req struct {
@@ -98,13 +175,13 @@ func (h *AuthHandler) DummyLogin(c *gin.Context) {
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+		_ = c.Error(errs.Validation("invalid request"))
 		return
 	}
 
 	token, err := h.svc.DummyLogin(req.Role)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = c.Error(err)
 		return
 	}
 
@@ -131,7 +208,17 @@ type (
 	}
 
 	LoginResponse struct {
-		Token string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+		Token        string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+		RefreshToken string `json:"refreshToken" example:"q8Vd3l0YyG2vJ6mX3f2c1b0a9Z8y7X6w5V4u3T2s1R0"`
+		ExpiresIn    int64  `json:"expiresIn" example:"900"`
+	}
+
+	RefreshRequest struct {
+		RefreshToken string `json:"refreshToken" example:"q8Vd3l0YyG2vJ6mX3f2c1b0a9Z8y7X6w5V4u3T2s1R0"`
+	}
+
+	LogoutRequest struct {
+		RefreshToken string `json:"refreshToken" example:"q8Vd3l0YyG2vJ6mX3f2c1b0a9Z8y7X6w5V4u3T2s1R0"`
 	}
 
 	DummyLoginRequest struct {
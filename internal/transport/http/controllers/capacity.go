@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/service"
+	"PVZ/pkg/helper"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SetCapacityHandler godoc
+// @Summary Вместимость ПВЗ
+// @Description Задает вместимость ПВЗ в местах хранения и правило при заполнении: reject — товар не принимается, warn — принимается с предупреждением в логе и метрике (только для moderator). Без capacity ограничение снимается
+// @Tags PVZ
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param request body CapacityRequest true "Вместимость"
+// @Success 200 {object} CapacityResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/capacity [put]
+func SetCapacityHandler(svc *service.PVZService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req CapacityRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		pvz, err := svc.SetCapacity(c.Request.Context(), c.Param("id"), req.Capacity, req.Policy, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, CapacityResponse{
+			PvzID:    pvz.ID,
+			Capacity: pvz.Capacity.Ptr(),
+			Policy:   pvz.CapacityPolicy,
+		})
+	}
+}
+
+// PVZOccupancyHandler godoc
+// @Summary Заполненность ПВЗ
+// @Description Сколько мест занято товарами, которые сейчас хранятся в ПВЗ (приняты или ждут выдачи), и сколько осталось (moderator или employee, закреплённый за ПВЗ)
+// @Tags PVZ
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Success 200 {object} OccupancyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/occupancy [get]
+func PVZOccupancyHandler(svc *service.PVZService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		occ, err := svc.Occupancy(c.Request.Context(), c.Param("id"), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := OccupancyResponse{
+			PvzID:    occ.PVZID,
+			Capacity: occ.Capacity,
+			Policy:   occ.Policy,
+			Occupied: occ.Occupied,
+		}
+		if occ.Capacity != nil {
+			free := max(*occ.Capacity-occ.Occupied, 0)
+			resp.Free = &free
+			resp.Full = free == 0
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// DTO структуры для вместимости ПВЗ
+type (
+	CapacityRequest struct {
+		Capacity *int   `json:"capacity" example:"500"`
+		Policy   string `json:"policy,omitempty" example:"reject" enums:"reject,warn"`
+	}
+
+	CapacityResponse struct {
+		PvzID    int64  `json:"pvzId" example:"1"`
+		Capacity *int   `json:"capacity,omitempty" example:"500"`
+		Policy   string `json:"policy" example:"reject" enums:"reject,warn"`
+	}
+
+	OccupancyResponse struct {
+		PvzID    int64  `json:"pvzId" example:"1"`
+		Capacity *int   `json:"capacity,omitempty" example:"500"`
+		Policy   string `json:"policy" example:"reject" enums:"reject,warn"`
+		Occupied int    `json:"occupied" example:"437"`
+		Free     *int   `json:"free,omitempty" example:"63"`
+		Full     bool   `json:"full" example:"false"`
+	}
+)
@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/service"
+	"PVZ/models"
+	"PVZ/pkg/helper"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateCellHandler godoc
+// @Summary Создание ячейки хранения
+// @Description Заводит в ПВЗ ячейку с кодом вида A-01-03 (стеллаж-полка-место) и вместимостью в товарах, по умолчанию 1 (только для moderator)
+// @Tags Cells
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param request body CellRequest true "Ячейка"
+// @Success 201 {object} CellResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/cells [post]
+func CreateCellHandler(svc *service.CellService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req CellRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		cell, err := svc.Create(c.Request.Context(), c.Param("id"), req.Code, req.Capacity, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, toCellResponse(cell, 0))
+	}
+}
+
+// ListCellsHandler godoc
+// @Summary Ячейки хранения ПВЗ
+// @Description Ячейки ПВЗ по коду с числом занятых и свободных мест (moderator или employee, закреплённый за ПВЗ)
+// @Tags Cells
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Success 200 {array} CellResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/cells [get]
+func ListCellsHandler(svc *service.CellService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		cells, err := svc.List(c.Request.Context(), c.Param("id"), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := make([]CellResponse, 0, len(cells))
+		for _, occ := range cells {
+			resp = append(resp, toCellResponse(occ.Cell, occ.Occupied))
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// DeleteCellHandler godoc
+// @Summary Удаление ячейки хранения
+// @Description Удаляет пустую ячейку ПВЗ (только для moderator)
+// @Tags Cells
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param code path string true "Код ячейки"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/cells/{code} [delete]
+func DeleteCellHandler(svc *service.CellService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		if err := svc.Delete(c.Request.Context(), c.Param("id"), c.Param("code"), userRole); err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// MoveProductHandler godoc
+// @Summary Перемещение товара между ячейками
+// @Description Перекладывает хранящийся товар в другую ячейку того же ПВЗ (только employee, закреплённый за ПВЗ)
+// @Tags Cells
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID товара"
+// @Param request body MoveProductRequest true "Целевая ячейка"
+// @Success 200 {object} ProductResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/{id}/move [post]
+func MoveProductHandler(svc *service.CellService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req MoveProductRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		product, err := svc.MoveProduct(c.Request.Context(), c.Param("id"), req.Cell, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, toProductResponse(product))
+	}
+}
+
+func toCellResponse(cell *models.PVZCell, occupied int) CellResponse {
+	return CellResponse{
+		ID:       cell.ID,
+		PvzID:    cell.PVZID,
+		Code:     cell.Code,
+		Capacity: cell.Capacity,
+		Occupied: occupied,
+		Free:     max(cell.Capacity-occupied, 0),
+	}
+}
+
+func cellCode(p *models.Product) string {
+	if cell := p.R.GetCell(); cell != nil {
+		return cell.Code
+	}
+	return ""
+}
+
+// DTO структуры для ячеек хранения
+type (
+	CellRequest struct {
+		Code     string `json:"code" example:"A-01-03"`
+		Capacity *int   `json:"capacity,omitempty" example:"4"`
+	}
+
+	CellResponse struct {
+		ID       string `json:"id" example:"550e8400-e29b-41d4-a716-446655440003"`
+		PvzID    int64  `json:"pvzId" example:"1"`
+		Code     string `json:"code" example:"A-01-03"`
+		Capacity int    `json:"capacity" example:"4"`
+		Occupied int    `json:"occupied" example:"1"`
+		Free     int    `json:"free" example:"3"`
+	}
+
+	MoveProductRequest struct {
+		Cell string `json:"cell" example:"B-02-01"`
+	}
+)
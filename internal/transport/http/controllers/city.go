@@ -0,0 +1,160 @@
+package controllers
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/service"
+	"PVZ/models"
+	"PVZ/pkg/helper"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListCitiesHandler godoc
+// @Summary Справочник городов
+// @Description Список городов, в которых можно открывать ПВЗ
+// @Tags Cities
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} CityResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cities/ [get]
+func ListCitiesHandler(svc *service.CityService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		cities, err := svc.List(c.Request.Context(), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := make([]CityResponse, 0, len(cities))
+		for _, city := range cities {
+			resp = append(resp, toCityResponse(city))
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// CreateCityHandler godoc
+// @Summary Добавление города
+// @Description Добавление города в справочник (только для moderator)
+// @Tags Cities
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CityRequest true "Город"
+// @Success 201 {object} CityResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cities/ [post]
+func CreateCityHandler(svc *service.CityService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req CityRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		city, err := svc.Create(c.Request.Context(), req.Name, req.Region, req.Timezone, req.Enabled, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, toCityResponse(city))
+	}
+}
+
+// UpdateCityHandler godoc
+// @Summary Изменение города
+// @Description Изменение города (только для moderator). В выключенном городе нельзя открыть новый ПВЗ, переименование переносится на его ПВЗ
+// @Tags Cities
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID города"
+// @Param request body CityRequest true "Новые значения"
+// @Success 200 {object} CityResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cities/{id} [put]
+func UpdateCityHandler(svc *service.CityService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req CityRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		city, err := svc.Update(c.Request.Context(), c.Param("id"), req.Name, req.Region, req.Timezone, req.Enabled, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, toCityResponse(city))
+	}
+}
+
+// DeleteCityHandler godoc
+// @Summary Удаление города
+// @Description Удаление города без ПВЗ (только для moderator). Город с ПВЗ можно только выключить
+// @Tags Cities
+// @Security BearerAuth
+// @Param id path int true "ID города"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cities/{id} [delete]
+func DeleteCityHandler(svc *service.CityService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		if err := svc.Delete(c.Request.Context(), c.Param("id"), userRole); err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func toCityResponse(city *models.City) CityResponse {
+	return CityResponse{
+		ID:       city.ID,
+		Name:     city.Name,
+		Region:   city.Region,
+		Timezone: city.Timezone,
+		Enabled:  city.Enabled,
+	}
+}
+
+// DTO структуры для City
+type (
+	CityRequest struct {
+		Name     string `json:"name" example:"Екатеринбург"`
+		Region   string `json:"region" example:"Свердловская область"`
+		Timezone string `json:"timezone" example:"Asia/Yekaterinburg"`
+		Enabled  *bool  `json:"enabled,omitempty" example:"true"`
+	}
+
+	CityResponse struct {
+		ID       int64  `json:"id" example:"4"`
+		Name     string `json:"name" example:"Екатеринбург"`
+		Region   string `json:"region" example:"Свердловская область"`
+		Timezone string `json:"timezone" example:"Asia/Yekaterinburg"`
+		Enabled  bool   `json:"enabled" example:"true"`
+	}
+)
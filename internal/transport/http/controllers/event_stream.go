@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"PVZ/internal/service"
+	"PVZ/pkg/helper"
+	"PVZ/pkg/metrics"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// heartbeatInterval — как часто в открытый поток пишется комментарий, чтобы
+// прокси не закрывали простаивающее соединение.
+const heartbeatInterval = 15 * time.Second
+
+// PVZEventsHandler godoc
+// @Summary Живая лента событий ПВЗ
+// @Description Server-sent events об открытии, закрытии, отмене и возобновлении приёмок и о добавлении и удалении товаров (moderator или employee, закреплённый за ПВЗ). Имя события — его тип, data — событие в JSON, id — ID события.
+// @Tags PVZ
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Success 200 {string} string "Поток событий"
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/events [get]
+func PVZEventsHandler(svc *service.EventStreamService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		sub, err := svc.Subscribe(c.Request.Context(), c.Param("id"), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		defer sub.Close()
+
+		metrics.EventStreamClients.Inc()
+		defer metrics.EventStreamClients.Dec()
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		// Отключает буферизацию ответа в nginx.
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+		c.Writer.Flush()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		c.Stream(func(w io.Writer) bool {
+			select {
+			case <-c.Request.Context().Done():
+				return false
+			case event, ok := <-sub.Events():
+				if !ok {
+					return false
+				}
+				data, err := json.Marshal(event)
+				if err != nil {
+					return false
+				}
+				_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+				return err == nil
+			case <-heartbeat.C:
+				_, err := io.WriteString(w, ": ping\n\n")
+				return err == nil
+			}
+		})
+	}
+}
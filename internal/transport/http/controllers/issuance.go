@@ -0,0 +1,196 @@
+package controllers
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/service"
+	"PVZ/models"
+	"PVZ/pkg/helper"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IssueProductsHandler godoc
+// @Summary Выдача посылок клиенту
+// @Description Отмечает выданными посылки ПВЗ с указанным кодом выдачи (только employee, закреплённый за ПВЗ). Без productIds выдаются все посылки с этим кодом
+// @Tags Issuance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param request body IssueRequest true "Код выдачи"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ"
+// @Success 200 {object} IssueResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/issue [post]
+func IssueProductsHandler(svc *service.IssuanceService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req IssueRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		issued, err := svc.Issue(c.Request.Context(), c.Param("id"), userRole, service.IssueInput{
+			PickupCode: req.PickupCode,
+			ProductIDs: req.ProductIDs,
+		})
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, IssueResponse{Products: toProductResponses(issued)})
+	}
+}
+
+// ReturnToSenderHandler godoc
+// @Summary Возврат невостребованных посылок отправителю
+// @Description Переводит ожидающие выдачи посылки ПВЗ в статус returned: они покидают ПВЗ и освобождают место (только employee, закреплённый за ПВЗ). Без productIds возвращаются просроченные посылки, не больше 500 за запрос
+// @Tags Issuance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param request body ReturnToSenderRequest false "Посылки"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ"
+// @Success 200 {object} IssueResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/return-to-sender [post]
+func ReturnToSenderHandler(svc *service.IssuanceService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ReturnToSenderRequest
+		if c.Request.ContentLength != 0 {
+			if err := c.ShouldBindJSON(&req); err != nil {
+				_ = c.Error(errs.Validation("invalid request"))
+				return
+			}
+		}
+
+		userRole := helper.GetUserRole(c)
+		returned, err := svc.ReturnToSender(c.Request.Context(), c.Param("id"), userRole, service.ReturnToSenderInput{
+			ProductIDs: req.ProductIDs,
+		})
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, IssueResponse{Products: toProductResponses(returned)})
+	}
+}
+
+// ListPVZProductsHandler godoc
+// @Summary Товары на хранении в ПВЗ
+// @Description Принятые и ожидающие выдачи товары ПВЗ от новых к старым с курсорной пагинацией (moderator или employee, закреплённый за ПВЗ)
+// @Tags Issuance
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param status query string false "Статус товара" Enums(received, ready_for_pickup)
+// @Param pickupCode query string false "Код выдачи"
+// @Param cell query string false "Код ячейки хранения"
+// @Param cursor query string false "Курсор следующей страницы из nextCursor"
+// @Param limit query int false "Количество записей на странице"
+// @Success 200 {object} ProductListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/products [get]
+func ListPVZProductsHandler(svc *service.IssuanceService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+		if limit < 1 || limit > 30 {
+			limit = 10
+		}
+
+		userRole := helper.GetUserRole(c)
+		products, next, err := svc.ListStored(
+			c.Request.Context(), c.Param("id"), c.Query("status"),
+			c.Query("pickupCode"), c.Query("cell"), c.Query("cursor"), limit, userRole,
+		)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, ProductListResponse{
+			Products:   toProductResponses(products),
+			NextCursor: next,
+		})
+	}
+}
+
+// ListOverdueProductsHandler godoc
+// @Summary Просроченные посылки ПВЗ
+// @Description Посылки, ожидающие выдачи дольше срока хранения своего типа, начиная с самых просроченных (moderator или employee, закреплённый за ПВЗ)
+// @Tags Issuance
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param cursor query string false "Курсор следующей страницы из nextCursor"
+// @Param limit query int false "Количество записей на странице"
+// @Success 200 {object} ProductListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/overdue [get]
+func ListOverdueProductsHandler(svc *service.IssuanceService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+		if limit < 1 || limit > 30 {
+			limit = 10
+		}
+
+		userRole := helper.GetUserRole(c)
+		products, next, err := svc.ListOverdue(c.Request.Context(), c.Param("id"), c.Query("cursor"), limit, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, ProductListResponse{
+			Products:   toProductResponses(products),
+			NextCursor: next,
+		})
+	}
+}
+
+func toProductResponses(products models.ProductSlice) []ProductResponse {
+	resp := make([]ProductResponse, 0, len(products))
+	for _, p := range products {
+		resp = append(resp, toProductResponse(p))
+	}
+	return resp
+}
+
+// DTO структуры для выдачи
+type (
+	IssueRequest struct {
+		PickupCode string   `json:"pickupCode" example:"482913"`
+		ProductIDs []string `json:"productIds,omitempty"`
+	}
+
+	ReturnToSenderRequest struct {
+		ProductIDs []string `json:"productIds,omitempty"`
+	}
+
+	IssueResponse struct {
+		Products []ProductResponse `json:"products"`
+	}
+
+	ProductListResponse struct {
+		Products   []ProductResponse `json:"products"`
+		NextCursor string            `json:"nextCursor,omitempty" example:"MjAyNS0wNC0wMVQxMjozMDowMFp8NTUwZTg0MDA"`
+	}
+)
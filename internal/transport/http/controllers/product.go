@@ -1,8 +1,11 @@
 package controllers
 
 import (
+	"PVZ/internal/domain/errs"
 	"PVZ/internal/service"
+	"PVZ/models"
 	"PVZ/pkg/helper"
+	"errors"
 	"net/http"
 	"time"
 
@@ -17,8 +20,13 @@ import (
 // @Produce json
 // @Security BearerAuth
 // @Param request body AddProductRequest true "Данные товара"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ"
 // @Success 201 {object} ProductResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /products/ [post]
 func AddProductHandler(svc *service.ProductService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -27,23 +35,177 @@ func AddProductHandler(svc *service.ProductService) gin.HandlerFunc {
 			Type  string `json:"type"`
 		}
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			_ = c.Error(errs.Validation("invalid request"))
 			return
 		}
 
 		userRole := helper.GetUserRole(c)
-		product, err := svc.AddProduct(c, req.PvzID, userRole, req.Type)
+		product, warning, err := svc.AddProduct(c.Request.Context(), req.PvzID, userRole, req.Type)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			_ = c.Error(err)
 			return
 		}
 
-		c.JSON(http.StatusCreated, gin.H{
+		resp := gin.H{
 			"id":          product.ID,
 			"receptionId": product.ReceptionID,
 			"type":        product.Type,
 			"addedAt":     product.AddedAt,
-		})
+		}
+		if warning != "" {
+			resp["warning"] = warning
+		}
+		c.JSON(http.StatusCreated, resp)
+	}
+}
+
+// ScanProductHandler godoc
+// @Summary Приемка товара по штрихкоду
+// @Description Добавление отсканированного товара в активную приемку ПВЗ (только для employee). Штрихкод не может повторяться в открытых приемках
+// @Tags Products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ScanProductRequest true "Данные скана"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ"
+// @Success 201 {object} ProductResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/scan [post]
+func ScanProductHandler(svc *service.ProductService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ScanProductRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		product, warning, err := svc.ScanProduct(c.Request.Context(), req.PvzID, userRole, req.toInput())
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := toProductResponse(product)
+		resp.Warning = warning
+		c.JSON(http.StatusCreated, resp)
+	}
+}
+
+func toProductResponse(p *models.Product) ProductResponse {
+	return ProductResponse{
+		ID:          p.ID,
+		ReceptionID: p.ReceptionID,
+		Type:        p.Type,
+		AddedAt:     p.AddedAt,
+		Barcode:     p.Barcode.String,
+		SKU:         p.Sku.String,
+		OrderNumber: p.OrderNumber.String,
+		WeightGrams: p.WeightGrams.Ptr(),
+		LengthMM:    p.LengthMM.Ptr(),
+		WidthMM:     p.WidthMM.Ptr(),
+		HeightMM:    p.HeightMM.Ptr(),
+		Status:      p.Status,
+		PickupCode:  p.PickupCode.String,
+		IssuedAt:    p.IssuedAt.Ptr(),
+
+		StorageExpiresAt: p.StorageExpiresAt.Ptr(),
+
+		ReturnReason:      p.ReturnReason.String,
+		ReturnComment:     p.ReturnComment.String,
+		OriginalProductID: p.OriginalProductID.String,
+
+		Cell: cellCode(p),
+	}
+}
+
+// BatchAddProductsHandler godoc
+// @Summary Пакетное добавление товаров
+// @Description Добавление списка товаров в активную приемку ПВЗ одной транзакцией (только для employee). Если хотя бы один товар не прошёл проверку, не сохраняется ни один, а в results указано, какие товары отклонены
+// @Tags Products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body BatchProductsRequest true "Товары"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ"
+// @Success 201 {object} BatchProductsResponse
+// @Failure 400 {object} BatchProductsResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} BatchProductsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /products/batch [post]
+func BatchAddProductsHandler(svc *service.ProductService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req BatchProductsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		items := make([]service.ProductInput, 0, len(req.Products))
+		for _, p := range req.Products {
+			items = append(items, p.toInput())
+		}
+
+		userRole := helper.GetUserRole(c)
+		results, warning, err := svc.AddProducts(c.Request.Context(), req.PvzID, userRole, items)
+		if err != nil && results == nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := BatchProductsResponse{Warning: warning, Results: make([]BatchProductResult, 0, len(results))}
+		for i, r := range results {
+			item := BatchProductResult{Index: i, Status: "created"}
+			switch {
+			case r.Err != nil:
+				item.Status = "rejected"
+				var domainErr *errs.Error
+				if errors.As(r.Err, &domainErr) {
+					item.Error = domainErr.Message()
+				}
+			case r.Product == nil:
+				item.Status = "skipped"
+			default:
+				p := toProductResponse(r.Product)
+				item.Product = &p
+			}
+			resp.Results = append(resp.Results, item)
+		}
+
+		if err != nil {
+			status := http.StatusBadRequest
+			if errors.Is(err, errs.ErrConflict) {
+				status = http.StatusConflict
+			}
+			var domainErr *errs.Error
+			if errors.As(err, &domainErr) {
+				resp.Error = domainErr.Message()
+			}
+			c.JSON(status, resp)
+			return
+		}
+
+		c.JSON(http.StatusCreated, resp)
+	}
+}
+
+func (r ProductItemRequest) toInput() service.ProductInput {
+	return service.ProductInput{
+		Type:        r.Type,
+		Barcode:     r.Barcode,
+		SKU:         r.SKU,
+		OrderNumber: r.OrderNumber,
+		WeightGrams: r.WeightGrams,
+		LengthMM:    r.LengthMM,
+		WidthMM:     r.WidthMM,
+		HeightMM:    r.HeightMM,
+		Cell:        r.Cell,
 	}
 }
 
@@ -51,13 +213,68 @@ func AddProductHandler(svc *service.ProductService) gin.HandlerFunc {
 type (
 	AddProductRequest struct {
 		PvzID string `json:"pvzId" example:"1"`
-		Type  string `json:"type" example:"electronics"`
+		Type  string `json:"type" example:"электроника"`
+	}
+
+	ProductItemRequest struct {
+		Type        string `json:"type" example:"электроника"`
+		Barcode     string `json:"barcode" example:"4601234567893"`
+		SKU         string `json:"sku,omitempty" example:"PHONE-128-BLK"`
+		OrderNumber string `json:"orderNumber,omitempty" example:"ORD-2025-000123"`
+		WeightGrams *int   `json:"weightGrams,omitempty" example:"450"`
+		LengthMM    *int   `json:"lengthMm,omitempty" example:"200"`
+		WidthMM     *int   `json:"widthMm,omitempty" example:"120"`
+		HeightMM    *int   `json:"heightMm,omitempty" example:"60"`
+		Cell        string `json:"cell,omitempty" example:"A-01-03"`
+	}
+
+	ScanProductRequest struct {
+		PvzID string `json:"pvzId" example:"1"`
+		ProductItemRequest
+	}
+
+	BatchProductsRequest struct {
+		PvzID    string               `json:"pvzId" example:"1"`
+		Products []ProductItemRequest `json:"products"`
+	}
+
+	BatchProductResult struct {
+		Index   int              `json:"index" example:"0"`
+		Status  string           `json:"status" example:"created" enums:"created,rejected,skipped"`
+		Product *ProductResponse `json:"product,omitempty"`
+		Error   string           `json:"error,omitempty" example:"invalid product type"`
+	}
+
+	BatchProductsResponse struct {
+		Error   string               `json:"error,omitempty" example:"some products are invalid"`
+		Warning string               `json:"warning,omitempty" example:"PVZ capacity exceeded: 12 of 10 slots occupied"`
+		Results []BatchProductResult `json:"results"`
 	}
 
 	ProductResponse struct {
-		ID          string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-		ReceptionID string    `json:"receptionId" example:"550e8400-e29b-41d4-a716-446655440001"`
-		Type        string    `json:"type" example:"electronics"`
-		AddedAt     time.Time `json:"addedAt" example:"2023-10-01T12:00:00Z"`
+		ID          string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+		ReceptionID string     `json:"receptionId" example:"550e8400-e29b-41d4-a716-446655440001"`
+		Type        string     `json:"type" example:"электроника"`
+		AddedAt     time.Time  `json:"addedAt" example:"2023-10-01T12:00:00Z"`
+		Barcode     string     `json:"barcode,omitempty" example:"4601234567893"`
+		SKU         string     `json:"sku,omitempty" example:"PHONE-128-BLK"`
+		OrderNumber string     `json:"orderNumber,omitempty" example:"ORD-2025-000123"`
+		WeightGrams *int       `json:"weightGrams,omitempty" example:"450"`
+		LengthMM    *int       `json:"lengthMm,omitempty" example:"200"`
+		WidthMM     *int       `json:"widthMm,omitempty" example:"120"`
+		HeightMM    *int       `json:"heightMm,omitempty" example:"60"`
+		Status      string     `json:"status" example:"ready_for_pickup" enums:"received,ready_for_pickup,issued,returned"`
+		PickupCode  string     `json:"pickupCode,omitempty" example:"482913"`
+		IssuedAt    *time.Time `json:"issuedAt,omitempty" example:"2023-10-02T15:00:00Z"`
+
+		StorageExpiresAt *time.Time `json:"storageExpiresAt,omitempty" example:"2023-10-08T12:00:00Z"`
+
+		ReturnReason      string `json:"returnReason,omitempty" example:"damaged" enums:"damaged,wrong_item,not_as_described,changed_mind,other"`
+		ReturnComment     string `json:"returnComment,omitempty" example:"Треснул экран"`
+		OriginalProductID string `json:"originalProductId,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+
+		Cell string `json:"cell,omitempty" example:"A-01-03"`
+
+		Warning string `json:"warning,omitempty" example:"PVZ capacity exceeded: 11 of 10 slots occupied"`
 	}
 )
@@ -0,0 +1,166 @@
+package controllers
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/service"
+	"PVZ/models"
+	"PVZ/pkg/helper"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListProductTypesHandler godoc
+// @Summary Справочник типов товаров
+// @Description Список типов товаров: модератор видит весь справочник, employee — только активные типы
+// @Tags ProductTypes
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} ProductTypeResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /product-types/ [get]
+func ListProductTypesHandler(svc *service.ProductTypeService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		types, err := svc.List(c.Request.Context(), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := make([]ProductTypeResponse, 0, len(types))
+		for _, pt := range types {
+			resp = append(resp, toProductTypeResponse(pt))
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// CreateProductTypeHandler godoc
+// @Summary Добавление типа товара
+// @Description Добавление типа товара в справочник (только для moderator)
+// @Tags ProductTypes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ProductTypeRequest true "Тип товара"
+// @Success 201 {object} ProductTypeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /product-types/ [post]
+func CreateProductTypeHandler(svc *service.ProductTypeService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ProductTypeRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		pt, err := svc.Create(c.Request.Context(), req.Code, req.NameRu, req.NameEn, req.IsActive, req.StorageDays, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, toProductTypeResponse(pt))
+	}
+}
+
+// UpdateProductTypeHandler godoc
+// @Summary Изменение типа товара
+// @Description Изменение названий, активности и срока хранения типа товара (только для moderator). Поля необязательны: не переданное поле не меняется. Выключенный тип нельзя добавить в приемку
+// @Tags ProductTypes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param code path string true "Код типа товара"
+// @Param request body ProductTypeUpdateRequest true "Новые значения"
+// @Success 200 {object} ProductTypeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /product-types/{code} [put]
+func UpdateProductTypeHandler(svc *service.ProductTypeService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ProductTypeUpdateRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		pt, err := svc.Update(c.Request.Context(), c.Param("code"), req.NameRu, req.NameEn, req.IsActive, req.StorageDays, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, toProductTypeResponse(pt))
+	}
+}
+
+// DeleteProductTypeHandler godoc
+// @Summary Удаление типа товара
+// @Description Удаление неиспользуемого типа товара (только для moderator). Тип, который уже есть у товаров, можно только выключить
+// @Tags ProductTypes
+// @Security BearerAuth
+// @Param code path string true "Код типа товара"
+// @Success 204
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /product-types/{code} [delete]
+func DeleteProductTypeHandler(svc *service.ProductTypeService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		if err := svc.Delete(c.Request.Context(), c.Param("code"), userRole); err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func toProductTypeResponse(pt *models.ProductType) ProductTypeResponse {
+	return ProductTypeResponse{
+		Code:        pt.Code,
+		NameRu:      pt.NameRu,
+		NameEn:      pt.NameEn,
+		IsActive:    pt.IsActive,
+		StorageDays: pt.StorageDays,
+	}
+}
+
+// DTO структуры для ProductType
+type (
+	ProductTypeRequest struct {
+		Code        string `json:"code" example:"обувь"`
+		NameRu      string `json:"nameRu" example:"Обувь"`
+		NameEn      string `json:"nameEn" example:"Shoes"`
+		IsActive    *bool  `json:"isActive,omitempty" example:"true"`
+		StorageDays *int   `json:"storageDays,omitempty" example:"7"`
+	}
+
+	ProductTypeUpdateRequest struct {
+		NameRu      *string `json:"nameRu,omitempty" example:"Обувь"`
+		NameEn      *string `json:"nameEn,omitempty" example:"Shoes"`
+		IsActive    *bool   `json:"isActive,omitempty" example:"false"`
+		StorageDays *int    `json:"storageDays,omitempty" example:"14"`
+	}
+
+	ProductTypeResponse struct {
+		Code        string `json:"code" example:"обувь"`
+		NameRu      string `json:"nameRu" example:"Обувь"`
+		NameEn      string `json:"nameEn" example:"Shoes"`
+		IsActive    bool   `json:"isActive" example:"true"`
+		StorageDays int    `json:"storageDays" example:"7"`
+	}
+)
@@ -1,7 +1,9 @@
 package controllers
 
 import (
+	"PVZ/internal/domain/errs"
 	"PVZ/internal/service"
+	"PVZ/models"
 	"PVZ/pkg/helper"
 	"net/http"
 	"strconv"
@@ -21,6 +23,7 @@ import (
 // @Success 201 {object} PVZResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /pvz/ [post]
 func CreatePVZHandler(svc *service.PVZService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -29,14 +32,14 @@ func CreatePVZHandler(svc *service.PVZService) gin.HandlerFunc {
 			City string `json:"city"`
 		}
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			_ = c.Error(errs.Validation("invalid request"))
 			return
 		}
 
 		userRole := helper.GetUserRole(c)
-		pvz, err := svc.CreatePVZ(c, req.Name, req.City, userRole)
+		pvz, err := svc.CreatePVZ(c.Request.Context(), req.Name, req.City, userRole)
 		if err != nil {
-			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			_ = c.Error(err)
 			return
 		}
 
@@ -46,34 +49,211 @@ func CreatePVZHandler(svc *service.PVZService) gin.HandlerFunc {
 
 // GetPVZListHandler godoc
 // @Summary Получение списка ПВЗ
-// @Description Получение списка пунктов выдачи заказов с пагинацией и фильтрацией по городу (только для admin и moderator)
+// @Description Получение списка пунктов выдачи заказов с приёмками и товарами, пагинацией, фильтрацией по городу и дате приёмки (только для admin и moderator)
 // @Tags PVZ
 // @Produce json
 // @Security BearerAuth
 // @Param page query int false "Номер страницы"
 // @Param limit query int false "Количество записей на странице"
 // @Param city query string false "Фильтр по городу"
+// @Param startDate query string false "Начало диапазона даты приёмки (RFC3339)"
+// @Param endDate query string false "Конец диапазона даты приёмки (RFC3339)"
 // @Success 200 {object} PVZListResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /pvz/ [get]
 func GetPVZListHandler(svc *service.PVZService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userRole := c.GetString("userRole")
 
 		page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if page < 1 {
+			page = 1
+		}
 		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+		if limit < 1 || limit > 30 {
+			limit = 10
+		}
 		offset := (page - 1) * limit
 
 		city := c.Query("city")
 
-		pvzList, err := svc.GetPVZList(c, offset, limit, city, userRole)
+		startDate, err := parseDateQuery(c, "startDate")
+		if err != nil {
+			_ = c.Error(errs.Validation("invalid startDate"))
+			return
+		}
+		endDate, err := parseDateQuery(c, "endDate")
+		if err != nil {
+			_ = c.Error(errs.Validation("invalid endDate"))
+			return
+		}
+
+		pvzList, err := svc.GetPVZList(c.Request.Context(), offset, limit, city, startDate, endDate, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := PVZListResponse{
+			PVZs: make([]PVZWithReceptionsResponse, 0, len(pvzList)),
+			Page: page,
+		}
+		for _, pvz := range pvzList {
+			resp.PVZs = append(resp.PVZs, toPVZWithReceptionsResponse(pvz))
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// AssignStaffHandler godoc
+// @Summary Закрепление сотрудника за ПВЗ
+// @Description Назначение сотрудника (employee) на ПВЗ (только для moderator)
+// @Tags PVZ
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param request body StaffRequest true "Сотрудник"
+// @Success 201 {object} StaffResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/staff [post]
+func AssignStaffHandler(svc *service.PVZService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			UserID string `json:"userId"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil || req.UserID == "" {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		staff, err := svc.AssignStaff(c.Request.Context(), c.Param("id"), req.UserID, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, toStaffResponse(staff))
+	}
+}
+
+// UnassignStaffHandler godoc
+// @Summary Открепление сотрудника от ПВЗ
+// @Description Снятие сотрудника с ПВЗ (только для moderator)
+// @Tags PVZ
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param request body StaffRequest true "Сотрудник"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/staff [delete]
+func UnassignStaffHandler(svc *service.PVZService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req struct {
+			UserID string `json:"userId"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil || req.UserID == "" {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		if err := svc.UnassignStaff(c.Request.Context(), c.Param("id"), req.UserID, userRole); err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// ListStaffHandler godoc
+// @Summary Сотрудники ПВЗ
+// @Description Список сотрудников, закреплённых за ПВЗ (только для moderator)
+// @Tags PVZ
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Success 200 {array} StaffResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/staff [get]
+func ListStaffHandler(svc *service.PVZService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		staff, err := svc.ListStaff(c.Request.Context(), c.Param("id"), userRole)
 		if err != nil {
-			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			_ = c.Error(err)
 			return
 		}
 
-		c.JSON(http.StatusOK, pvzList)
+		resp := make([]StaffResponse, 0, len(staff))
+		for _, st := range staff {
+			resp = append(resp, toStaffResponse(st))
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+func toStaffResponse(st *models.PVZStaff) StaffResponse {
+	resp := StaffResponse{
+		PvzID:      st.PVZID,
+		UserID:     st.UserID,
+		AssignedBy: st.AssignedBy.String,
+		CreatedAt:  st.CreatedAt,
+	}
+	if user := st.R.GetUser(); user != nil {
+		resp.Email = user.Email
+	}
+	return resp
+}
+
+func parseDateQuery(c *gin.Context, key string) (*time.Time, error) {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil, nil
 	}
+
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func toPVZWithReceptionsResponse(pvz *models.PVZ) PVZWithReceptionsResponse {
+	resp := PVZWithReceptionsResponse{
+		PVZ: PVZResponse{
+			ID:        pvz.ID,
+			Name:      pvz.Name,
+			City:      pvz.City,
+			CreatedAt: pvz.CreatedAt,
+		},
+		Receptions: make([]ReceptionWithProducts, 0, len(pvz.R.GetReceptions())),
+	}
+
+	for _, rec := range pvz.R.GetReceptions() {
+		resp.Receptions = append(resp.Receptions, toReceptionWithProducts(rec))
+	}
+
+	return resp
 }
 
 // DTO структуры для PVZ
@@ -90,8 +270,30 @@ type (
 		CreatedAt time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
 	}
 
+	ReceptionWithProducts struct {
+		Reception ReceptionResponse `json:"reception"`
+		Products  []ProductResponse `json:"products"`
+	}
+
+	PVZWithReceptionsResponse struct {
+		PVZ        PVZResponse             `json:"pvz"`
+		Receptions []ReceptionWithProducts `json:"receptions"`
+	}
+
+	StaffRequest struct {
+		UserID string `json:"userId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	}
+
+	StaffResponse struct {
+		PvzID      int64     `json:"pvzId" example:"1"`
+		UserID     string    `json:"userId" example:"550e8400-e29b-41d4-a716-446655440000"`
+		Email      string    `json:"email,omitempty" example:"user@example.com"`
+		AssignedBy string    `json:"assignedBy,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+		CreatedAt  time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	}
+
 	PVZListResponse struct {
-		PVZs []PVZResponse `json:"pvzs"`
-		Page int           `json:"page" example:"1"`
+		PVZs []PVZWithReceptionsResponse `json:"pvzs"`
+		Page int                         `json:"page" example:"1"`
 	}
 )
@@ -1,9 +1,12 @@
 package controllers
 
 import (
+	"PVZ/internal/domain/errs"
 	"PVZ/internal/service"
+	"PVZ/models"
 	"PVZ/pkg/helper"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -17,8 +20,12 @@ import (
 // @Produce json
 // @Security BearerAuth
 // @Param request body ReceptionRequest true "Данные приемки"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ"
 // @Success 201 {object} ReceptionResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /receptions/ [post]
 func CreateReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -26,7 +33,7 @@ func CreateReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
 			PvzID string `json:"pvzId"`
 		}
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			_ = c.Error(errs.Validation("invalid request"))
 			return
 		}
 
@@ -35,7 +42,7 @@ func CreateReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
 
 		reception, err := svc.CreateReception(ctx, req.PvzID, userRole)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			_ = c.Error(err)
 			return
 		}
 
@@ -58,6 +65,9 @@ func CreateReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
 // @Param request body ReceptionRequest true "Данные для закрытия приемки"
 // @Success 200 {object} ReceptionResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /receptions/close [put]
 func CloseReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -65,14 +75,14 @@ func CloseReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
 			PvzID string `json:"pvzId"`
 		}
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			_ = c.Error(errs.Validation("invalid request"))
 			return
 		}
 
 		userRole := c.GetString("userRole")
-		reception, err := svc.CloseReception(c, req.PvzID, userRole)
+		reception, err := svc.CloseReception(c.Request.Context(), req.PvzID, userRole)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			_ = c.Error(err)
 			return
 		}
 
@@ -95,6 +105,9 @@ func CloseReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
 // @Param request body ReceptionRequest true "Данные для удаления товара"
 // @Success 200 {object} ReceptionWithProductsResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 // @Router /receptions/last-product [delete]
 func DeleteLastProductHandler(svc *service.ReceptionService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -102,27 +115,252 @@ func DeleteLastProductHandler(svc *service.ReceptionService) gin.HandlerFunc {
 			PvzID string `json:"pvzId"`
 		}
 		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			_ = c.Error(errs.Validation("invalid request"))
 			return
 		}
 
 		userRole := helper.GetUserRole(c)
-		reception, err := svc.DeleteLastProduct(c, req.PvzID, userRole)
+		reception, err := svc.DeleteLastProduct(c.Request.Context(), req.PvzID, userRole)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			_ = c.Error(err)
 			return
 		}
 
+		productIDs := make([]string, 0, len(reception.R.GetProducts()))
+		for _, p := range reception.R.GetProducts() {
+			productIDs = append(productIDs, p.ID)
+		}
+
 		c.JSON(http.StatusOK, gin.H{
 			"id":         reception.ID,
 			"pvzId":      reception.PVZID,
 			"status":     reception.Status,
 			"dateTime":   reception.DateTime,
-			"productIDs": reception.ProductIds,
+			"productIDs": productIDs,
 		})
 	}
 }
 
+// DeleteProductHandler godoc
+// @Summary Удаление товара из приемки
+// @Description Удаление конкретного товара из приемки в статусе in_progress (только для employee, закреплённого за ПВЗ). Удаление записывается в журнал
+// @Tags Receptions
+// @Security BearerAuth
+// @Param id path string true "ID приемки"
+// @Param productId path string true "ID товара"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /receptions/{id}/products/{productId} [delete]
+func DeleteProductHandler(svc *service.ReceptionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		if err := svc.DeleteProduct(c.Request.Context(), c.Param("id"), c.Param("productId"), userRole); err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// CancelReceptionHandler godoc
+// @Summary Отмена приемки
+// @Description Отмена приемки в статусе in_progress (moderator или employee, закреплённый за ПВЗ)
+// @Tags Receptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID приемки"
+// @Success 200 {object} ReceptionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /receptions/{id}/cancel [post]
+func CancelReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		reception, err := svc.CancelReception(c.Request.Context(), c.Param("id"), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, toReceptionResponse(reception))
+	}
+}
+
+// ReopenReceptionHandler godoc
+// @Summary Возврат приемки в работу
+// @Description Возврат закрытой приемки в статус in_progress (только для moderator, в течение RECEPTION_REOPEN_WINDOW после закрытия)
+// @Tags Receptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID приемки"
+// @Success 200 {object} ReceptionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /receptions/{id}/reopen [post]
+func ReopenReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		reception, err := svc.ReopenReception(c.Request.Context(), c.Param("id"), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, toReceptionResponse(reception))
+	}
+}
+
+// ReceptionHistoryHandler godoc
+// @Summary История статусов приемки
+// @Description Кто и когда менял статус приемки (moderator или employee, закреплённый за ПВЗ)
+// @Tags Receptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID приемки"
+// @Success 200 {array} StatusChangeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /receptions/{id}/history [get]
+func ReceptionHistoryHandler(svc *service.ReceptionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		history, err := svc.StatusHistory(c.Request.Context(), c.Param("id"), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := make([]StatusChangeResponse, 0, len(history))
+		for _, h := range history {
+			resp = append(resp, StatusChangeResponse{
+				FromStatus: h.FromStatus.String,
+				ToStatus:   h.ToStatus,
+				ChangedBy:  h.ChangedBy.String,
+				ChangedAt:  h.ChangedAt,
+			})
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// GetReceptionHandler godoc
+// @Summary Получение приемки
+// @Description Приемка со списком товаров в порядке добавления (moderator или employee, закреплённый за ПВЗ)
+// @Tags Receptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID приемки"
+// @Success 200 {object} ReceptionWithProducts
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /receptions/{id} [get]
+func GetReceptionHandler(svc *service.ReceptionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		reception, err := svc.GetReception(c.Request.Context(), c.Param("id"), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, toReceptionWithProducts(reception))
+	}
+}
+
+// ListPVZReceptionsHandler godoc
+// @Summary История приемок ПВЗ
+// @Description История приемок ПВЗ от новых к старым с фильтром по статусу и дате и курсорной пагинацией (moderator или employee, закреплённый за ПВЗ)
+// @Tags Receptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "ID ПВЗ"
+// @Param status query string false "Статус приемки" Enums(in_progress, closed, cancelled)
+// @Param startDate query string false "Начало диапазона (RFC3339)"
+// @Param endDate query string false "Конец диапазона (RFC3339)"
+// @Param cursor query string false "Курсор следующей страницы из nextCursor"
+// @Param limit query int false "Количество записей на странице"
+// @Success 200 {object} ReceptionListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /pvz/{id}/receptions [get]
+func ListPVZReceptionsHandler(svc *service.ReceptionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+		if limit < 1 || limit > 30 {
+			limit = 10
+		}
+
+		startDate, err := parseDateQuery(c, "startDate")
+		if err != nil {
+			_ = c.Error(errs.Validation("invalid startDate"))
+			return
+		}
+		endDate, err := parseDateQuery(c, "endDate")
+		if err != nil {
+			_ = c.Error(errs.Validation("invalid endDate"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		receptions, next, err := svc.ListReceptions(
+			c.Request.Context(), c.Param("id"), c.Query("status"),
+			startDate, endDate, c.Query("cursor"), limit, userRole,
+		)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := ReceptionListResponse{
+			Receptions: make([]ReceptionWithProducts, 0, len(receptions)),
+			NextCursor: next,
+		}
+		for _, rec := range receptions {
+			resp.Receptions = append(resp.Receptions, toReceptionWithProducts(rec))
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+func toReceptionResponse(rec *models.Reception) ReceptionResponse {
+	return ReceptionResponse{
+		ID:       rec.ID,
+		PvzID:    rec.PVZID,
+		Kind:     rec.Kind,
+		Status:   rec.Status,
+		DateTime: rec.DateTime,
+	}
+}
+
+func toReceptionWithProducts(rec *models.Reception) ReceptionWithProducts {
+	item := ReceptionWithProducts{
+		Reception: toReceptionResponse(rec),
+		Products:  make([]ProductResponse, 0, len(rec.R.GetProducts())),
+	}
+	for _, p := range rec.R.GetProducts() {
+		item.Products = append(item.Products, toProductResponse(p))
+	}
+	return item
+}
+
 // DTO структуры для Reception
 type (
 	ReceptionRequest struct {
@@ -132,15 +370,28 @@ type (
 	ReceptionResponse struct {
 		ID       string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
 		PvzID    int64     `json:"pvzId" example:"1"`
-		Status   string    `json:"status" example:"active"`
+		Kind     string    `json:"kind" example:"delivery" enums:"delivery,return"`
+		Status   string    `json:"status" example:"in_progress" enums:"in_progress,closed,cancelled"`
 		DateTime time.Time `json:"dateTime" example:"2023-10-01T12:00:00Z"`
 	}
 
 	ReceptionWithProductsResponse struct {
 		ID         string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
 		PvzID      int64     `json:"pvzId" example:"1"`
-		Status     string    `json:"status" example:"active"`
+		Status     string    `json:"status" example:"in_progress" enums:"in_progress,closed,cancelled"`
 		DateTime   time.Time `json:"dateTime" example:"2023-10-01T12:00:00Z"`
 		ProductIDs []string  `json:"productIDs" example:"[\"prod1\", \"prod2\"]"`
 	}
+
+	StatusChangeResponse struct {
+		FromStatus string    `json:"fromStatus,omitempty" example:"in_progress"`
+		ToStatus   string    `json:"toStatus" example:"closed"`
+		ChangedBy  string    `json:"changedBy,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+		ChangedAt  time.Time `json:"changedAt" example:"2023-10-01T12:00:00Z"`
+	}
+
+	ReceptionListResponse struct {
+		Receptions []ReceptionWithProducts `json:"receptions"`
+		NextCursor string                  `json:"nextCursor,omitempty" example:"MjAyNS0wNC0wMVQxMjozMDowMFp8NTUwZTg0MDA"`
+	}
 )
@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/service"
+	"PVZ/pkg/helper"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateReturnHandler godoc
+// @Summary Создание приемки возвратов
+// @Description Открывает приемку товаров, возвращенных покупателями (только для employee). Работает независимо от приемки поставки
+// @Tags Returns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ReceptionRequest true "ПВЗ"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ"
+// @Success 201 {object} ReceptionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /returns/ [post]
+func CreateReturnHandler(svc *service.ReceptionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ReceptionRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		rec, err := svc.CreateReturn(c.Request.Context(), req.PvzID, helper.GetUserRole(c))
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, toReceptionResponse(rec))
+	}
+}
+
+// AddReturnItemHandler godoc
+// @Summary Приемка возвращенного товара
+// @Description Добавляет товар в открытую приемку возвратов ПВЗ (только для employee). Исходный товар берется из originalProductId, иначе ищется среди выданных по штрихкоду, и переводится в статус returned
+// @Tags Returns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ReturnItemRequest true "Возвращенный товар"
+// @Param Idempotency-Key header string false "Ключ идемпотентности: повтор с тем же ключом вернёт исходный ответ"
+// @Success 201 {object} ProductResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /returns/items [post]
+func AddReturnItemHandler(svc *service.ProductService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ReturnItemRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		product, warning, err := svc.AddReturnItem(c.Request.Context(), req.PvzID, helper.GetUserRole(c), service.ReturnItemInput{
+			ProductInput:      req.toInput(),
+			Reason:            req.Reason,
+			Comment:           req.Comment,
+			OriginalProductID: req.OriginalProductID,
+		})
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := toProductResponse(product)
+		resp.Warning = warning
+		c.JSON(http.StatusCreated, resp)
+	}
+}
+
+// CloseReturnHandler godoc
+// @Summary Закрытие приемки возвратов
+// @Description Закрывает открытую приемку возвратов ПВЗ (только для employee)
+// @Tags Returns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ReceptionRequest true "ПВЗ"
+// @Success 200 {object} ReceptionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /returns/close [put]
+func CloseReturnHandler(svc *service.ReceptionService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req ReceptionRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		rec, err := svc.CloseReturn(c.Request.Context(), req.PvzID, helper.GetUserRole(c))
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, toReceptionResponse(rec))
+	}
+}
+
+// DTO структуры для Returns
+type (
+	ReturnItemRequest struct {
+		PvzID string `json:"pvzId" example:"1"`
+		ProductItemRequest
+		Reason            string `json:"reason" example:"damaged" enums:"damaged,wrong_item,not_as_described,changed_mind,other"`
+		Comment           string `json:"comment,omitempty" example:"Треснул экран"`
+		OriginalProductID string `json:"originalProductId,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+	}
+)
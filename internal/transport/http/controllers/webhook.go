@@ -0,0 +1,227 @@
+package controllers
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/internal/service"
+	"PVZ/models"
+	"PVZ/pkg/helper"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListWebhooksHandler godoc
+// @Summary Список вебхуков
+// @Description Список подписок на события (только для moderator)
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} WebhookResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /webhooks/ [get]
+func ListWebhooksHandler(svc *service.WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		hooks, err := svc.List(c.Request.Context(), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := make([]WebhookResponse, 0, len(hooks))
+		for _, hook := range hooks {
+			resp = append(resp, toWebhookResponse(hook))
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// CreateWebhookHandler godoc
+// @Summary Регистрация вебхука
+// @Description Подписка на события приемок (только для moderator). Без pvzId приходят события всех ПВЗ. URL не может указывать на loopback, link-local и адреса метаданных облаков. Если secret не задан, он генерируется; секрет возвращается только в ответе на создание
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body WebhookRequest true "Подписка"
+// @Success 201 {object} WebhookResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /webhooks/ [post]
+func CreateWebhookHandler(svc *service.WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req WebhookRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			return
+		}
+
+		userRole := helper.GetUserRole(c)
+		hook, err := svc.Create(c.Request.Context(), service.WebhookInput{
+			URL:        req.URL,
+			EventTypes: req.EventTypes,
+			PvzID:      req.PvzID,
+			Secret:     req.Secret,
+		}, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := toWebhookResponse(hook)
+		resp.Secret = hook.Secret
+		c.JSON(http.StatusCreated, resp)
+	}
+}
+
+// GetWebhookHandler godoc
+// @Summary Вебхук
+// @Description Подписка по ID (только для moderator)
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID вебхука"
+// @Success 200 {object} WebhookResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /webhooks/{id} [get]
+func GetWebhookHandler(svc *service.WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		hook, err := svc.Get(c.Request.Context(), c.Param("id"), userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, toWebhookResponse(hook))
+	}
+}
+
+// DeleteWebhookHandler godoc
+// @Summary Удаление вебхука
+// @Description Удаление подписки вместе с журналом доставок (только для moderator)
+// @Tags Webhooks
+// @Security BearerAuth
+// @Param id path string true "ID вебхука"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /webhooks/{id} [delete]
+func DeleteWebhookHandler(svc *service.WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userRole := helper.GetUserRole(c)
+		if err := svc.Delete(c.Request.Context(), c.Param("id"), userRole); err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// ListWebhookDeliveriesHandler godoc
+// @Summary Журнал доставок вебхука
+// @Description Последние доставки подписки от новых к старым (только для moderator)
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID вебхука"
+// @Param limit query int false "Количество записей (до 100)"
+// @Success 200 {array} WebhookDeliveryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /webhooks/{id}/deliveries [get]
+func ListWebhookDeliveriesHandler(svc *service.WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+		if limit < 1 || limit > 100 {
+			limit = 50
+		}
+
+		userRole := helper.GetUserRole(c)
+		deliveries, err := svc.ListDeliveries(c.Request.Context(), c.Param("id"), limit, userRole)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+
+		resp := make([]WebhookDeliveryResponse, 0, len(deliveries))
+		for _, d := range deliveries {
+			resp = append(resp, WebhookDeliveryResponse{
+				ID:             d.ID,
+				EventID:        d.EventID,
+				EventType:      d.EventType,
+				Status:         d.Status,
+				Attempts:       d.Attempts,
+				LastStatusCode: d.LastStatusCode.Ptr(),
+				LastError:      d.LastError.String,
+				NextAttemptAt:  d.NextAttemptAt,
+				CreatedAt:      d.CreatedAt,
+				DeliveredAt:    d.DeliveredAt.Ptr(),
+			})
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+func toWebhookResponse(hook *models.Webhook) WebhookResponse {
+	resp := WebhookResponse{
+		ID:        hook.ID,
+		URL:       hook.URL,
+		Enabled:   hook.Enabled,
+		CreatedAt: hook.CreatedAt,
+	}
+	_ = json.Unmarshal(hook.EventTypes, &resp.EventTypes)
+	if hook.PVZID.Valid {
+		resp.PvzID = strconv.FormatInt(hook.PVZID.Int64, 10)
+	}
+	return resp
+}
+
+// DTO структуры для Webhook
+type (
+	WebhookRequest struct {
+		URL        string   `json:"url" example:"https://wms.example.com/hooks/pvz"`
+		EventTypes []string `json:"eventTypes" example:"reception.closed"`
+		PvzID      string   `json:"pvzId,omitempty" example:"1"`
+		Secret     string   `json:"secret,omitempty" example:"7f9c2ba4e88f827d616045507605853e"`
+	}
+
+	WebhookResponse struct {
+		ID         string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+		URL        string    `json:"url" example:"https://wms.example.com/hooks/pvz"`
+		EventTypes []string  `json:"eventTypes" example:"reception.closed"`
+		PvzID      string    `json:"pvzId,omitempty" example:"1"`
+		Enabled    bool      `json:"enabled" example:"true"`
+		Secret     string    `json:"secret,omitempty" example:"7f9c2ba4e88f827d616045507605853e"`
+		CreatedAt  time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	}
+
+	WebhookDeliveryResponse struct {
+		ID             string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440002"`
+		EventID        string     `json:"eventId" example:"550e8400-e29b-41d4-a716-446655440003"`
+		EventType      string     `json:"eventType" example:"reception.closed"`
+		Status         string     `json:"status" example:"delivered" enums:"pending,delivered,failed"`
+		Attempts       int        `json:"attempts" example:"1"`
+		LastStatusCode *int       `json:"lastStatusCode,omitempty" example:"200"`
+		LastError      string     `json:"lastError,omitempty" example:"unexpected status 503"`
+		NextAttemptAt  time.Time  `json:"nextAttemptAt" example:"2023-10-01T12:00:10Z"`
+		CreatedAt      time.Time  `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+		DeliveredAt    *time.Time `json:"deliveredAt,omitempty" example:"2023-10-01T12:00:01Z"`
+	}
+)
@@ -1,14 +1,20 @@
 package middleware
 
 import (
-	"PVZ/internal/transport/http/controllers"
+	"PVZ/pkg/auth"
 	"PVZ/pkg/helper"
+	"context"
+	"log/slog"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
-func JWTMiddleware(jwtKey []byte) gin.HandlerFunc {
+type RevocationList interface {
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+func JWTMiddleware(jwtKey []byte, revocations RevocationList) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		tokenString := c.GetHeader("Authorization")
 		if tokenString == "" {
@@ -16,13 +22,26 @@ func JWTMiddleware(jwtKey []byte) gin.HandlerFunc {
 			return
 		}
 
-		role, err := controllers.ParseJWT(tokenString, jwtKey)
+		claims, err := auth.ParseJWT(tokenString, jwtKey)
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
 			return
 		}
 
-		helper.SetUserRole(c, role)
+		if claims.Id != "" {
+			revoked, err := revocations.IsRevoked(c.Request.Context(), claims.Id)
+			if err != nil {
+				slog.Error("Failed to check token revocation", "jti", claims.Id, "err", err)
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "internal error"})
+				return
+			}
+			if revoked {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token revoked"})
+				return
+			}
+		}
+
+		helper.SetPrincipal(c, auth.PrincipalFromClaims(claims))
 
 		c.Next()
 	}
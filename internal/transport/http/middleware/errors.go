@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"PVZ/internal/domain/errs"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorMiddleware превращает ошибку, положенную хендлером через c.Error,
+// в HTTP-статус и тело {"error": "..."}.
+func ErrorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		status := StatusFromError(err)
+
+		msg := errs.ErrInternal.Error()
+		var domainErr *errs.Error
+		if errors.As(err, &domainErr) {
+			msg = domainErr.Message()
+		}
+
+		if status == http.StatusInternalServerError {
+			slog.Error("Request failed", "method", c.Request.Method, "path", c.FullPath(), "err", err)
+		}
+
+		c.JSON(status, gin.H{"error": msg})
+	}
+}
+
+func StatusFromError(err error) int {
+	switch errs.Kind(err) {
+	case errs.ErrValidation:
+		return http.StatusBadRequest
+	case errs.ErrUnauthorized:
+		return http.StatusUnauthorized
+	case errs.ErrForbidden:
+		return http.StatusForbidden
+	case errs.ErrNotFound:
+		return http.StatusNotFound
+	case errs.ErrConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"PVZ/internal/domain/errs"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestErrorMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		message string
+	}{
+		{"validation", errs.Validation("invalid city"), http.StatusBadRequest, "invalid city"},
+		{"unauthorized", errs.Unauthorized("invalid email or password"), http.StatusUnauthorized, "invalid email or password"},
+		{"forbidden", errs.Forbidden("access denied"), http.StatusForbidden, "access denied"},
+		{"not found", errs.NotFound("no active reception"), http.StatusNotFound, "no active reception"},
+		{"conflict", errs.Conflict("there is already an active reception"), http.StatusConflict, "there is already an active reception"},
+		{"internal", errs.Internal("failed to create PVZ", errors.New("connection refused")), http.StatusInternalServerError, "failed to create PVZ"},
+		{"unknown", errors.New("pq: relation does not exist"), http.StatusInternalServerError, "internal error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(ErrorMiddleware())
+			r.GET("/", func(c *gin.Context) {
+				_ = c.Error(tt.err)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d", w.Code, tt.status)
+			}
+
+			var body struct {
+				Error string `json:"error"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body.Error != tt.message {
+				t.Fatalf("error = %q, want %q", body.Error, tt.message)
+			}
+		})
+	}
+}
+
+func TestErrorMiddleware_WrappedDomainErrorKeepsKind(t *testing.T) {
+	err := errs.Wrap(errs.Conflict("there is already an active reception"), "failed to create reception")
+	if got := StatusFromError(err); got != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", got, http.StatusConflict)
+	}
+}
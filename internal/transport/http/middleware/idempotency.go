@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"PVZ/pkg/auth"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLen     = 255
+)
+
+type IdempotencyStore interface {
+	Acquire(ctx context.Context, userID, key, requestHash string, now, lockedUntil, expiresAt time.Time) (*models.IdempotencyKey, bool, error)
+	Complete(ctx context.Context, rec *models.IdempotencyKey) error
+	Release(ctx context.Context, rec *models.IdempotencyKey) error
+}
+
+// IdempotencyMiddleware выполняет запрос с заголовком Idempotency-Key один
+// раз: повтор с тем же ключом и телом получает сохранённый ответ, а с другим
+// телом — 409. Ключи действуют ttl и у каждого пользователя свои. Сохраняются
+// только успешные (2xx) ответы: после любого другого ответа или паники ключ
+// освобождается и повтор выполняется заново. Пока запрос выполняется, ключ
+// занят не дольше lockTTL — иначе его навсегда заблокировал бы упавший
+// процесс. Запросы без заголовка проходят как обычно.
+func IdempotencyMiddleware(store IdempotencyStore, ttl, lockTTL time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			_ = c.Error(errs.Validation("idempotency key is too long"))
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			_ = c.Error(errs.Validation("invalid request"))
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		hash := requestHash(c.Request, body)
+		now := time.Now()
+		rec, acquired, err := store.Acquire(ctx, auth.UserIDFromContext(ctx), key, hash, now, now.Add(lockTTL), now.Add(ttl))
+		if err != nil {
+			_ = c.Error(errs.Wrap(err, "failed to check idempotency key"))
+			c.Abort()
+			return
+		}
+
+		if !acquired {
+			replay(c, rec, hash)
+			return
+		}
+
+		// Ответ уже отправлен, поэтому результат сохраняется, даже если
+		// клиент успел отключиться.
+		ctx = context.WithoutCancel(ctx)
+
+		// Паника в обработчике дальше разбирается gin.Recovery, но ключ
+		// освобождается сразу, а не по истечении lockTTL.
+		defer func() {
+			if p := recover(); p != nil {
+				release(ctx, store, rec)
+				panic(p)
+			}
+		}()
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		// Ошибки из c.Error превращаются в ответ уже после этого middleware,
+		// поэтому статус recorder'а их не отражает.
+		status := recorder.Status()
+		if len(c.Errors) > 0 || status < http.StatusOK || status >= http.StatusMultipleChoices {
+			release(ctx, store, rec)
+			return
+		}
+
+		rec.StatusCode = null.IntFrom(status)
+		rec.ContentType = null.StringFrom(recorder.Header().Get("Content-Type"))
+		rec.ResponseBody = null.BytesFrom(recorder.body.Bytes())
+		if err := store.Complete(ctx, rec); err != nil {
+			slog.Error("Failed to save idempotent response", "key", key, "err", err)
+		}
+	}
+}
+
+func release(ctx context.Context, store IdempotencyStore, rec *models.IdempotencyKey) {
+	if err := store.Release(ctx, rec); err != nil {
+		slog.Error("Failed to release idempotency key", "key", rec.Key, "err", err)
+	}
+}
+
+func replay(c *gin.Context, rec *models.IdempotencyKey, hash string) {
+	switch {
+	case rec.RequestHash != hash:
+		_ = c.Error(errs.Conflict("idempotency key is already used for a different request"))
+		c.Abort()
+	case !rec.StatusCode.Valid:
+		_ = c.Error(errs.Conflict("request with this idempotency key is still in progress"))
+		c.Abort()
+	default:
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Data(rec.StatusCode.Int, rec.ContentType.String, rec.ResponseBody.Bytes)
+		c.Abort()
+	}
+}
+
+// requestHash связывает ключ с методом, путём и телом запроса.
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder копирует тело ответа, чтобы сохранить его для повторов.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,223 @@
+package middleware
+
+import (
+	"PVZ/internal/domain/errs"
+	"PVZ/models"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type fakeIdempotencyStore struct {
+	mu   sync.Mutex
+	keys map[string]*models.IdempotencyKey
+}
+
+func newFakeIdempotencyStore() *fakeIdempotencyStore {
+	return &fakeIdempotencyStore{keys: map[string]*models.IdempotencyKey{}}
+}
+
+func (s *fakeIdempotencyStore) Acquire(ctx context.Context, userID, key, requestHash string, now, lockedUntil, expiresAt time.Time) (*models.IdempotencyKey, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if rec, ok := s.keys[userID+"/"+key]; ok && rec.ExpiresAt.After(now) && (rec.StatusCode.Valid || rec.LockedUntil.After(now)) {
+		copied := *rec
+		return &copied, false, nil
+	}
+	rec := &models.IdempotencyKey{UserID: userID, Key: key, RequestHash: requestHash, CreatedAt: now, LockedUntil: lockedUntil, ExpiresAt: expiresAt}
+	s.keys[userID+"/"+key] = rec
+	copied := *rec
+	return &copied, true, nil
+}
+
+func (s *fakeIdempotencyStore) Complete(ctx context.Context, rec *models.IdempotencyKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copied := *rec
+	s.keys[rec.UserID+"/"+rec.Key] = &copied
+	return nil
+}
+
+func (s *fakeIdempotencyStore) Release(ctx context.Context, rec *models.IdempotencyKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, rec.UserID+"/"+rec.Key)
+	return nil
+}
+
+func idempotentRouter(store IdempotencyStore, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.Use(ErrorMiddleware())
+	r.Use(gin.CustomRecovery(func(c *gin.Context, _ any) {
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}))
+	r.POST("/products", IdempotencyMiddleware(store, time.Hour, time.Minute), handler)
+	return r
+}
+
+func postProduct(r http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/products", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestIdempotencyMiddlewareReplaysResponse(t *testing.T) {
+	calls := 0
+	r := idempotentRouter(newFakeIdempotencyStore(), func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{"call": calls})
+	})
+
+	first := postProduct(r, "k1", `{"type":"обувь"}`)
+	retry := postProduct(r, "k1", `{"type":"обувь"}`)
+
+	if calls != 1 {
+		t.Fatalf("handler calls = %d, want 1", calls)
+	}
+	if retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() {
+		t.Fatalf("retry = %d %s, want %d %s", retry.Code, retry.Body, first.Code, first.Body)
+	}
+	if retry.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Fatal("replayed response must be marked")
+	}
+	if ct := retry.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Fatalf("content type = %q", ct)
+	}
+}
+
+func TestIdempotencyMiddlewareRejectsDifferentBody(t *testing.T) {
+	r := idempotentRouter(newFakeIdempotencyStore(), func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+
+	postProduct(r, "k1", `{"type":"обувь"}`)
+	w := postProduct(r, "k1", `{"type":"одежда"}`)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusConflict)
+	}
+}
+
+func TestIdempotencyMiddlewareInProgress(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	r := idempotentRouter(store, func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+
+	hash := requestHash(httptest.NewRequest(http.MethodPost, "/products", nil), []byte(`{}`))
+	now := time.Now()
+	if _, _, err := store.Acquire(context.Background(), "", "k1", hash, now, now.Add(time.Minute), now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if w := postProduct(r, "k1", `{}`); w.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusConflict)
+	}
+}
+
+func TestIdempotencyMiddlewareReacquiresLapsedLock(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	r := idempotentRouter(store, func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+
+	// Ключ занял запрос, процесс которого упал, не освободив его.
+	hash := requestHash(httptest.NewRequest(http.MethodPost, "/products", nil), []byte(`{}`))
+	past := time.Now().Add(-2 * time.Minute)
+	if _, _, err := store.Acquire(context.Background(), "", "k1", hash, past, past.Add(time.Minute), past.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if w := postProduct(r, "k1", `{}`); w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusCreated)
+	}
+}
+
+func TestIdempotencyMiddlewareReleasesKeyOnError(t *testing.T) {
+	calls := 0
+	r := idempotentRouter(newFakeIdempotencyStore(), func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			_ = c.Error(errs.NotFound("no active reception found"))
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+
+	if w := postProduct(r, "k1", `{}`); w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	if w := postProduct(r, "k1", `{}`); w.Code != http.StatusCreated {
+		t.Fatalf("retry status = %d, want %d", w.Code, http.StatusCreated)
+	}
+	if calls != 2 {
+		t.Fatalf("handler calls = %d, want 2", calls)
+	}
+}
+
+func TestIdempotencyMiddlewareReleasesKeyOnWrittenClientError(t *testing.T) {
+	calls := 0
+	r := idempotentRouter(newFakeIdempotencyStore(), func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			c.JSON(http.StatusConflict, gin.H{"error": "some products have duplicate barcodes"})
+			return
+		}
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+
+	if w := postProduct(r, "k1", `{}`); w.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusConflict)
+	}
+	if w := postProduct(r, "k1", `{}`); w.Code != http.StatusCreated {
+		t.Fatalf("retry status = %d, want %d", w.Code, http.StatusCreated)
+	}
+}
+
+func TestIdempotencyMiddlewareReleasesKeyOnPanic(t *testing.T) {
+	calls := 0
+	r := idempotentRouter(newFakeIdempotencyStore(), func(c *gin.Context) {
+		calls++
+		if calls == 1 {
+			panic("boom")
+		}
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+
+	if w := postProduct(r, "k1", `{}`); w.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+	if w := postProduct(r, "k1", `{}`); w.Code != http.StatusCreated {
+		t.Fatalf("retry status = %d, want %d", w.Code, http.StatusCreated)
+	}
+}
+
+func TestIdempotencyMiddlewareWithoutKey(t *testing.T) {
+	calls := 0
+	r := idempotentRouter(newFakeIdempotencyStore(), func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusCreated, gin.H{})
+	})
+
+	postProduct(r, "", `{}`)
+	postProduct(r, "", `{}`)
+
+	if calls != 2 {
+		t.Fatalf("handler calls = %d, want 2", calls)
+	}
+}
@@ -20,6 +20,6 @@ func LoggerMiddleware() gin.HandlerFunc {
 
 		status := c.Writer.Status()
 		duration := time.Since(start)
-		slog.Info("[HTTP] %s %s -> %d (%s)", method, path, status, duration)
+		slog.Info("[HTTP]", "method", method, "path", path, "status", status, "duration", duration)
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"PVZ/internal/transport/http/middleware"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -16,14 +17,25 @@ func SetupRouter(
 	receptionService *service.ReceptionService,
 	pvzService *service.PVZService,
 	productService *service.ProductService,
+	productTypeService *service.ProductTypeService,
+	cityService *service.CityService,
+	webhookService *service.WebhookService,
+	eventStreamService *service.EventStreamService,
+	issuanceService *service.IssuanceService,
+	cellService *service.CellService,
 	userService *service.UserService,
 	jwtKey []byte,
+	revocations middleware.RevocationList,
+	idempotencyKeys middleware.IdempotencyStore,
+	idempotencyTTL time.Duration,
+	idempotencyLockTTL time.Duration,
 	logger *slog.Logger,
 ) *gin.Engine {
 
 	r := gin.Default()
 
 	r.Use(middleware.PrometheusMetricsMiddleware())
+	r.Use(middleware.ErrorMiddleware())
 
 	r.GET("/health", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"status": "ok"})
@@ -35,33 +47,120 @@ func SetupRouter(
 		auth.POST("/register", authHandler.Register)
 		auth.POST("/login", authHandler.Login)
 		auth.POST("/dummy", authHandler.DummyLogin)
+		auth.POST("/refresh", authHandler.Refresh)
+		auth.POST("/logout", middleware.JWTMiddleware(jwtKey, revocations), authHandler.Logout)
 	}
 
 	r.GET("/metrics", gin.WrapH(controllers.MetricsHandler()))
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	api := r.Group("/")
-	api.Use(middleware.JWTMiddleware(jwtKey))
+	api.Use(middleware.JWTMiddleware(jwtKey, revocations))
+	idempotent := middleware.IdempotencyMiddleware(idempotencyKeys, idempotencyTTL, idempotencyLockTTL)
 	{
 		pvz := api.Group("/pvz")
 		pvz.Use(middleware.RoleMiddleware("admin", "moderator"))
 		{
 			pvz.POST("/", controllers.CreatePVZHandler(pvzService))
 			pvz.GET("/", controllers.GetPVZListHandler(pvzService))
+			pvz.GET("/:id/staff", controllers.ListStaffHandler(pvzService))
+			pvz.POST("/:id/staff", controllers.AssignStaffHandler(pvzService))
+			pvz.DELETE("/:id/staff", controllers.UnassignStaffHandler(pvzService))
+			pvz.PUT("/:id/capacity", controllers.SetCapacityHandler(pvzService))
+			pvz.POST("/:id/cells", controllers.CreateCellHandler(cellService))
+			pvz.DELETE("/:id/cells/:code", controllers.DeleteCellHandler(cellService))
 		}
+		api.GET("/pvz/:id/occupancy",
+			middleware.RoleMiddleware("employee", "moderator"),
+			controllers.PVZOccupancyHandler(pvzService),
+		)
+		api.GET("/pvz/:id/cells",
+			middleware.RoleMiddleware("employee", "moderator"),
+			controllers.ListCellsHandler(cellService),
+		)
+		api.GET("/pvz/:id/receptions",
+			middleware.RoleMiddleware("employee", "moderator"),
+			controllers.ListPVZReceptionsHandler(receptionService),
+		)
+		api.POST("/pvz/:id/issue",
+			middleware.RoleMiddleware("employee"),
+			idempotent,
+			controllers.IssueProductsHandler(issuanceService),
+		)
+		api.POST("/pvz/:id/return-to-sender",
+			middleware.RoleMiddleware("employee"),
+			idempotent,
+			controllers.ReturnToSenderHandler(issuanceService),
+		)
+		api.GET("/pvz/:id/products",
+			middleware.RoleMiddleware("employee", "moderator"),
+			controllers.ListPVZProductsHandler(issuanceService),
+		)
+		api.GET("/pvz/:id/overdue",
+			middleware.RoleMiddleware("employee", "moderator"),
+			controllers.ListOverdueProductsHandler(issuanceService),
+		)
+		api.GET("/pvz/:id/events",
+			middleware.RoleMiddleware("employee", "moderator"),
+			controllers.PVZEventsHandler(eventStreamService),
+		)
 
 		reception := api.Group("/receptions")
 		reception.Use(middleware.RoleMiddleware("employee", "moderator"))
 		{
-			reception.POST("/", controllers.CreateReceptionHandler(receptionService))
+			reception.POST("/", idempotent, controllers.CreateReceptionHandler(receptionService))
+			reception.GET("/:id", controllers.GetReceptionHandler(receptionService))
 			reception.PUT("/close", controllers.CloseReceptionHandler(receptionService))
 			reception.DELETE("/last-product", controllers.DeleteLastProductHandler(receptionService))
+			reception.DELETE("/:id/products/:productId", controllers.DeleteProductHandler(receptionService))
+			reception.POST("/:id/cancel", controllers.CancelReceptionHandler(receptionService))
+			reception.POST("/:id/reopen", controllers.ReopenReceptionHandler(receptionService))
+			reception.GET("/:id/history", controllers.ReceptionHistoryHandler(receptionService))
 		}
 
 		product := api.Group("/products")
 		product.Use(middleware.RoleMiddleware("employee", "moderator"))
 		{
-			product.POST("/", controllers.AddProductHandler(productService))
+			product.POST("/", idempotent, controllers.AddProductHandler(productService))
+			product.POST("/scan", idempotent, controllers.ScanProductHandler(productService))
+			product.POST("/batch", idempotent, controllers.BatchAddProductsHandler(productService))
+			product.POST("/:id/move", controllers.MoveProductHandler(cellService))
+		}
+
+		returns := api.Group("/returns")
+		returns.Use(middleware.RoleMiddleware("employee"))
+		{
+			returns.POST("/", idempotent, controllers.CreateReturnHandler(receptionService))
+			returns.POST("/items", idempotent, controllers.AddReturnItemHandler(productService))
+			returns.PUT("/close", controllers.CloseReturnHandler(receptionService))
+		}
+
+		productTypes := api.Group("/product-types")
+		productTypes.Use(middleware.RoleMiddleware("employee", "moderator"))
+		{
+			productTypes.GET("/", controllers.ListProductTypesHandler(productTypeService))
+			productTypes.POST("/", controllers.CreateProductTypeHandler(productTypeService))
+			productTypes.PUT("/:code", controllers.UpdateProductTypeHandler(productTypeService))
+			productTypes.DELETE("/:code", controllers.DeleteProductTypeHandler(productTypeService))
+		}
+
+		cities := api.Group("/cities")
+		cities.Use(middleware.RoleMiddleware("employee", "moderator"))
+		{
+			cities.GET("/", controllers.ListCitiesHandler(cityService))
+			cities.POST("/", controllers.CreateCityHandler(cityService))
+			cities.PUT("/:id", controllers.UpdateCityHandler(cityService))
+			cities.DELETE("/:id", controllers.DeleteCityHandler(cityService))
+		}
+
+		webhooks := api.Group("/webhooks")
+		webhooks.Use(middleware.RoleMiddleware("moderator"))
+		{
+			webhooks.GET("/", controllers.ListWebhooksHandler(webhookService))
+			webhooks.POST("/", controllers.CreateWebhookHandler(webhookService))
+			webhooks.GET("/:id", controllers.GetWebhookHandler(webhookService))
+			webhooks.DELETE("/:id", controllers.DeleteWebhookHandler(webhookService))
+			webhooks.GET("/:id/deliveries", controllers.ListWebhookDeliveriesHandler(webhookService))
 		}
 	}
 
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+)
+
+// ErrInternalAddress — доставка направлена на сам сервер или в его окружение.
+var ErrInternalAddress = errors.New("webhook address is internal")
+
+// metadataHosts — сервисы метаданных облаков, которые не покрываются
+// проверками на loopback, частные и link-local сети.
+var metadataHosts = map[string]bool{
+	"metadata":                 true,
+	"metadata.google.internal": true,
+	"100.100.100.200":          true,
+}
+
+// IsInternalAddr сообщает, что адрес ведёт внутрь окружения сервиса:
+// loopback, частные сети (RFC 1918, fc00::/7), link-local (в том числе
+// 169.254.169.254) и адреса метаданных облаков.
+func IsInternalAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsUnspecified() || addr.IsMulticast() ||
+		metadataHosts[addr.String()]
+}
+
+// IsInternalHost проверяет хост из URL без обращения к DNS: localhost,
+// имена сервисов метаданных и IP-адреса из IsInternalAddr. Имена, которые
+// только резолвятся во внутренние адреса, ловит Sender при подключении.
+func IsInternalHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || metadataHosts[host] {
+		return true
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return IsInternalAddr(addr)
+	}
+	return false
+}
+
+// denyInternal — net.Dialer.Control: проверяет уже разрешённый адрес, поэтому
+// имя, которое резолвится во внутреннюю сеть, не проходит.
+func denyInternal(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || IsInternalAddr(addr) {
+		return ErrInternalAddress
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Заголовки запроса доставки. Получатель проверяет подпись, пересчитав
+// HMAC-SHA256 от "<timestamp>.<body>" своим секретом.
+const (
+	SignatureHeader = "X-PVZ-Signature"
+	TimestampHeader = "X-PVZ-Timestamp"
+	EventHeader     = "X-PVZ-Event"
+	DeliveryHeader  = "X-PVZ-Delivery"
+)
+
+// maxResponseBody — сколько байт ответа читается, чтобы переиспользовать соединение.
+const maxResponseBody = 64 << 10
+
+// Sign возвращает значение заголовка подписи.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись за постоянное время.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID string
+	EventType  string
+	Body       []byte
+}
+
+type Sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSender создаёт отправителя. Без allowInternal соединения с внутренними
+// адресами (IsInternalAddr) запрещены; разрешать их стоит только при
+// локальной разработке. Редиректы не выполняются: ответ 3xx считается
+// неуспешной доставкой, иначе получатель мог бы перенаправить запрос внутрь.
+func NewSender(timeout time.Duration, allowInternal bool) *Sender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowInternal {
+		dialer.Control = denyInternal
+	}
+
+	// Прокси не используется: с ним проверялся бы адрес прокси, а не получателя.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	client := &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return &Sender{client: client, now: time.Now}
+}
+
+// Send отправляет подписанный POST. Успех — любой ответ 2xx; код ответа
+// возвращается и при ошибке, если он был получен.
+func (s *Sender) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	ts := s.now().Unix()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "PVZ-Webhooks/1.0")
+	httpReq.Header.Set(EventHeader, req.EventType)
+	httpReq.Header.Set(DeliveryHeader, req.DeliveryID)
+	httpReq.Header.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, ts, req.Body))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSender_SignsRequest(t *testing.T) {
+	const secret = "0123456789abcdef"
+	body := []byte(`{"id":"e1","type":"reception.closed"}`)
+
+	var got *http.Request
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	sender := NewSender(time.Second, true)
+	sender.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	code, err := sender.Send(context.Background(), Request{
+		URL:        srv.URL,
+		Secret:     secret,
+		DeliveryID: "d1",
+		EventType:  "reception.closed",
+		Body:       body,
+	})
+	if err != nil || code != http.StatusNoContent {
+		t.Fatalf("send: code=%d err=%v", code, err)
+	}
+
+	if got.Header.Get(EventHeader) != "reception.closed" || got.Header.Get(DeliveryHeader) != "d1" {
+		t.Fatalf("unexpected headers: %v", got.Header)
+	}
+	if string(gotBody) != string(body) {
+		t.Fatalf("body = %s", gotBody)
+	}
+
+	ts, err := strconv.ParseInt(got.Header.Get(TimestampHeader), 10, 64)
+	if err != nil || ts != 1700000000 {
+		t.Fatalf("timestamp header = %q", got.Header.Get(TimestampHeader))
+	}
+	if !Verify(secret, ts, gotBody, got.Header.Get(SignatureHeader)) {
+		t.Fatalf("signature does not verify: %q", got.Header.Get(SignatureHeader))
+	}
+	if Verify("another-secret-1234", ts, gotBody, got.Header.Get(SignatureHeader)) {
+		t.Fatalf("signature verified with a wrong secret")
+	}
+}
+
+func TestSender_Non2xxIsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	code, err := NewSender(time.Second, true).Send(context.Background(), Request{URL: srv.URL, Secret: "s", Body: []byte(`{}`)})
+	if err == nil || code != http.StatusServiceUnavailable {
+		t.Fatalf("expected error with 503, got code=%d err=%v", code, err)
+	}
+}
+
+func TestSender_RejectsHostResolvingToInternal(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	// hook.example.com "резолвится" в адрес тестового сервера на 127.0.0.1,
+	// как это делают имена вроде 127.0.0.1.nip.io.
+	sender := NewSender(time.Second, false)
+	transport := sender.client.Transport.(*http.Transport)
+	dial := transport.DialContext
+	transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if addr == "hook.example.com:80" {
+			addr = srv.Listener.Addr().String()
+		}
+		return dial(ctx, network, addr)
+	}
+
+	_, err := sender.Send(context.Background(), Request{URL: "http://hook.example.com/hook", Secret: "s", Body: []byte(`{}`)})
+	if !errors.Is(err, ErrInternalAddress) {
+		t.Fatalf("expected internal address error, got %v", err)
+	}
+	if called {
+		t.Fatal("request reached an internal address")
+	}
+}
+
+func TestSender_DoesNotFollowRedirects(t *testing.T) {
+	internalCalled := false
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		internalCalled = true
+	}))
+	defer internal.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL+"/latest/meta-data", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	// Внутренние адреса разрешены, чтобы достучаться до тестового сервера:
+	// редирект не выполняется независимо от этой настройки.
+	code, err := NewSender(time.Second, true).Send(context.Background(), Request{URL: srv.URL, Secret: "s", Body: []byte(`{}`)})
+	if err == nil || code != http.StatusFound {
+		t.Fatalf("expected error with 302, got code=%d err=%v", code, err)
+	}
+	if internalCalled {
+		t.Fatal("redirect was followed")
+	}
+}
+
+func TestIsInternalHost(t *testing.T) {
+	for _, host := range []string{"localhost", "api.localhost.", "127.0.0.1", "::1", "10.1.2.3", "172.20.0.1", "192.168.0.1", "169.254.169.254", "fd00:ec2::254", "metadata.google.internal", "::ffff:10.0.0.1"} {
+		if !IsInternalHost(host) {
+			t.Errorf("%s must be internal", host)
+		}
+	}
+	for _, host := range []string{"wms.example.com", "8.8.8.8", "2001:4860:4860::8888"} {
+		if IsInternalHost(host) {
+			t.Errorf("%s must not be internal", host)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"PVZ/internal/service"
+	"PVZ/pkg/metrics"
+	"context"
+	"log/slog"
+	"time"
+)
+
+const (
+	AutoCloseModeClose = "close"
+	AutoCloseModeFlag  = "flag"
+
+	// autoCloseBatchSize — сколько приёмок обрабатывается за один проход.
+	autoCloseBatchSize = 100
+)
+
+type LeaderElector interface {
+	TryAcquire(ctx context.Context) (bool, error)
+	Release(ctx context.Context) error
+}
+
+type StaleReceptionCloser interface {
+	CloseStaleReceptions(ctx context.Context, olderThan time.Time, limit int, flagOnly bool) (service.StaleReceptions, error)
+}
+
+type AutoCloseSettings struct {
+	Mode     string
+	MaxAge   time.Duration
+	Interval time.Duration
+}
+
+// AutoCloser периодически закрывает приёмки, забытые в статусе in_progress:
+// пока такая приёмка открыта, ПВЗ не может начать новую. Работает только на
+// реплике, которая держит блокировку лидера.
+type AutoCloser struct {
+	leader     LeaderElector
+	receptions StaleReceptionCloser
+	settings   AutoCloseSettings
+	now        func() time.Time
+}
+
+func NewAutoCloser(leader LeaderElector, receptions StaleReceptionCloser, settings AutoCloseSettings) *AutoCloser {
+	return &AutoCloser{
+		leader:     leader,
+		receptions: receptions,
+		settings:   settings,
+		now:        time.Now,
+	}
+}
+
+// Run выполняет проходы до отмены ctx, после чего отдаёт лидерство.
+func (w *AutoCloser) Run(ctx context.Context) {
+	slog.Info("Auto-close worker started", "mode", w.settings.Mode, "maxAge", w.settings.MaxAge, "interval", w.settings.Interval)
+
+	ticker := time.NewTicker(w.settings.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.resign()
+			slog.Info("Auto-close worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *AutoCloser) tick(ctx context.Context) {
+	leader, err := w.leader.TryAcquire(ctx)
+	if err != nil {
+		slog.Error("Failed to acquire auto-close leader lock", "err", err)
+	}
+	if !leader {
+		metrics.AutoCloseLeader.Set(0)
+		return
+	}
+	metrics.AutoCloseLeader.Set(1)
+
+	flagOnly := w.settings.Mode == AutoCloseModeFlag
+	res, err := w.receptions.CloseStaleReceptions(ctx, w.now().Add(-w.settings.MaxAge), autoCloseBatchSize, flagOnly)
+
+	metrics.ReceptionAutoClosed.Add(float64(res.Closed))
+	metrics.ReceptionStale.Set(float64(len(res.Stale) - res.Closed))
+
+	if flagOnly {
+		for _, rec := range res.Stale {
+			slog.Warn("Stale reception", "id", rec.ID, "pvzID", rec.PVZID, "openedAt", rec.DateTime)
+		}
+	}
+
+	if err != nil {
+		metrics.AutoCloseRuns.WithLabelValues("error").Inc()
+		slog.Error("Auto-close run failed", "closed", res.Closed, "err", err)
+		return
+	}
+
+	metrics.AutoCloseRuns.WithLabelValues("success").Inc()
+	if res.Closed > 0 {
+		slog.Info("Stale receptions auto-closed", "closed", res.Closed)
+	}
+}
+
+func (w *AutoCloser) resign() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := w.leader.Release(ctx); err != nil {
+		slog.Warn("Failed to release auto-close leader lock", "err", err)
+	}
+	metrics.AutoCloseLeader.Set(0)
+}
@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"PVZ/internal/service"
+	"PVZ/models"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeLeader struct {
+	leader   bool
+	err      error
+	released bool
+}
+
+func (l *fakeLeader) TryAcquire(ctx context.Context) (bool, error) {
+	return l.leader, l.err
+}
+
+func (l *fakeLeader) Release(ctx context.Context) error {
+	l.released = true
+	return nil
+}
+
+type fakeCloser struct {
+	calls     int
+	olderThan time.Time
+	flagOnly  bool
+}
+
+func (c *fakeCloser) CloseStaleReceptions(ctx context.Context, olderThan time.Time, limit int, flagOnly bool) (service.StaleReceptions, error) {
+	c.calls++
+	c.olderThan = olderThan
+	c.flagOnly = flagOnly
+	return service.StaleReceptions{Stale: models.ReceptionSlice{{ID: "r1"}}, Closed: 1}, nil
+}
+
+func newTestAutoCloser(leader *fakeLeader, closer *fakeCloser, mode string) *AutoCloser {
+	w := NewAutoCloser(leader, closer, AutoCloseSettings{Mode: mode, MaxAge: 12 * time.Hour, Interval: time.Minute})
+	w.now = func() time.Time { return time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC) }
+	return w
+}
+
+func TestAutoCloser_OnlyLeaderRuns(t *testing.T) {
+	closer := &fakeCloser{}
+
+	newTestAutoCloser(&fakeLeader{leader: false}, closer, AutoCloseModeClose).tick(context.Background())
+	newTestAutoCloser(&fakeLeader{err: errors.New("db down")}, closer, AutoCloseModeClose).tick(context.Background())
+	if closer.calls != 0 {
+		t.Fatalf("follower must not close receptions, got %d calls", closer.calls)
+	}
+
+	newTestAutoCloser(&fakeLeader{leader: true}, closer, AutoCloseModeClose).tick(context.Background())
+	if closer.calls != 1 {
+		t.Fatalf("leader must run once, got %d calls", closer.calls)
+	}
+	if want := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC); !closer.olderThan.Equal(want) {
+		t.Fatalf("olderThan = %v, want %v", closer.olderThan, want)
+	}
+	if closer.flagOnly {
+		t.Fatalf("close mode must not be flag-only")
+	}
+}
+
+func TestAutoCloser_FlagMode(t *testing.T) {
+	closer := &fakeCloser{}
+	newTestAutoCloser(&fakeLeader{leader: true}, closer, AutoCloseModeFlag).tick(context.Background())
+
+	if !closer.flagOnly {
+		t.Fatalf("flag mode must not close receptions")
+	}
+}
+
+func TestAutoCloser_RunReleasesLeadership(t *testing.T) {
+	leader := &fakeLeader{leader: true}
+	closer := &fakeCloser{}
+	w := newTestAutoCloser(leader, closer, AutoCloseModeClose)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w.Run(ctx)
+
+	if closer.calls != 1 {
+		t.Fatalf("expected one run before stop, got %d", closer.calls)
+	}
+	if !leader.released {
+		t.Fatalf("leadership must be released on stop")
+	}
+}
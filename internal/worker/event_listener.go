@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"PVZ/internal/events"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+)
+
+const (
+	listenBaseBackoff = time.Second
+	listenMaxBackoff  = 30 * time.Second
+)
+
+type NotificationSource interface {
+	Listen(ctx context.Context, handle func(payload string)) error
+}
+
+type EventDispatcher interface {
+	Dispatch(event events.Event)
+}
+
+// EventListener передаёт события из LISTEN/NOTIFY в хаб живой ленты. Он
+// работает на каждой реплике: событие публикует одна из них, а получают
+// подписчики всех.
+type EventListener struct {
+	source NotificationSource
+	hub    EventDispatcher
+}
+
+func NewEventListener(source NotificationSource, hub EventDispatcher) *EventListener {
+	return &EventListener{source: source, hub: hub}
+}
+
+// Run переподключается после обрыва соединения, пока не отменён ctx.
+func (l *EventListener) Run(ctx context.Context) {
+	slog.Info("Event listener started")
+
+	failures := 0
+	for {
+		started := time.Now()
+		err := l.source.Listen(ctx, l.handle)
+		if ctx.Err() != nil {
+			slog.Info("Event listener stopped")
+			return
+		}
+
+		// Соединение, которое продержалось дольше максимальной задержки,
+		// считается рабочим, и задержка начинается заново.
+		if time.Since(started) > listenMaxBackoff {
+			failures = 0
+		}
+		failures++
+		delay := retryBackoff(failures, listenBaseBackoff, listenMaxBackoff)
+		slog.Error("Event listener disconnected", "retryIn", delay, "err", err)
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Event listener stopped")
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (l *EventListener) handle(payload string) {
+	var event events.Event
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		slog.Warn("Invalid event notification", "err", err)
+		return
+	}
+	l.hub.Dispatch(event)
+}
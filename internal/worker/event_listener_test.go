@@ -0,0 +1,59 @@
+package worker
+
+import (
+	"PVZ/internal/events"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeNotificationSource struct {
+	payloads []string
+	calls    int
+	cancel   context.CancelFunc
+}
+
+func (s *fakeNotificationSource) Listen(ctx context.Context, handle func(payload string)) error {
+	s.calls++
+	for _, p := range s.payloads {
+		handle(p)
+	}
+	if s.calls == 1 {
+		return errors.New("connection reset")
+	}
+	s.cancel()
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+type recordingDispatcher struct {
+	events []events.Event
+}
+
+func (d *recordingDispatcher) Dispatch(event events.Event) {
+	d.events = append(d.events, event)
+}
+
+func TestEventListenerReconnectsAndDispatches(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	source := &fakeNotificationSource{
+		payloads: []string{`{"id":"e1","type":"reception.created","payload":{"pvzId":1}}`, `not json`},
+		cancel:   cancel,
+	}
+	hub := &recordingDispatcher{}
+
+	NewEventListener(source, hub).Run(ctx)
+
+	if source.calls != 2 {
+		t.Fatalf("listen calls = %d, want 2", source.calls)
+	}
+	if len(hub.events) != 2 {
+		t.Fatalf("dispatched = %d, want 2", len(hub.events))
+	}
+	if hub.events[0].ID != "e1" || hub.events[0].Type != events.ReceptionCreated {
+		t.Fatalf("unexpected event %+v", hub.events[0])
+	}
+}
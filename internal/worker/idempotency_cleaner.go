@@ -0,0 +1,46 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type ExpiredKeyStore interface {
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// IdempotencyCleaner удаляет истёкшие ключи идемпотентности. Удаление
+// безопасно повторять, поэтому он работает на всех репликах без лидера.
+type IdempotencyCleaner struct {
+	store    ExpiredKeyStore
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewIdempotencyCleaner(store ExpiredKeyStore, interval time.Duration) *IdempotencyCleaner {
+	return &IdempotencyCleaner{store: store, interval: interval, now: time.Now}
+}
+
+func (w *IdempotencyCleaner) Run(ctx context.Context) {
+	slog.Info("Idempotency key cleaner started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := w.store.DeleteExpired(ctx, w.now())
+		if err != nil && ctx.Err() == nil {
+			slog.Error("Failed to delete expired idempotency keys", "err", err)
+		} else if n > 0 {
+			slog.Info("Expired idempotency keys deleted", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Idempotency key cleaner stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
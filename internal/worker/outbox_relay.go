@@ -0,0 +1,166 @@
+package worker
+
+import (
+	"PVZ/internal/events"
+	"PVZ/models"
+	"PVZ/pkg/metrics"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+const (
+	outboxBaseBackoff = 2 * time.Second
+	outboxMaxBackoff  = 10 * time.Minute
+
+	maxLastErrorLen = 1000
+)
+
+type OutboxStore interface {
+	FetchPending(ctx context.Context, now time.Time, maxAttempts, limit int) (models.OutboxSlice, error)
+	MarkPublished(ctx context.Context, event *models.Outbox) error
+	MarkFailed(ctx context.Context, event *models.Outbox) error
+}
+
+type TxManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	Savepoint(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type OutboxRelaySettings struct {
+	Interval    time.Duration
+	BatchSize   int
+	MaxAttempts int
+}
+
+// OutboxRelay переносит события из outbox в EventPublisher. Событие
+// помечается опубликованным только после успешной публикации, поэтому при
+// сбое между ними оно уйдёт повторно (at-least-once).
+type OutboxRelay struct {
+	store     OutboxStore
+	tx        TxManager
+	publisher events.EventPublisher
+	settings  OutboxRelaySettings
+	now       func() time.Time
+}
+
+func NewOutboxRelay(store OutboxStore, tx TxManager, publisher events.EventPublisher, settings OutboxRelaySettings) *OutboxRelay {
+	return &OutboxRelay{
+		store:     store,
+		tx:        tx,
+		publisher: publisher,
+		settings:  settings,
+		now:       time.Now,
+	}
+}
+
+// Run разбирает очередь до отмены ctx. Пока пачки приходят полными, следующая
+// берётся сразу, без ожидания интервала.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	slog.Info("Outbox relay started", "interval", r.settings.Interval, "batchSize", r.settings.BatchSize)
+
+	ticker := time.NewTicker(r.settings.Interval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := r.relayBatch(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.Error("Outbox relay failed", "err", err)
+				}
+				break
+			}
+			if n < r.settings.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch публикует одну пачку событий и возвращает её размер.
+func (r *OutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	var n int
+	err := r.tx.Do(ctx, func(ctx context.Context) error {
+		pending, err := r.store.FetchPending(ctx, r.now(), r.settings.MaxAttempts, r.settings.BatchSize)
+		if err != nil {
+			return err
+		}
+		n = len(pending)
+
+		for _, e := range pending {
+			if err := r.publish(ctx, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return n, err
+}
+
+// publish возвращает ошибку только если не удалось сохранить результат
+// попытки; ошибка самого издателя записывается в событие. Издатели пишут в
+// БД (вебхуки, pg_notify) в транзакции релея, поэтому публикация идёт под
+// точкой сохранения: их ошибка SQL не ломает транзакцию для MarkFailed.
+func (r *OutboxRelay) publish(ctx context.Context, e *models.Outbox) error {
+	err := r.tx.Savepoint(ctx, func(ctx context.Context) error {
+		return r.publisher.Publish(ctx, events.Event{
+			ID:            e.ID,
+			Type:          e.EventType,
+			AggregateType: e.AggregateType,
+			AggregateID:   e.AggregateID,
+			Payload:       json.RawMessage(e.Payload),
+			OccurredAt:    e.CreatedAt,
+		})
+	})
+	if err == nil {
+		metrics.OutboxPublished.WithLabelValues(e.EventType).Inc()
+		return r.store.MarkPublished(ctx, e)
+	}
+
+	metrics.OutboxPublishFailures.WithLabelValues(e.EventType).Inc()
+
+	e.Attempts++
+	e.NextAttemptAt = r.now().Add(retryBackoff(e.Attempts, outboxBaseBackoff, outboxMaxBackoff))
+	e.LastError = null.StringFrom(truncate(err.Error(), maxLastErrorLen))
+
+	if e.Attempts >= r.settings.MaxAttempts {
+		slog.Error("Outbox event dropped after max attempts", "id", e.ID, "type", e.EventType, "attempts", e.Attempts, "err", err)
+	} else {
+		slog.Warn("Failed to publish outbox event", "id", e.ID, "type", e.EventType, "attempts", e.Attempts, "err", err)
+	}
+
+	return r.store.MarkFailed(ctx, e)
+}
+
+// retryBackoff — задержка перед следующей попыткой: base, удваивается с
+// каждой неудачей, но не больше max.
+func retryBackoff(attempts int, base, max time.Duration) time.Duration {
+	d := base
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= max {
+			return max
+		}
+	}
+	return d
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	// Обрезка могла разрезать многобайтный символ, а Postgres не примет невалидный UTF-8.
+	return strings.ToValidUTF8(s[:n], "")
+}
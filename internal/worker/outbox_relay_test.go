@@ -0,0 +1,204 @@
+package worker
+
+import (
+	"PVZ/internal/events"
+	"PVZ/models"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type passthroughTx struct{}
+
+func (passthroughTx) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (passthroughTx) Savepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeOutboxStore повторяет фильтр FetchPending без блокировок.
+type fakeOutboxStore struct {
+	events []*models.Outbox
+}
+
+func (s *fakeOutboxStore) FetchPending(ctx context.Context, now time.Time, maxAttempts, limit int) (models.OutboxSlice, error) {
+	var list models.OutboxSlice
+	for _, e := range s.events {
+		if !e.PublishedAt.Valid && !e.NextAttemptAt.After(now) && e.Attempts < maxAttempts && len(list) < limit {
+			list = append(list, e)
+		}
+	}
+	return list, nil
+}
+
+func (s *fakeOutboxStore) MarkPublished(ctx context.Context, event *models.Outbox) error {
+	event.PublishedAt.SetValid(time.Now())
+	return nil
+}
+
+func (s *fakeOutboxStore) MarkFailed(ctx context.Context, event *models.Outbox) error {
+	return nil
+}
+
+func newTestRelay(store *fakeOutboxStore, publisher events.EventPublisher, now *time.Time) *OutboxRelay {
+	r := NewOutboxRelay(store, passthroughTx{}, publisher, OutboxRelaySettings{Interval: time.Second, BatchSize: 10, MaxAttempts: 3})
+	r.now = func() time.Time { return *now }
+	return r
+}
+
+func TestOutboxRelay_PublishesPendingEvents(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := &fakeOutboxStore{events: []*models.Outbox{
+		{ID: "e1", EventType: events.ReceptionCreated, Payload: []byte(`{"id":"r1"}`), NextAttemptAt: now},
+		{ID: "e2", EventType: events.ProductAdded, Payload: []byte(`{"id":"p1"}`), NextAttemptAt: now},
+	}}
+	publisher := events.NewMemoryPublisher()
+	relay := newTestRelay(store, publisher, &now)
+
+	if _, err := relay.relayBatch(context.Background()); err != nil {
+		t.Fatalf("relay: %v", err)
+	}
+
+	got := publisher.Events()
+	if len(got) != 2 || got[0].ID != "e1" || got[1].ID != "e2" {
+		t.Fatalf("unexpected published events: %+v", got)
+	}
+	if string(got[0].Payload) != `{"id":"r1"}` {
+		t.Fatalf("payload = %s", got[0].Payload)
+	}
+
+	// Опубликованные события повторно не отправляются.
+	if n, _ := relay.relayBatch(context.Background()); n != 0 {
+		t.Fatalf("expected empty batch, got %d", n)
+	}
+}
+
+func TestOutboxRelay_RetriesWithBackoff(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := &models.Outbox{ID: "e1", EventType: events.PVZCreated, Payload: []byte(`{}`), NextAttemptAt: now}
+	store := &fakeOutboxStore{events: []*models.Outbox{event}}
+
+	publisher := events.NewMemoryPublisher()
+	publisher.Fail = func(events.Event) error { return errors.New("broker unavailable") }
+	relay := newTestRelay(store, publisher, &now)
+
+	if _, err := relay.relayBatch(context.Background()); err != nil {
+		t.Fatalf("relay: %v", err)
+	}
+	if event.Attempts != 1 || event.LastError.String != "broker unavailable" {
+		t.Fatalf("attempts = %d, lastError = %q", event.Attempts, event.LastError.String)
+	}
+	if !event.NextAttemptAt.Equal(now.Add(outboxBaseBackoff)) {
+		t.Fatalf("next attempt at %v, want %v", event.NextAttemptAt, now.Add(outboxBaseBackoff))
+	}
+
+	// До следующей попытки событие не берётся.
+	if n, _ := relay.relayBatch(context.Background()); n != 0 {
+		t.Fatalf("event retried before backoff elapsed")
+	}
+
+	publisher.Fail = nil
+	now = now.Add(outboxBaseBackoff)
+	if _, err := relay.relayBatch(context.Background()); err != nil {
+		t.Fatalf("relay: %v", err)
+	}
+	if !event.PublishedAt.Valid || len(publisher.Events()) != 1 {
+		t.Fatalf("event must be published on retry")
+	}
+}
+
+func TestOutboxRelay_StopsAfterMaxAttempts(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := &models.Outbox{ID: "e1", EventType: events.PVZCreated, Payload: []byte(`{}`), NextAttemptAt: now}
+	store := &fakeOutboxStore{events: []*models.Outbox{event}}
+
+	publisher := events.NewMemoryPublisher()
+	publisher.Fail = func(events.Event) error { return errors.New("broker unavailable") }
+	relay := newTestRelay(store, publisher, &now)
+
+	for i := 0; i < 5; i++ {
+		if _, err := relay.relayBatch(context.Background()); err != nil {
+			t.Fatalf("relay: %v", err)
+		}
+		now = now.Add(outboxMaxBackoff)
+	}
+
+	if event.Attempts != 3 {
+		t.Fatalf("attempts = %d, want 3", event.Attempts)
+	}
+}
+
+// pgTx имитирует транзакцию Postgres: после ошибки SQL она отклоняет все
+// запросы, пока не будет отката к точке сохранения.
+type pgTx struct {
+	aborted bool
+}
+
+func (tx *pgTx) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	defer func() { tx.aborted = false }()
+	return fn(ctx)
+}
+
+func (tx *pgTx) Savepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := fn(ctx)
+	if err != nil {
+		tx.aborted = false
+	}
+	return err
+}
+
+type pgOutboxStore struct {
+	fakeOutboxStore
+	tx *pgTx
+}
+
+func (s *pgOutboxStore) MarkFailed(ctx context.Context, event *models.Outbox) error {
+	if s.tx.aborted {
+		return errors.New("current transaction is aborted")
+	}
+	return nil
+}
+
+func TestOutboxRelay_RecordsFailureAfterPublisherSQLError(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := &models.Outbox{ID: "e1", EventType: events.ReceptionClosed, Payload: []byte(`{}`), NextAttemptAt: now}
+	tx := &pgTx{}
+	store := &pgOutboxStore{fakeOutboxStore: fakeOutboxStore{events: []*models.Outbox{event}}, tx: tx}
+
+	// Издатель упал на запросе в БД, как вставка доставки вебхука.
+	publisher := events.NewMemoryPublisher()
+	publisher.Fail = func(events.Event) error {
+		tx.aborted = true
+		return errors.New("insert webhook delivery: deadlock detected")
+	}
+
+	relay := NewOutboxRelay(store, tx, publisher, OutboxRelaySettings{Interval: time.Second, BatchSize: 10, MaxAttempts: 3})
+	relay.now = func() time.Time { return now }
+
+	if _, err := relay.relayBatch(context.Background()); err != nil {
+		t.Fatalf("relay: %v", err)
+	}
+	if event.Attempts != 1 || !event.NextAttemptAt.After(now) {
+		t.Fatalf("failed attempt not recorded: attempts = %d, next attempt at %v", event.Attempts, event.NextAttemptAt)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 2 * time.Second},
+		{2, 4 * time.Second},
+		{5, 32 * time.Second},
+		{20, outboxMaxBackoff},
+	}
+	for _, tt := range tests {
+		if got := retryBackoff(tt.attempts, outboxBaseBackoff, outboxMaxBackoff); got != tt.want {
+			t.Errorf("retryBackoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
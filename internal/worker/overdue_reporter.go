@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// overdueBatchSize — сколько просроченных посылок отмечается за одну транзакцию.
+const overdueBatchSize = 500
+
+type OverdueTracker interface {
+	ReportOverdue(ctx context.Context, now time.Time, limit int) (int, error)
+	RefreshOverdueMetrics(ctx context.Context, now time.Time) error
+}
+
+// OverdueReporter периодически ищет посылки, пролежавшие в ПВЗ дольше срока
+// хранения. Занятые строки пропускаются, а о каждой посылке сообщается один
+// раз, поэтому он работает на всех репликах без лидера.
+type OverdueReporter struct {
+	tracker  OverdueTracker
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewOverdueReporter(tracker OverdueTracker, interval time.Duration) *OverdueReporter {
+	return &OverdueReporter{tracker: tracker, interval: interval, now: time.Now}
+}
+
+func (w *OverdueReporter) Run(ctx context.Context) {
+	slog.Info("Overdue reporter started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Overdue reporter stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *OverdueReporter) tick(ctx context.Context) {
+	now := w.now()
+
+	total := 0
+	for ctx.Err() == nil {
+		n, err := w.tracker.ReportOverdue(ctx, now, overdueBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.Error("Failed to report overdue products", "err", err)
+			}
+			break
+		}
+		total += n
+		if n < overdueBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		slog.Info("Overdue products reported", "count", total)
+	}
+
+	if err := w.tracker.RefreshOverdueMetrics(ctx, now); err != nil && ctx.Err() == nil {
+		slog.Error("Failed to refresh overdue metrics", "err", err)
+	}
+}
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeOverdueTracker struct {
+	pending   int
+	batches   int
+	refreshed int
+}
+
+func (f *fakeOverdueTracker) ReportOverdue(ctx context.Context, now time.Time, limit int) (int, error) {
+	f.batches++
+	n := min(f.pending, limit)
+	f.pending -= n
+	return n, nil
+}
+
+func (f *fakeOverdueTracker) RefreshOverdueMetrics(ctx context.Context, now time.Time) error {
+	f.refreshed++
+	return nil
+}
+
+func TestOverdueReporterDrainsBacklog(t *testing.T) {
+	tracker := &fakeOverdueTracker{pending: 2*overdueBatchSize + 1}
+	w := NewOverdueReporter(tracker, time.Minute)
+
+	w.tick(context.Background())
+
+	if tracker.pending != 0 {
+		t.Fatalf("%d overdue products left unreported", tracker.pending)
+	}
+	if tracker.batches != 3 {
+		t.Fatalf("batches = %d, want 3", tracker.batches)
+	}
+	if tracker.refreshed != 1 {
+		t.Fatalf("metrics refreshed %d times, want 1", tracker.refreshed)
+	}
+}
@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type ExpiredRevocationStore interface {
+	DeleteExpiredRevocations(ctx context.Context, now time.Time) (int64, error)
+}
+
+// RevocationCleaner удаляет отзывы access token'ов, срок действия которых
+// истёк: такие токены JWTMiddleware отвергает и без списка. Как и
+// IdempotencyCleaner, работает на всех репликах без лидера.
+type RevocationCleaner struct {
+	store    ExpiredRevocationStore
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewRevocationCleaner(store ExpiredRevocationStore, interval time.Duration) *RevocationCleaner {
+	return &RevocationCleaner{store: store, interval: interval, now: time.Now}
+}
+
+func (w *RevocationCleaner) Run(ctx context.Context) {
+	slog.Info("Revoked token cleaner started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := w.store.DeleteExpiredRevocations(ctx, w.now())
+		if err != nil && ctx.Err() == nil {
+			slog.Error("Failed to delete expired revoked tokens", "err", err)
+		} else if n > 0 {
+			slog.Info("Expired revoked tokens deleted", "count", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Revoked token cleaner stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package worker
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/webhook"
+	"PVZ/models"
+	"PVZ/pkg/metrics"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/aarondl/null/v8"
+)
+
+const (
+	webhookBaseBackoff = 10 * time.Second
+	webhookMaxBackoff  = time.Hour
+)
+
+type WebhookStore interface {
+	ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) (models.WebhookDeliverySlice, error)
+	SaveAttempt(ctx context.Context, d *models.WebhookDelivery) error
+	GetByID(ctx context.Context, id string) (*models.Webhook, error)
+}
+
+type WebhookSender interface {
+	Send(ctx context.Context, req webhook.Request) (int, error)
+}
+
+// Lease — на сколько доставки пачки закрепляются за репликой; должно
+// покрывать отправку всей пачки с таймаутами.
+type WebhookDispatcherSettings struct {
+	Interval    time.Duration
+	BatchSize   int
+	MaxAttempts int
+	Lease       time.Duration
+}
+
+// WebhookDispatcher отправляет поставленные в очередь доставки вебхуков.
+// Неудачная доставка повторяется с экспоненциальной задержкой, после
+// MaxAttempts попыток получает статус failed.
+type WebhookDispatcher struct {
+	store    WebhookStore
+	sender   WebhookSender
+	settings WebhookDispatcherSettings
+	now      func() time.Time
+}
+
+func NewWebhookDispatcher(store WebhookStore, sender WebhookSender, settings WebhookDispatcherSettings) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		store:    store,
+		sender:   sender,
+		settings: settings,
+		now:      time.Now,
+	}
+}
+
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	slog.Info("Webhook dispatcher started", "interval", d.settings.Interval, "batchSize", d.settings.BatchSize)
+
+	ticker := time.NewTicker(d.settings.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.dispatchBatch(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("Webhook dispatch failed", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Webhook dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatchBatch отправляет одну пачку доставок. Доставки забираются в аренду
+// одним коротким запросом, а запросы к получателям идут вне транзакции:
+// каждая попытка сохраняется сразу, поэтому сбой записи одной доставки не
+// приводит к повторной отправке остальных.
+func (d *WebhookDispatcher) dispatchBatch(ctx context.Context) error {
+	now := d.now()
+	due, err := d.store.ClaimDueDeliveries(ctx, now, now.Add(d.settings.Lease), d.settings.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	hooks := make(map[string]*models.Webhook)
+	var saveErr error
+	for _, delivery := range due {
+		hook, ok := hooks[delivery.WebhookID]
+		if !ok {
+			if hook, err = d.store.GetByID(ctx, delivery.WebhookID); err != nil {
+				return err
+			}
+			hooks[delivery.WebhookID] = hook
+		}
+
+		d.attempt(ctx, hook, delivery)
+		// Несохранённая попытка повторится после окончания аренды.
+		if err := d.store.SaveAttempt(ctx, delivery); err != nil {
+			saveErr = err
+		}
+	}
+	return saveErr
+}
+
+// attempt выполняет одну попытку и записывает её результат в delivery.
+func (d *WebhookDispatcher) attempt(ctx context.Context, hook *models.Webhook, delivery *models.WebhookDelivery) {
+	delivery.Attempts++
+
+	if hook == nil || !hook.Enabled {
+		delivery.Status = constants.WebhookDeliveryFailed
+		delivery.LastError = null.StringFrom("webhook disabled")
+		metrics.WebhookDeliveries.WithLabelValues(constants.WebhookDeliveryFailed).Inc()
+		return
+	}
+
+	code, err := d.sender.Send(ctx, webhook.Request{
+		URL:        hook.URL,
+		Secret:     hook.Secret,
+		DeliveryID: delivery.ID,
+		EventType:  delivery.EventType,
+		Body:       delivery.Payload,
+	})
+	delivery.LastStatusCode = null.NewInt(code, code != 0)
+
+	if err == nil {
+		delivery.Status = constants.WebhookDeliveryDelivered
+		delivery.DeliveredAt = null.TimeFrom(d.now())
+		delivery.LastError = null.String{}
+		metrics.WebhookDeliveries.WithLabelValues(constants.WebhookDeliveryDelivered).Inc()
+		return
+	}
+
+	delivery.LastError = null.StringFrom(truncate(err.Error(), maxLastErrorLen))
+	if delivery.Attempts >= d.settings.MaxAttempts {
+		delivery.Status = constants.WebhookDeliveryFailed
+		metrics.WebhookDeliveries.WithLabelValues(constants.WebhookDeliveryFailed).Inc()
+		slog.Error("Webhook delivery failed", "id", delivery.ID, "webhookID", hook.ID, "attempts", delivery.Attempts, "err", err)
+		return
+	}
+
+	delivery.NextAttemptAt = d.now().Add(retryBackoff(delivery.Attempts, webhookBaseBackoff, webhookMaxBackoff))
+	metrics.WebhookDeliveries.WithLabelValues("retry").Inc()
+	slog.Warn("Webhook delivery attempt failed", "id", delivery.ID, "webhookID", hook.ID, "attempts", delivery.Attempts, "err", err)
+}
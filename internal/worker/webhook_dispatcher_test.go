@@ -0,0 +1,207 @@
+package worker
+
+import (
+	"PVZ/internal/constants"
+	"PVZ/internal/webhook"
+	"PVZ/models"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeWebhookStore struct {
+	hooks      map[string]*models.Webhook
+	deliveries []*models.WebhookDelivery
+	failSave   map[string]bool
+}
+
+func (s *fakeWebhookStore) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) (models.WebhookDeliverySlice, error) {
+	var list models.WebhookDeliverySlice
+	for _, d := range s.deliveries {
+		if d.Status == constants.WebhookDeliveryPending && !d.NextAttemptAt.After(now) && len(list) < limit {
+			d.NextAttemptAt = leaseUntil
+			cp := *d
+			list = append(list, &cp)
+		}
+	}
+	return list, nil
+}
+
+// SaveAttempt отклоняет доставки из failSave, как упавший UPDATE.
+func (s *fakeWebhookStore) SaveAttempt(ctx context.Context, d *models.WebhookDelivery) error {
+	if s.failSave[d.ID] {
+		return errors.New("connection reset")
+	}
+	for i, stored := range s.deliveries {
+		if stored.ID == d.ID {
+			*s.deliveries[i] = *d
+		}
+	}
+	return nil
+}
+
+func (s *fakeWebhookStore) GetByID(ctx context.Context, id string) (*models.Webhook, error) {
+	return s.hooks[id], nil
+}
+
+func newTestDispatcher(store *fakeWebhookStore, now *time.Time) *WebhookDispatcher {
+	d := NewWebhookDispatcher(store, webhook.NewSender(time.Second, true), WebhookDispatcherSettings{
+		Interval:    time.Second,
+		BatchSize:   10,
+		MaxAttempts: 3,
+		Lease:       time.Minute,
+	})
+	d.now = func() time.Time { return *now }
+	return d
+}
+
+func newTestDelivery(now time.Time) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:            "d1",
+		WebhookID:     "w1",
+		EventID:       "e1",
+		EventType:     "reception.closed",
+		Payload:       []byte(`{"id":"e1"}`),
+		Status:        constants.WebhookDeliveryPending,
+		NextAttemptAt: now,
+	}
+}
+
+func TestWebhookDispatcher_RetriesUntilDelivered(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(webhook.SignatureHeader) == "" {
+			t.Errorf("request is not signed")
+		}
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	delivery := newTestDelivery(now)
+	store := &fakeWebhookStore{
+		hooks:      map[string]*models.Webhook{"w1": {ID: "w1", URL: srv.URL, Secret: "0123456789abcdef", Enabled: true}},
+		deliveries: []*models.WebhookDelivery{delivery},
+	}
+	d := newTestDispatcher(store, &now)
+
+	if err := d.dispatchBatch(context.Background()); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if delivery.Status != constants.WebhookDeliveryPending || delivery.Attempts != 1 || delivery.LastStatusCode.Int != http.StatusServiceUnavailable {
+		t.Fatalf("after failure: status=%s attempts=%d code=%v", delivery.Status, delivery.Attempts, delivery.LastStatusCode)
+	}
+	if !delivery.NextAttemptAt.Equal(now.Add(webhookBaseBackoff)) {
+		t.Fatalf("next attempt at %v, want %v", delivery.NextAttemptAt, now.Add(webhookBaseBackoff))
+	}
+
+	// До истечения задержки повторной отправки нет.
+	if err := d.dispatchBatch(context.Background()); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("delivery retried before backoff elapsed")
+	}
+
+	now = now.Add(webhookBaseBackoff)
+	if err := d.dispatchBatch(context.Background()); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if delivery.Status != constants.WebhookDeliveryDelivered || !delivery.DeliveredAt.Valid || delivery.LastError.Valid {
+		t.Fatalf("after success: status=%s deliveredAt=%v lastError=%v", delivery.Status, delivery.DeliveredAt, delivery.LastError)
+	}
+}
+
+func TestWebhookDispatcher_FailsAfterMaxAttempts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	delivery := newTestDelivery(now)
+	store := &fakeWebhookStore{
+		hooks:      map[string]*models.Webhook{"w1": {ID: "w1", URL: srv.URL, Secret: "0123456789abcdef", Enabled: true}},
+		deliveries: []*models.WebhookDelivery{delivery},
+	}
+	d := newTestDispatcher(store, &now)
+
+	for i := 0; i < 5; i++ {
+		if err := d.dispatchBatch(context.Background()); err != nil {
+			t.Fatalf("dispatch: %v", err)
+		}
+		now = now.Add(webhookMaxBackoff)
+	}
+
+	if delivery.Status != constants.WebhookDeliveryFailed || delivery.Attempts != 3 {
+		t.Fatalf("status=%s attempts=%d, want failed after 3", delivery.Status, delivery.Attempts)
+	}
+}
+
+func TestWebhookDispatcher_DisabledWebhook(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	delivery := newTestDelivery(now)
+	store := &fakeWebhookStore{
+		hooks:      map[string]*models.Webhook{"w1": {ID: "w1", URL: "http://127.0.0.1:1", Enabled: false}},
+		deliveries: []*models.WebhookDelivery{delivery},
+	}
+
+	if err := newTestDispatcher(store, &now).dispatchBatch(context.Background()); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if delivery.Status != constants.WebhookDeliveryFailed || delivery.LastError.String != "webhook disabled" {
+		t.Fatalf("status=%s lastError=%q", delivery.Status, delivery.LastError.String)
+	}
+}
+
+func TestWebhookDispatcher_SaveFailureKeepsOtherAttempts(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	first, second := newTestDelivery(now), newTestDelivery(now)
+	second.ID = "d2"
+	store := &fakeWebhookStore{
+		hooks:      map[string]*models.Webhook{"w1": {ID: "w1", URL: srv.URL, Secret: "0123456789abcdef", Enabled: true}},
+		deliveries: []*models.WebhookDelivery{first, second},
+		failSave:   map[string]bool{"d1": true},
+	}
+	d := newTestDispatcher(store, &now)
+
+	if err := d.dispatchBatch(context.Background()); err == nil {
+		t.Fatalf("expected save error")
+	}
+	if second.Status != constants.WebhookDeliveryDelivered {
+		t.Fatalf("second delivery status = %s, want delivered", second.Status)
+	}
+
+	// Доставка с несохранённой попыткой закреплена арендой и до её окончания
+	// повторно не отправляется; сохранённая — не отправляется вовсе.
+	if err := d.dispatchBatch(context.Background()); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("calls = %d, want 2", calls.Load())
+	}
+
+	delete(store.failSave, "d1")
+	now = now.Add(time.Minute)
+	if err := d.dispatchBatch(context.Background()); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if calls.Load() != 3 || first.Status != constants.WebhookDeliveryDelivered {
+		t.Fatalf("calls = %d, first status = %s", calls.Load(), first.Status)
+	}
+}
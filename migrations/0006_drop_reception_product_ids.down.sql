@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_products_reception_added_at;
+CREATE INDEX IF NOT EXISTS idx_products_reception_id ON products(reception_id);
+
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS product_ids JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+UPDATE receptions r
+    SET product_ids = sub.ids
+    FROM (
+        SELECT reception_id, jsonb_agg(id::text ORDER BY added_at, id) AS ids
+        FROM products
+        GROUP BY reception_id
+    ) sub
+    WHERE sub.reception_id = r.id;
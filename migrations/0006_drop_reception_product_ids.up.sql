@@ -0,0 +1,9 @@
+DELETE FROM products p
+    USING receptions r
+    WHERE p.reception_id = r.id
+      AND NOT (r.product_ids ? p.id::text);
+
+ALTER TABLE receptions DROP COLUMN IF EXISTS product_ids;
+
+DROP INDEX IF EXISTS idx_products_reception_id;
+CREATE INDEX IF NOT EXISTS idx_products_reception_added_at ON products(reception_id, added_at, id);
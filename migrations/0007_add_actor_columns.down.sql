@@ -0,0 +1,5 @@
+ALTER TABLE products DROP COLUMN IF EXISTS created_by;
+
+ALTER TABLE receptions
+    DROP COLUMN IF EXISTS closed_by,
+    DROP COLUMN IF EXISTS created_by;
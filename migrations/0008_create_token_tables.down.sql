@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_revoked_tokens_expires_at;
+DROP INDEX IF EXISTS idx_refresh_tokens_user_id;
+
+DROP TABLE IF EXISTS revoked_tokens;
+DROP TABLE IF EXISTS refresh_tokens;
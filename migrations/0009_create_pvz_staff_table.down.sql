@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_pvz_staff_user_id;
+DROP TABLE IF EXISTS pvz_staff;
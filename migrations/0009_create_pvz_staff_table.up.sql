@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS pvz_staff (
+    pvz_id BIGINT NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assigned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pvz_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pvz_staff_user_id ON pvz_staff(user_id);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_receptions_pvz_date_time;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_receptions_pvz_date_time ON receptions(pvz_id, date_time DESC, id DESC);
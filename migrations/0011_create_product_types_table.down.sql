@@ -0,0 +1,3 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_type_fkey;
+
+DROP TABLE IF EXISTS product_types;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS product_types (
+    code VARCHAR(20) PRIMARY KEY,
+    name_ru VARCHAR(255) NOT NULL,
+    name_en VARCHAR(255) NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO product_types (code, name_ru, name_en) VALUES
+    ('электроника', 'Электроника', 'Electronics'),
+    ('одежда', 'Одежда', 'Clothes'),
+    ('обувь', 'Обувь', 'Shoes')
+ON CONFLICT (code) DO NOTHING;
+
+-- Типы, которые уже встречаются в товарах, но не входят в справочник,
+-- заводим выключенными, чтобы не потерять историю.
+INSERT INTO product_types (code, name_ru, is_active)
+SELECT DISTINCT type, type, FALSE FROM products
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE products
+    ADD CONSTRAINT products_type_fkey FOREIGN KEY (type) REFERENCES product_types(code);
@@ -0,0 +1,3 @@
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_city_fkey;
+
+DROP TABLE IF EXISTS cities;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS cities (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    region VARCHAR(255) NOT NULL DEFAULT '',
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Europe/Moscow',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO cities (name, region, timezone) VALUES
+    ('Москва', 'Москва', 'Europe/Moscow'),
+    ('Санкт-Петербург', 'Санкт-Петербург', 'Europe/Moscow'),
+    ('Казань', 'Республика Татарстан', 'Europe/Moscow')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO cities (name, enabled)
+SELECT DISTINCT city, FALSE FROM pvz
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE pvz
+    ADD CONSTRAINT pvz_city_fkey FOREIGN KEY (city) REFERENCES cities(name) ON UPDATE CASCADE;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_products_barcode;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS height_mm,
+    DROP COLUMN IF EXISTS width_mm,
+    DROP COLUMN IF EXISTS length_mm,
+    DROP COLUMN IF EXISTS weight_grams,
+    DROP COLUMN IF EXISTS order_number,
+    DROP COLUMN IF EXISTS sku,
+    DROP COLUMN IF EXISTS barcode;
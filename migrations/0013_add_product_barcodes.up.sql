@@ -0,0 +1,10 @@
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS barcode VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS sku VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS order_number VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS weight_grams INTEGER CHECK (weight_grams > 0),
+    ADD COLUMN IF NOT EXISTS length_mm INTEGER CHECK (length_mm > 0),
+    ADD COLUMN IF NOT EXISTS width_mm INTEGER CHECK (width_mm > 0),
+    ADD COLUMN IF NOT EXISTS height_mm INTEGER CHECK (height_mm > 0);
+
+CREATE INDEX IF NOT EXISTS idx_products_barcode ON products(barcode) WHERE barcode IS NOT NULL;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS product_audit_log (
+    id UUID PRIMARY KEY,
+    action VARCHAR(20) NOT NULL,
+    product_id UUID NOT NULL,
+    reception_id UUID NOT NULL REFERENCES receptions(id) ON DELETE CASCADE,
+    product_type VARCHAR(20) NOT NULL,
+    barcode VARCHAR(64),
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_audit_log_reception_id ON product_audit_log(reception_id, created_at);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS status_history;
+
+-- Отменённые приёмки в старой схеме выразить нельзя, считаем их закрытыми.
+UPDATE receptions SET status = 'closed' WHERE status = 'cancelled';
+
+ALTER TABLE receptions DROP CONSTRAINT IF EXISTS receptions_status_check;
+
+ALTER TABLE receptions DROP COLUMN IF EXISTS closed_at;
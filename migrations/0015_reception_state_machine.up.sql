@@ -0,0 +1,16 @@
+ALTER TABLE receptions
+    ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP;
+
+ALTER TABLE receptions
+    ADD CONSTRAINT receptions_status_check CHECK (status IN ('in_progress', 'closed', 'cancelled'));
+
+CREATE TABLE IF NOT EXISTS status_history (
+    id UUID PRIMARY KEY,
+    reception_id UUID NOT NULL REFERENCES receptions(id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    changed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_status_history_reception_id ON status_history(reception_id, changed_at);
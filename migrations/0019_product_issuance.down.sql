@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_products_status;
+DROP INDEX IF EXISTS idx_products_pickup_code;
+
+ALTER TABLE products DROP COLUMN IF EXISTS issued_by;
+ALTER TABLE products DROP COLUMN IF EXISTS issued_at;
+ALTER TABLE products DROP COLUMN IF EXISTS status_changed_at;
+ALTER TABLE products DROP COLUMN IF EXISTS pickup_code;
+ALTER TABLE products DROP COLUMN IF EXISTS status;
@@ -0,0 +1,41 @@
+ALTER TABLE products ADD COLUMN IF NOT EXISTS status VARCHAR(32) NOT NULL DEFAULT 'received';
+ALTER TABLE products ADD COLUMN IF NOT EXISTS pickup_code VARCHAR(16);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMP;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS issued_at TIMESTAMP;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS issued_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- Товары уже закрытых приёмок готовы к выдаче. Код выдачи общий для товаров
+-- одного заказа в ПВЗ, товар без номера заказа получает свой код.
+DO $$
+DECLARE
+    g RECORD;
+    code TEXT;
+BEGIN
+    FOR g IN
+        SELECT r.pvz_id, COALESCE(p.order_number, p.id::text) AS grp
+        FROM products p
+        JOIN receptions r ON r.id = p.reception_id
+        WHERE r.status = 'closed'
+        GROUP BY 1, 2
+    LOOP
+        LOOP
+            code := lpad(floor(random() * 1000000)::int::text, 6, '0');
+            EXIT WHEN NOT EXISTS (
+                SELECT 1 FROM products p
+                JOIN receptions r ON r.id = p.reception_id
+                WHERE r.pvz_id = g.pvz_id AND p.pickup_code = code
+            );
+        END LOOP;
+
+        UPDATE products p
+        SET status = 'ready_for_pickup', pickup_code = code, status_changed_at = NOW()
+        FROM receptions r
+        WHERE r.id = p.reception_id
+          AND r.status = 'closed'
+          AND r.pvz_id = g.pvz_id
+          AND COALESCE(p.order_number, p.id::text) = g.grp;
+    END LOOP;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_products_pickup_code ON products(pickup_code) WHERE status = 'ready_for_pickup';
+CREATE INDEX IF NOT EXISTS idx_products_status ON products(status);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_products_original_product_id;
+
+ALTER TABLE products DROP COLUMN IF EXISTS original_product_id;
+ALTER TABLE products DROP COLUMN IF EXISTS return_comment;
+ALTER TABLE products DROP COLUMN IF EXISTS return_reason;
+
+DROP INDEX IF EXISTS idx_receptions_pvz_kind_active;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_receptions_pvz_status
+    ON receptions(pvz_id, status) WHERE status = 'in_progress';
+
+ALTER TABLE receptions DROP CONSTRAINT IF EXISTS receptions_kind_check;
+ALTER TABLE receptions DROP COLUMN IF EXISTS kind;
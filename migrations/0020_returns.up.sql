@@ -0,0 +1,14 @@
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS kind VARCHAR(16) NOT NULL DEFAULT 'delivery';
+ALTER TABLE receptions
+    ADD CONSTRAINT receptions_kind_check CHECK (kind IN ('delivery', 'return'));
+
+-- Приёмка поставки и приёмка возвратов в ПВЗ открываются независимо.
+DROP INDEX IF EXISTS idx_receptions_pvz_status;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_receptions_pvz_kind_active
+    ON receptions(pvz_id, kind) WHERE status = 'in_progress';
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS return_reason VARCHAR(32);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS return_comment TEXT;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS original_product_id UUID REFERENCES products(id);
+
+CREATE INDEX IF NOT EXISTS idx_products_original_product_id ON products(original_product_id) WHERE original_product_id IS NOT NULL;
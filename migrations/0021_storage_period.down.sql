@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_products_storage_expires_at;
+
+ALTER TABLE products DROP COLUMN IF EXISTS overdue_reported_at;
+ALTER TABLE products DROP COLUMN IF EXISTS storage_expires_at;
+
+ALTER TABLE product_types DROP CONSTRAINT IF EXISTS product_types_storage_days_check;
+ALTER TABLE product_types DROP COLUMN IF EXISTS storage_days;
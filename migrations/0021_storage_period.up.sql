@@ -0,0 +1,19 @@
+-- Срок хранения посылки в ПВЗ, дней
+ALTER TABLE product_types ADD COLUMN IF NOT EXISTS storage_days INTEGER NOT NULL DEFAULT 7;
+ALTER TABLE product_types
+    ADD CONSTRAINT product_types_storage_days_check CHECK (storage_days > 0);
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS storage_expires_at TIMESTAMP;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS overdue_reported_at TIMESTAMP;
+
+-- Посылки, уже ожидающие выдачи, хранятся от закрытия своей приёмки.
+UPDATE products p
+SET storage_expires_at = r.closed_at + make_interval(days => pt.storage_days)
+FROM receptions r, product_types pt
+WHERE r.id = p.reception_id
+  AND pt.code = p.type
+  AND p.status = 'ready_for_pickup'
+  AND r.closed_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_products_storage_expires_at
+    ON products(storage_expires_at) WHERE status = 'ready_for_pickup';
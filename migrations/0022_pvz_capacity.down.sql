@@ -0,0 +1,5 @@
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_capacity_policy_check;
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_capacity_check;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS capacity_policy;
+ALTER TABLE pvz DROP COLUMN IF EXISTS capacity;
@@ -0,0 +1,8 @@
+-- Вместимость ПВЗ в местах хранения; NULL — без ограничения.
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS capacity INTEGER;
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS capacity_policy VARCHAR(16) NOT NULL DEFAULT 'reject';
+
+ALTER TABLE pvz
+    ADD CONSTRAINT pvz_capacity_check CHECK (capacity IS NULL OR capacity > 0);
+ALTER TABLE pvz
+    ADD CONSTRAINT pvz_capacity_policy_check CHECK (capacity_policy IN ('reject', 'warn'));
@@ -0,0 +1,14 @@
+-- Ячейки хранения ПВЗ, код вида A-01-03: стеллаж, полка, место.
+CREATE TABLE IF NOT EXISTS pvz_cells (
+    id UUID PRIMARY KEY,
+    pvz_id BIGINT NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    code VARCHAR(32) NOT NULL,
+    capacity INTEGER NOT NULL DEFAULT 1 CHECK (capacity > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pvz_cells_pvz_code ON pvz_cells(pvz_id, code);
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS cell_id UUID REFERENCES pvz_cells(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_products_cell_id ON products(cell_id) WHERE cell_id IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_receptions_in_progress_opened;
+ALTER TABLE receptions DROP COLUMN IF EXISTS reopened_at;
@@ -0,0 +1,7 @@
+-- Время последнего возврата приёмки в работу: от него считается возраст
+-- приёмки для автозакрытия.
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS reopened_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_receptions_in_progress_opened
+    ON receptions ((COALESCE(reopened_at, date_time)), id)
+    WHERE status = 'in_progress';
@@ -0,0 +1,3 @@
+-- Пока запрос выполняется, ключ занят до locked_until. Если процесс упал и
+-- не освободил ключ, после этого срока повтор займёт его заново.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP NOT NULL DEFAULT NOW();
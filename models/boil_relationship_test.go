@@ -8,8 +8,28 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("ProductAuditLogToReceptionUsingReception", testProductAuditLogToOneReceptionUsingReception)
+	t.Run("ProductAuditLogToUserUsingActor", testProductAuditLogToOneUserUsingActor)
 	t.Run("ProductToReceptionUsingReception", testProductToOneReceptionUsingReception)
+	t.Run("ProductToUserUsingCreatedByUser", testProductToOneUserUsingCreatedByUser)
+	t.Run("ProductToUserUsingIssuedByUser", testProductToOneUserUsingIssuedByUser)
+	t.Run("ProductToProductUsingOriginalProduct", testProductToOneProductUsingOriginalProduct)
+	t.Run("ProductToProductTypeUsingTypeProductType", testProductToOneProductTypeUsingTypeProductType)
+	t.Run("ProductToPVZCellUsingCell", testProductToOnePVZCellUsingCell)
+	t.Run("PVZToCityUsingPVZCity", testPVZToOneCityUsingPVZCity)
+	t.Run("PVZCellToPVZUsingPVZ", testPVZCellToOnePVZUsingPVZ)
+	t.Run("PVZStaffToPVZUsingPVZ", testPVZStaffToOnePVZUsingPVZ)
+	t.Run("PVZStaffToUserUsingUser", testPVZStaffToOneUserUsingUser)
+	t.Run("PVZStaffToUserUsingAssignedByUser", testPVZStaffToOneUserUsingAssignedByUser)
 	t.Run("ReceptionToPVZUsingPVZ", testReceptionToOnePVZUsingPVZ)
+	t.Run("ReceptionToUserUsingCreatedByUser", testReceptionToOneUserUsingCreatedByUser)
+	t.Run("ReceptionToUserUsingClosedByUser", testReceptionToOneUserUsingClosedByUser)
+	t.Run("RefreshTokenToUserUsingUser", testRefreshTokenToOneUserUsingUser)
+	t.Run("StatusHistoryToReceptionUsingReception", testStatusHistoryToOneReceptionUsingReception)
+	t.Run("StatusHistoryToUserUsingChangedByUser", testStatusHistoryToOneUserUsingChangedByUser)
+	t.Run("WebhookDeliveryToWebhookUsingWebhook", testWebhookDeliveryToOneWebhookUsingWebhook)
+	t.Run("WebhookToPVZUsingPVZ", testWebhookToOnePVZUsingPVZ)
+	t.Run("WebhookToUserUsingCreatedByUser", testWebhookToOneUserUsingCreatedByUser)
 }
 
 // TestOneToOne tests cannot be run in parallel
@@ -19,20 +39,72 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("CityToPVZS", testCityToManyPVZS)
+	t.Run("ProductTypeToTypeProducts", testProductTypeToManyTypeProducts)
+	t.Run("ProductToOriginalProductProducts", testProductToManyOriginalProductProducts)
+	t.Run("PVZToPVZCells", testPVZToManyPVZCells)
+	t.Run("PVZToPVZStaffs", testPVZToManyPVZStaffs)
 	t.Run("PVZToReceptions", testPVZToManyReceptions)
+	t.Run("PVZToWebhooks", testPVZToManyWebhooks)
+	t.Run("PVZCellToCellProducts", testPVZCellToManyCellProducts)
+	t.Run("ReceptionToProductAuditLogs", testReceptionToManyProductAuditLogs)
 	t.Run("ReceptionToProducts", testReceptionToManyProducts)
+	t.Run("ReceptionToStatusHistories", testReceptionToManyStatusHistories)
+	t.Run("UserToActorProductAuditLogs", testUserToManyActorProductAuditLogs)
+	t.Run("UserToCreatedByProducts", testUserToManyCreatedByProducts)
+	t.Run("UserToIssuedByProducts", testUserToManyIssuedByProducts)
+	t.Run("UserToPVZStaffs", testUserToManyPVZStaffs)
+	t.Run("UserToAssignedByPVZStaffs", testUserToManyAssignedByPVZStaffs)
+	t.Run("UserToCreatedByReceptions", testUserToManyCreatedByReceptions)
+	t.Run("UserToClosedByReceptions", testUserToManyClosedByReceptions)
+	t.Run("UserToRefreshTokens", testUserToManyRefreshTokens)
+	t.Run("UserToChangedByStatusHistories", testUserToManyChangedByStatusHistories)
+	t.Run("UserToCreatedByWebhooks", testUserToManyCreatedByWebhooks)
+	t.Run("WebhookToWebhookDeliveries", testWebhookToManyWebhookDeliveries)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("ProductAuditLogToReceptionUsingProductAuditLogs", testProductAuditLogToOneSetOpReceptionUsingReception)
+	t.Run("ProductAuditLogToUserUsingActorProductAuditLogs", testProductAuditLogToOneSetOpUserUsingActor)
 	t.Run("ProductToReceptionUsingProducts", testProductToOneSetOpReceptionUsingReception)
+	t.Run("ProductToUserUsingCreatedByProducts", testProductToOneSetOpUserUsingCreatedByUser)
+	t.Run("ProductToUserUsingIssuedByProducts", testProductToOneSetOpUserUsingIssuedByUser)
+	t.Run("ProductToProductUsingOriginalProductProducts", testProductToOneSetOpProductUsingOriginalProduct)
+	t.Run("ProductToProductTypeUsingTypeProducts", testProductToOneSetOpProductTypeUsingTypeProductType)
+	t.Run("ProductToPVZCellUsingCellProducts", testProductToOneSetOpPVZCellUsingCell)
+	t.Run("PVZToCityUsingPVZS", testPVZToOneSetOpCityUsingPVZCity)
+	t.Run("PVZCellToPVZUsingPVZCells", testPVZCellToOneSetOpPVZUsingPVZ)
+	t.Run("PVZStaffToPVZUsingPVZStaffs", testPVZStaffToOneSetOpPVZUsingPVZ)
+	t.Run("PVZStaffToUserUsingPVZStaffs", testPVZStaffToOneSetOpUserUsingUser)
+	t.Run("PVZStaffToUserUsingAssignedByPVZStaffs", testPVZStaffToOneSetOpUserUsingAssignedByUser)
 	t.Run("ReceptionToPVZUsingReceptions", testReceptionToOneSetOpPVZUsingPVZ)
+	t.Run("ReceptionToUserUsingCreatedByReceptions", testReceptionToOneSetOpUserUsingCreatedByUser)
+	t.Run("ReceptionToUserUsingClosedByReceptions", testReceptionToOneSetOpUserUsingClosedByUser)
+	t.Run("RefreshTokenToUserUsingRefreshTokens", testRefreshTokenToOneSetOpUserUsingUser)
+	t.Run("StatusHistoryToReceptionUsingStatusHistories", testStatusHistoryToOneSetOpReceptionUsingReception)
+	t.Run("StatusHistoryToUserUsingChangedByStatusHistories", testStatusHistoryToOneSetOpUserUsingChangedByUser)
+	t.Run("WebhookDeliveryToWebhookUsingWebhookDeliveries", testWebhookDeliveryToOneSetOpWebhookUsingWebhook)
+	t.Run("WebhookToPVZUsingWebhooks", testWebhookToOneSetOpPVZUsingPVZ)
+	t.Run("WebhookToUserUsingCreatedByWebhooks", testWebhookToOneSetOpUserUsingCreatedByUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("ProductAuditLogToUserUsingActorProductAuditLogs", testProductAuditLogToOneRemoveOpUserUsingActor)
+	t.Run("ProductToUserUsingCreatedByProducts", testProductToOneRemoveOpUserUsingCreatedByUser)
+	t.Run("ProductToUserUsingIssuedByProducts", testProductToOneRemoveOpUserUsingIssuedByUser)
+	t.Run("ProductToProductUsingOriginalProductProducts", testProductToOneRemoveOpProductUsingOriginalProduct)
+	t.Run("ProductToPVZCellUsingCellProducts", testProductToOneRemoveOpPVZCellUsingCell)
+	t.Run("PVZStaffToUserUsingAssignedByPVZStaffs", testPVZStaffToOneRemoveOpUserUsingAssignedByUser)
+	t.Run("ReceptionToUserUsingCreatedByReceptions", testReceptionToOneRemoveOpUserUsingCreatedByUser)
+	t.Run("ReceptionToUserUsingClosedByReceptions", testReceptionToOneRemoveOpUserUsingClosedByUser)
+	t.Run("StatusHistoryToUserUsingChangedByStatusHistories", testStatusHistoryToOneRemoveOpUserUsingChangedByUser)
+	t.Run("WebhookToPVZUsingWebhooks", testWebhookToOneRemoveOpPVZUsingPVZ)
+	t.Run("WebhookToUserUsingCreatedByWebhooks", testWebhookToOneRemoveOpUserUsingCreatedByUser)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -45,14 +117,58 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("CityToPVZS", testCityToManyAddOpPVZS)
+	t.Run("ProductTypeToTypeProducts", testProductTypeToManyAddOpTypeProducts)
+	t.Run("ProductToOriginalProductProducts", testProductToManyAddOpOriginalProductProducts)
+	t.Run("PVZToPVZCells", testPVZToManyAddOpPVZCells)
+	t.Run("PVZToPVZStaffs", testPVZToManyAddOpPVZStaffs)
 	t.Run("PVZToReceptions", testPVZToManyAddOpReceptions)
+	t.Run("PVZToWebhooks", testPVZToManyAddOpWebhooks)
+	t.Run("PVZCellToCellProducts", testPVZCellToManyAddOpCellProducts)
+	t.Run("ReceptionToProductAuditLogs", testReceptionToManyAddOpProductAuditLogs)
 	t.Run("ReceptionToProducts", testReceptionToManyAddOpProducts)
+	t.Run("ReceptionToStatusHistories", testReceptionToManyAddOpStatusHistories)
+	t.Run("UserToActorProductAuditLogs", testUserToManyAddOpActorProductAuditLogs)
+	t.Run("UserToCreatedByProducts", testUserToManyAddOpCreatedByProducts)
+	t.Run("UserToIssuedByProducts", testUserToManyAddOpIssuedByProducts)
+	t.Run("UserToPVZStaffs", testUserToManyAddOpPVZStaffs)
+	t.Run("UserToAssignedByPVZStaffs", testUserToManyAddOpAssignedByPVZStaffs)
+	t.Run("UserToCreatedByReceptions", testUserToManyAddOpCreatedByReceptions)
+	t.Run("UserToClosedByReceptions", testUserToManyAddOpClosedByReceptions)
+	t.Run("UserToRefreshTokens", testUserToManyAddOpRefreshTokens)
+	t.Run("UserToChangedByStatusHistories", testUserToManyAddOpChangedByStatusHistories)
+	t.Run("UserToCreatedByWebhooks", testUserToManyAddOpCreatedByWebhooks)
+	t.Run("WebhookToWebhookDeliveries", testWebhookToManyAddOpWebhookDeliveries)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("ProductToOriginalProductProducts", testProductToManySetOpOriginalProductProducts)
+	t.Run("PVZToWebhooks", testPVZToManySetOpWebhooks)
+	t.Run("PVZCellToCellProducts", testPVZCellToManySetOpCellProducts)
+	t.Run("UserToActorProductAuditLogs", testUserToManySetOpActorProductAuditLogs)
+	t.Run("UserToCreatedByProducts", testUserToManySetOpCreatedByProducts)
+	t.Run("UserToIssuedByProducts", testUserToManySetOpIssuedByProducts)
+	t.Run("UserToAssignedByPVZStaffs", testUserToManySetOpAssignedByPVZStaffs)
+	t.Run("UserToCreatedByReceptions", testUserToManySetOpCreatedByReceptions)
+	t.Run("UserToClosedByReceptions", testUserToManySetOpClosedByReceptions)
+	t.Run("UserToChangedByStatusHistories", testUserToManySetOpChangedByStatusHistories)
+	t.Run("UserToCreatedByWebhooks", testUserToManySetOpCreatedByWebhooks)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("ProductToOriginalProductProducts", testProductToManyRemoveOpOriginalProductProducts)
+	t.Run("PVZToWebhooks", testPVZToManyRemoveOpWebhooks)
+	t.Run("PVZCellToCellProducts", testPVZCellToManyRemoveOpCellProducts)
+	t.Run("UserToActorProductAuditLogs", testUserToManyRemoveOpActorProductAuditLogs)
+	t.Run("UserToCreatedByProducts", testUserToManyRemoveOpCreatedByProducts)
+	t.Run("UserToIssuedByProducts", testUserToManyRemoveOpIssuedByProducts)
+	t.Run("UserToAssignedByPVZStaffs", testUserToManyRemoveOpAssignedByPVZStaffs)
+	t.Run("UserToCreatedByReceptions", testUserToManyRemoveOpCreatedByReceptions)
+	t.Run("UserToClosedByReceptions", testUserToManyRemoveOpClosedByReceptions)
+	t.Run("UserToChangedByStatusHistories", testUserToManyRemoveOpChangedByStatusHistories)
+	t.Run("UserToCreatedByWebhooks", testUserToManyRemoveOpCreatedByWebhooks)
+}
@@ -12,142 +12,358 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("Cities", testCities)
+	t.Run("IdempotencyKeys", testIdempotencyKeys)
+	t.Run("Outboxes", testOutboxes)
+	t.Run("ProductAuditLogs", testProductAuditLogs)
+	t.Run("ProductTypes", testProductTypes)
 	t.Run("Products", testProducts)
 	t.Run("PVZS", testPVZS)
+	t.Run("PVZCells", testPVZCells)
+	t.Run("PVZStaffs", testPVZStaffs)
 	t.Run("Receptions", testReceptions)
+	t.Run("RefreshTokens", testRefreshTokens)
+	t.Run("RevokedTokens", testRevokedTokens)
 	t.Run("SchemaMigrations", testSchemaMigrations)
+	t.Run("StatusHistories", testStatusHistories)
 	t.Run("Users", testUsers)
+	t.Run("WebhookDeliveries", testWebhookDeliveries)
+	t.Run("Webhooks", testWebhooks)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("Cities", testCitiesDelete)
+	t.Run("IdempotencyKeys", testIdempotencyKeysDelete)
+	t.Run("Outboxes", testOutboxesDelete)
+	t.Run("ProductAuditLogs", testProductAuditLogsDelete)
+	t.Run("ProductTypes", testProductTypesDelete)
 	t.Run("Products", testProductsDelete)
 	t.Run("PVZS", testPVZSDelete)
+	t.Run("PVZCells", testPVZCellsDelete)
+	t.Run("PVZStaffs", testPVZStaffsDelete)
 	t.Run("Receptions", testReceptionsDelete)
+	t.Run("RefreshTokens", testRefreshTokensDelete)
+	t.Run("RevokedTokens", testRevokedTokensDelete)
 	t.Run("SchemaMigrations", testSchemaMigrationsDelete)
+	t.Run("StatusHistories", testStatusHistoriesDelete)
 	t.Run("Users", testUsersDelete)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesDelete)
+	t.Run("Webhooks", testWebhooksDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("Cities", testCitiesQueryDeleteAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysQueryDeleteAll)
+	t.Run("Outboxes", testOutboxesQueryDeleteAll)
+	t.Run("ProductAuditLogs", testProductAuditLogsQueryDeleteAll)
+	t.Run("ProductTypes", testProductTypesQueryDeleteAll)
 	t.Run("Products", testProductsQueryDeleteAll)
 	t.Run("PVZS", testPVZSQueryDeleteAll)
+	t.Run("PVZCells", testPVZCellsQueryDeleteAll)
+	t.Run("PVZStaffs", testPVZStaffsQueryDeleteAll)
 	t.Run("Receptions", testReceptionsQueryDeleteAll)
+	t.Run("RefreshTokens", testRefreshTokensQueryDeleteAll)
+	t.Run("RevokedTokens", testRevokedTokensQueryDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsQueryDeleteAll)
+	t.Run("StatusHistories", testStatusHistoriesQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesQueryDeleteAll)
+	t.Run("Webhooks", testWebhooksQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("Cities", testCitiesSliceDeleteAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceDeleteAll)
+	t.Run("Outboxes", testOutboxesSliceDeleteAll)
+	t.Run("ProductAuditLogs", testProductAuditLogsSliceDeleteAll)
+	t.Run("ProductTypes", testProductTypesSliceDeleteAll)
 	t.Run("Products", testProductsSliceDeleteAll)
 	t.Run("PVZS", testPVZSSliceDeleteAll)
+	t.Run("PVZCells", testPVZCellsSliceDeleteAll)
+	t.Run("PVZStaffs", testPVZStaffsSliceDeleteAll)
 	t.Run("Receptions", testReceptionsSliceDeleteAll)
+	t.Run("RefreshTokens", testRefreshTokensSliceDeleteAll)
+	t.Run("RevokedTokens", testRevokedTokensSliceDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceDeleteAll)
+	t.Run("StatusHistories", testStatusHistoriesSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceDeleteAll)
+	t.Run("Webhooks", testWebhooksSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("Cities", testCitiesExists)
+	t.Run("IdempotencyKeys", testIdempotencyKeysExists)
+	t.Run("Outboxes", testOutboxesExists)
+	t.Run("ProductAuditLogs", testProductAuditLogsExists)
+	t.Run("ProductTypes", testProductTypesExists)
 	t.Run("Products", testProductsExists)
 	t.Run("PVZS", testPVZSExists)
+	t.Run("PVZCells", testPVZCellsExists)
+	t.Run("PVZStaffs", testPVZStaffsExists)
 	t.Run("Receptions", testReceptionsExists)
+	t.Run("RefreshTokens", testRefreshTokensExists)
+	t.Run("RevokedTokens", testRevokedTokensExists)
 	t.Run("SchemaMigrations", testSchemaMigrationsExists)
+	t.Run("StatusHistories", testStatusHistoriesExists)
 	t.Run("Users", testUsersExists)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesExists)
+	t.Run("Webhooks", testWebhooksExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("Cities", testCitiesFind)
+	t.Run("IdempotencyKeys", testIdempotencyKeysFind)
+	t.Run("Outboxes", testOutboxesFind)
+	t.Run("ProductAuditLogs", testProductAuditLogsFind)
+	t.Run("ProductTypes", testProductTypesFind)
 	t.Run("Products", testProductsFind)
 	t.Run("PVZS", testPVZSFind)
+	t.Run("PVZCells", testPVZCellsFind)
+	t.Run("PVZStaffs", testPVZStaffsFind)
 	t.Run("Receptions", testReceptionsFind)
+	t.Run("RefreshTokens", testRefreshTokensFind)
+	t.Run("RevokedTokens", testRevokedTokensFind)
 	t.Run("SchemaMigrations", testSchemaMigrationsFind)
+	t.Run("StatusHistories", testStatusHistoriesFind)
 	t.Run("Users", testUsersFind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesFind)
+	t.Run("Webhooks", testWebhooksFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("Cities", testCitiesBind)
+	t.Run("IdempotencyKeys", testIdempotencyKeysBind)
+	t.Run("Outboxes", testOutboxesBind)
+	t.Run("ProductAuditLogs", testProductAuditLogsBind)
+	t.Run("ProductTypes", testProductTypesBind)
 	t.Run("Products", testProductsBind)
 	t.Run("PVZS", testPVZSBind)
+	t.Run("PVZCells", testPVZCellsBind)
+	t.Run("PVZStaffs", testPVZStaffsBind)
 	t.Run("Receptions", testReceptionsBind)
+	t.Run("RefreshTokens", testRefreshTokensBind)
+	t.Run("RevokedTokens", testRevokedTokensBind)
 	t.Run("SchemaMigrations", testSchemaMigrationsBind)
+	t.Run("StatusHistories", testStatusHistoriesBind)
 	t.Run("Users", testUsersBind)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesBind)
+	t.Run("Webhooks", testWebhooksBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("Cities", testCitiesOne)
+	t.Run("IdempotencyKeys", testIdempotencyKeysOne)
+	t.Run("Outboxes", testOutboxesOne)
+	t.Run("ProductAuditLogs", testProductAuditLogsOne)
+	t.Run("ProductTypes", testProductTypesOne)
 	t.Run("Products", testProductsOne)
 	t.Run("PVZS", testPVZSOne)
+	t.Run("PVZCells", testPVZCellsOne)
+	t.Run("PVZStaffs", testPVZStaffsOne)
 	t.Run("Receptions", testReceptionsOne)
+	t.Run("RefreshTokens", testRefreshTokensOne)
+	t.Run("RevokedTokens", testRevokedTokensOne)
 	t.Run("SchemaMigrations", testSchemaMigrationsOne)
+	t.Run("StatusHistories", testStatusHistoriesOne)
 	t.Run("Users", testUsersOne)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesOne)
+	t.Run("Webhooks", testWebhooksOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("Cities", testCitiesAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysAll)
+	t.Run("Outboxes", testOutboxesAll)
+	t.Run("ProductAuditLogs", testProductAuditLogsAll)
+	t.Run("ProductTypes", testProductTypesAll)
 	t.Run("Products", testProductsAll)
 	t.Run("PVZS", testPVZSAll)
+	t.Run("PVZCells", testPVZCellsAll)
+	t.Run("PVZStaffs", testPVZStaffsAll)
 	t.Run("Receptions", testReceptionsAll)
+	t.Run("RefreshTokens", testRefreshTokensAll)
+	t.Run("RevokedTokens", testRevokedTokensAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsAll)
+	t.Run("StatusHistories", testStatusHistoriesAll)
 	t.Run("Users", testUsersAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesAll)
+	t.Run("Webhooks", testWebhooksAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("Cities", testCitiesCount)
+	t.Run("IdempotencyKeys", testIdempotencyKeysCount)
+	t.Run("Outboxes", testOutboxesCount)
+	t.Run("ProductAuditLogs", testProductAuditLogsCount)
+	t.Run("ProductTypes", testProductTypesCount)
 	t.Run("Products", testProductsCount)
 	t.Run("PVZS", testPVZSCount)
+	t.Run("PVZCells", testPVZCellsCount)
+	t.Run("PVZStaffs", testPVZStaffsCount)
 	t.Run("Receptions", testReceptionsCount)
+	t.Run("RefreshTokens", testRefreshTokensCount)
+	t.Run("RevokedTokens", testRevokedTokensCount)
 	t.Run("SchemaMigrations", testSchemaMigrationsCount)
+	t.Run("StatusHistories", testStatusHistoriesCount)
 	t.Run("Users", testUsersCount)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesCount)
+	t.Run("Webhooks", testWebhooksCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("Cities", testCitiesHooks)
+	t.Run("IdempotencyKeys", testIdempotencyKeysHooks)
+	t.Run("Outboxes", testOutboxesHooks)
+	t.Run("ProductAuditLogs", testProductAuditLogsHooks)
+	t.Run("ProductTypes", testProductTypesHooks)
 	t.Run("Products", testProductsHooks)
 	t.Run("PVZS", testPVZSHooks)
+	t.Run("PVZCells", testPVZCellsHooks)
+	t.Run("PVZStaffs", testPVZStaffsHooks)
 	t.Run("Receptions", testReceptionsHooks)
+	t.Run("RefreshTokens", testRefreshTokensHooks)
+	t.Run("RevokedTokens", testRevokedTokensHooks)
 	t.Run("SchemaMigrations", testSchemaMigrationsHooks)
+	t.Run("StatusHistories", testStatusHistoriesHooks)
 	t.Run("Users", testUsersHooks)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesHooks)
+	t.Run("Webhooks", testWebhooksHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("Cities", testCitiesInsert)
+	t.Run("Cities", testCitiesInsertWhitelist)
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsert)
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsertWhitelist)
+	t.Run("Outboxes", testOutboxesInsert)
+	t.Run("Outboxes", testOutboxesInsertWhitelist)
+	t.Run("ProductAuditLogs", testProductAuditLogsInsert)
+	t.Run("ProductAuditLogs", testProductAuditLogsInsertWhitelist)
+	t.Run("ProductTypes", testProductTypesInsert)
+	t.Run("ProductTypes", testProductTypesInsertWhitelist)
 	t.Run("Products", testProductsInsert)
 	t.Run("Products", testProductsInsertWhitelist)
 	t.Run("PVZS", testPVZSInsert)
 	t.Run("PVZS", testPVZSInsertWhitelist)
+	t.Run("PVZCells", testPVZCellsInsert)
+	t.Run("PVZCells", testPVZCellsInsertWhitelist)
+	t.Run("PVZStaffs", testPVZStaffsInsert)
+	t.Run("PVZStaffs", testPVZStaffsInsertWhitelist)
 	t.Run("Receptions", testReceptionsInsert)
 	t.Run("Receptions", testReceptionsInsertWhitelist)
+	t.Run("RefreshTokens", testRefreshTokensInsert)
+	t.Run("RefreshTokens", testRefreshTokensInsertWhitelist)
+	t.Run("RevokedTokens", testRevokedTokensInsert)
+	t.Run("RevokedTokens", testRevokedTokensInsertWhitelist)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsert)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsertWhitelist)
+	t.Run("StatusHistories", testStatusHistoriesInsert)
+	t.Run("StatusHistories", testStatusHistoriesInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsert)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesInsertWhitelist)
+	t.Run("Webhooks", testWebhooksInsert)
+	t.Run("Webhooks", testWebhooksInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("Cities", testCitiesReload)
+	t.Run("IdempotencyKeys", testIdempotencyKeysReload)
+	t.Run("Outboxes", testOutboxesReload)
+	t.Run("ProductAuditLogs", testProductAuditLogsReload)
+	t.Run("ProductTypes", testProductTypesReload)
 	t.Run("Products", testProductsReload)
 	t.Run("PVZS", testPVZSReload)
+	t.Run("PVZCells", testPVZCellsReload)
+	t.Run("PVZStaffs", testPVZStaffsReload)
 	t.Run("Receptions", testReceptionsReload)
+	t.Run("RefreshTokens", testRefreshTokensReload)
+	t.Run("RevokedTokens", testRevokedTokensReload)
 	t.Run("SchemaMigrations", testSchemaMigrationsReload)
+	t.Run("StatusHistories", testStatusHistoriesReload)
 	t.Run("Users", testUsersReload)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReload)
+	t.Run("Webhooks", testWebhooksReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("Cities", testCitiesReloadAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysReloadAll)
+	t.Run("Outboxes", testOutboxesReloadAll)
+	t.Run("ProductAuditLogs", testProductAuditLogsReloadAll)
+	t.Run("ProductTypes", testProductTypesReloadAll)
 	t.Run("Products", testProductsReloadAll)
 	t.Run("PVZS", testPVZSReloadAll)
+	t.Run("PVZCells", testPVZCellsReloadAll)
+	t.Run("PVZStaffs", testPVZStaffsReloadAll)
 	t.Run("Receptions", testReceptionsReloadAll)
+	t.Run("RefreshTokens", testRefreshTokensReloadAll)
+	t.Run("RevokedTokens", testRevokedTokensReloadAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsReloadAll)
+	t.Run("StatusHistories", testStatusHistoriesReloadAll)
 	t.Run("Users", testUsersReloadAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesReloadAll)
+	t.Run("Webhooks", testWebhooksReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("Cities", testCitiesSelect)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSelect)
+	t.Run("Outboxes", testOutboxesSelect)
+	t.Run("ProductAuditLogs", testProductAuditLogsSelect)
+	t.Run("ProductTypes", testProductTypesSelect)
 	t.Run("Products", testProductsSelect)
 	t.Run("PVZS", testPVZSSelect)
+	t.Run("PVZCells", testPVZCellsSelect)
+	t.Run("PVZStaffs", testPVZStaffsSelect)
 	t.Run("Receptions", testReceptionsSelect)
+	t.Run("RefreshTokens", testRefreshTokensSelect)
+	t.Run("RevokedTokens", testRevokedTokensSelect)
 	t.Run("SchemaMigrations", testSchemaMigrationsSelect)
+	t.Run("StatusHistories", testStatusHistoriesSelect)
 	t.Run("Users", testUsersSelect)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSelect)
+	t.Run("Webhooks", testWebhooksSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("Cities", testCitiesUpdate)
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpdate)
+	t.Run("Outboxes", testOutboxesUpdate)
+	t.Run("ProductAuditLogs", testProductAuditLogsUpdate)
+	t.Run("ProductTypes", testProductTypesUpdate)
 	t.Run("Products", testProductsUpdate)
 	t.Run("PVZS", testPVZSUpdate)
+	t.Run("PVZCells", testPVZCellsUpdate)
+	t.Run("PVZStaffs", testPVZStaffsUpdate)
 	t.Run("Receptions", testReceptionsUpdate)
+	t.Run("RefreshTokens", testRefreshTokensUpdate)
+	t.Run("RevokedTokens", testRevokedTokensUpdate)
 	t.Run("SchemaMigrations", testSchemaMigrationsUpdate)
+	t.Run("StatusHistories", testStatusHistoriesUpdate)
 	t.Run("Users", testUsersUpdate)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpdate)
+	t.Run("Webhooks", testWebhooksUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("Cities", testCitiesSliceUpdateAll)
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceUpdateAll)
+	t.Run("Outboxes", testOutboxesSliceUpdateAll)
+	t.Run("ProductAuditLogs", testProductAuditLogsSliceUpdateAll)
+	t.Run("ProductTypes", testProductTypesSliceUpdateAll)
 	t.Run("Products", testProductsSliceUpdateAll)
 	t.Run("PVZS", testPVZSSliceUpdateAll)
+	t.Run("PVZCells", testPVZCellsSliceUpdateAll)
+	t.Run("PVZStaffs", testPVZStaffsSliceUpdateAll)
 	t.Run("Receptions", testReceptionsSliceUpdateAll)
+	t.Run("RefreshTokens", testRefreshTokensSliceUpdateAll)
+	t.Run("RevokedTokens", testRevokedTokensSliceUpdateAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceUpdateAll)
+	t.Run("StatusHistories", testStatusHistoriesSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
+	t.Run("WebhookDeliveries", testWebhookDeliveriesSliceUpdateAll)
+	t.Run("Webhooks", testWebhooksSliceUpdateAll)
 }
@@ -4,15 +4,39 @@
 package models
 
 var TableNames = struct {
-	Products         string
-	PVZ              string
-	Receptions       string
-	SchemaMigrations string
-	Users            string
+	Cities            string
+	IdempotencyKeys   string
+	Outbox            string
+	ProductAuditLog   string
+	ProductTypes      string
+	Products          string
+	PVZ               string
+	PVZCells          string
+	PVZStaff          string
+	Receptions        string
+	RefreshTokens     string
+	RevokedTokens     string
+	SchemaMigrations  string
+	StatusHistory     string
+	Users             string
+	WebhookDeliveries string
+	Webhooks          string
 }{
-	Products:         "products",
-	PVZ:              "pvz",
-	Receptions:       "receptions",
-	SchemaMigrations: "schema_migrations",
-	Users:            "users",
+	Cities:            "cities",
+	IdempotencyKeys:   "idempotency_keys",
+	Outbox:            "outbox",
+	ProductAuditLog:   "product_audit_log",
+	ProductTypes:      "product_types",
+	Products:          "products",
+	PVZ:               "pvz",
+	PVZCells:          "pvz_cells",
+	PVZStaff:          "pvz_staff",
+	Receptions:        "receptions",
+	RefreshTokens:     "refresh_tokens",
+	RevokedTokens:     "revoked_tokens",
+	SchemaMigrations:  "schema_migrations",
+	StatusHistory:     "status_history",
+	Users:             "users",
+	WebhookDeliveries: "webhook_deliveries",
+	Webhooks:          "webhooks",
 }
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testIdempotencyKeys(t *testing.T) {
+	t.Parallel()
+
+	query := IdempotencyKeys()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testIdempotencyKeysDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testIdempotencyKeysQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := IdempotencyKeys().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testIdempotencyKeysSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := IdempotencyKeySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testIdempotencyKeysExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := IdempotencyKeyExists(ctx, tx, o.UserID, o.Key)
+	if err != nil {
+		t.Errorf("Unable to check if IdempotencyKey exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected IdempotencyKeyExists to return true, but got false.")
+	}
+}
+
+func testIdempotencyKeysFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	idempotencyKeyFound, err := FindIdempotencyKey(ctx, tx, o.UserID, o.Key)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if idempotencyKeyFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testIdempotencyKeysBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = IdempotencyKeys().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testIdempotencyKeysOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := IdempotencyKeys().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testIdempotencyKeysAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	idempotencyKeyOne := &IdempotencyKey{}
+	idempotencyKeyTwo := &IdempotencyKey{}
+	if err = randomize.Struct(seed, idempotencyKeyOne, idempotencyKeyDBTypes, false, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+	if err = randomize.Struct(seed, idempotencyKeyTwo, idempotencyKeyDBTypes, false, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = idempotencyKeyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = idempotencyKeyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := IdempotencyKeys().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testIdempotencyKeysCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	idempotencyKeyOne := &IdempotencyKey{}
+	idempotencyKeyTwo := &IdempotencyKey{}
+	if err = randomize.Struct(seed, idempotencyKeyOne, idempotencyKeyDBTypes, false, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+	if err = randomize.Struct(seed, idempotencyKeyTwo, idempotencyKeyDBTypes, false, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = idempotencyKeyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = idempotencyKeyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func idempotencyKeyBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *IdempotencyKey) error {
+	*o = IdempotencyKey{}
+	return nil
+}
+
+func idempotencyKeyAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *IdempotencyKey) error {
+	*o = IdempotencyKey{}
+	return nil
+}
+
+func idempotencyKeyAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *IdempotencyKey) error {
+	*o = IdempotencyKey{}
+	return nil
+}
+
+func idempotencyKeyBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *IdempotencyKey) error {
+	*o = IdempotencyKey{}
+	return nil
+}
+
+func idempotencyKeyAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *IdempotencyKey) error {
+	*o = IdempotencyKey{}
+	return nil
+}
+
+func idempotencyKeyBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *IdempotencyKey) error {
+	*o = IdempotencyKey{}
+	return nil
+}
+
+func idempotencyKeyAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *IdempotencyKey) error {
+	*o = IdempotencyKey{}
+	return nil
+}
+
+func idempotencyKeyBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *IdempotencyKey) error {
+	*o = IdempotencyKey{}
+	return nil
+}
+
+func idempotencyKeyAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *IdempotencyKey) error {
+	*o = IdempotencyKey{}
+	return nil
+}
+
+func testIdempotencyKeysHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &IdempotencyKey{}
+	o := &IdempotencyKey{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey object: %s", err)
+	}
+
+	AddIdempotencyKeyHook(boil.BeforeInsertHook, idempotencyKeyBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	idempotencyKeyBeforeInsertHooks = []IdempotencyKeyHook{}
+
+	AddIdempotencyKeyHook(boil.AfterInsertHook, idempotencyKeyAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	idempotencyKeyAfterInsertHooks = []IdempotencyKeyHook{}
+
+	AddIdempotencyKeyHook(boil.AfterSelectHook, idempotencyKeyAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	idempotencyKeyAfterSelectHooks = []IdempotencyKeyHook{}
+
+	AddIdempotencyKeyHook(boil.BeforeUpdateHook, idempotencyKeyBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	idempotencyKeyBeforeUpdateHooks = []IdempotencyKeyHook{}
+
+	AddIdempotencyKeyHook(boil.AfterUpdateHook, idempotencyKeyAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	idempotencyKeyAfterUpdateHooks = []IdempotencyKeyHook{}
+
+	AddIdempotencyKeyHook(boil.BeforeDeleteHook, idempotencyKeyBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	idempotencyKeyBeforeDeleteHooks = []IdempotencyKeyHook{}
+
+	AddIdempotencyKeyHook(boil.AfterDeleteHook, idempotencyKeyAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	idempotencyKeyAfterDeleteHooks = []IdempotencyKeyHook{}
+
+	AddIdempotencyKeyHook(boil.BeforeUpsertHook, idempotencyKeyBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	idempotencyKeyBeforeUpsertHooks = []IdempotencyKeyHook{}
+
+	AddIdempotencyKeyHook(boil.AfterUpsertHook, idempotencyKeyAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	idempotencyKeyAfterUpsertHooks = []IdempotencyKeyHook{}
+}
+
+func testIdempotencyKeysInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testIdempotencyKeysInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(idempotencyKeyPrimaryKeyColumns, idempotencyKeyColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testIdempotencyKeysReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testIdempotencyKeysReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := IdempotencyKeySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testIdempotencyKeysSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := IdempotencyKeys().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	idempotencyKeyDBTypes = map[string]string{`UserID`: `character varying`, `Key`: `character varying`, `RequestHash`: `character varying`, `StatusCode`: `integer`, `ContentType`: `character varying`, `ResponseBody`: `bytea`, `CreatedAt`: `timestamp without time zone`, `ExpiresAt`: `timestamp without time zone`, `LockedUntil`: `timestamp without time zone`}
+	_                     = bytes.MinRead
+)
+
+func testIdempotencyKeysUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(idempotencyKeyPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(idempotencyKeyAllColumns) == len(idempotencyKeyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testIdempotencyKeysSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(idempotencyKeyAllColumns) == len(idempotencyKeyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &IdempotencyKey{}
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, idempotencyKeyDBTypes, true, idempotencyKeyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(idempotencyKeyAllColumns, idempotencyKeyPrimaryKeyColumns) {
+		fields = idempotencyKeyAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			idempotencyKeyAllColumns,
+			idempotencyKeyPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := IdempotencyKeySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testIdempotencyKeysUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(idempotencyKeyAllColumns) == len(idempotencyKeyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := IdempotencyKey{}
+	if err = randomize.Struct(seed, &o, idempotencyKeyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert IdempotencyKey: %s", err)
+	}
+
+	count, err := IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, idempotencyKeyDBTypes, false, idempotencyKeyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize IdempotencyKey struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert IdempotencyKey: %s", err)
+	}
+
+	count, err = IdempotencyKeys().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOutboxes(t *testing.T) {
+	t.Parallel()
+
+	query := Outboxes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOutboxesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOutboxesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Outboxes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOutboxesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OutboxSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOutboxesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OutboxExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Outbox exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OutboxExists to return true, but got false.")
+	}
+}
+
+func testOutboxesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	outboxFound, err := FindOutbox(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if outboxFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOutboxesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Outboxes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOutboxesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Outboxes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOutboxesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	outboxOne := &Outbox{}
+	outboxTwo := &Outbox{}
+	if err = randomize.Struct(seed, outboxOne, outboxDBTypes, false, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+	if err = randomize.Struct(seed, outboxTwo, outboxDBTypes, false, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = outboxOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = outboxTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Outboxes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOutboxesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	outboxOne := &Outbox{}
+	outboxTwo := &Outbox{}
+	if err = randomize.Struct(seed, outboxOne, outboxDBTypes, false, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+	if err = randomize.Struct(seed, outboxTwo, outboxDBTypes, false, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = outboxOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = outboxTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func outboxBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Outbox) error {
+	*o = Outbox{}
+	return nil
+}
+
+func outboxAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Outbox) error {
+	*o = Outbox{}
+	return nil
+}
+
+func outboxAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Outbox) error {
+	*o = Outbox{}
+	return nil
+}
+
+func outboxBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Outbox) error {
+	*o = Outbox{}
+	return nil
+}
+
+func outboxAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Outbox) error {
+	*o = Outbox{}
+	return nil
+}
+
+func outboxBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Outbox) error {
+	*o = Outbox{}
+	return nil
+}
+
+func outboxAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Outbox) error {
+	*o = Outbox{}
+	return nil
+}
+
+func outboxBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Outbox) error {
+	*o = Outbox{}
+	return nil
+}
+
+func outboxAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Outbox) error {
+	*o = Outbox{}
+	return nil
+}
+
+func testOutboxesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Outbox{}
+	o := &Outbox{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, outboxDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Outbox object: %s", err)
+	}
+
+	AddOutboxHook(boil.BeforeInsertHook, outboxBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	outboxBeforeInsertHooks = []OutboxHook{}
+
+	AddOutboxHook(boil.AfterInsertHook, outboxAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	outboxAfterInsertHooks = []OutboxHook{}
+
+	AddOutboxHook(boil.AfterSelectHook, outboxAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	outboxAfterSelectHooks = []OutboxHook{}
+
+	AddOutboxHook(boil.BeforeUpdateHook, outboxBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	outboxBeforeUpdateHooks = []OutboxHook{}
+
+	AddOutboxHook(boil.AfterUpdateHook, outboxAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	outboxAfterUpdateHooks = []OutboxHook{}
+
+	AddOutboxHook(boil.BeforeDeleteHook, outboxBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	outboxBeforeDeleteHooks = []OutboxHook{}
+
+	AddOutboxHook(boil.AfterDeleteHook, outboxAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	outboxAfterDeleteHooks = []OutboxHook{}
+
+	AddOutboxHook(boil.BeforeUpsertHook, outboxBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	outboxBeforeUpsertHooks = []OutboxHook{}
+
+	AddOutboxHook(boil.AfterUpsertHook, outboxAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	outboxAfterUpsertHooks = []OutboxHook{}
+}
+
+func testOutboxesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOutboxesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(outboxPrimaryKeyColumns, outboxColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOutboxesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOutboxesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OutboxSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOutboxesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Outboxes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	outboxDBTypes = map[string]string{`ID`: `uuid`, `AggregateType`: `character varying`, `AggregateID`: `character varying`, `EventType`: `character varying`, `Payload`: `jsonb`, `CreatedAt`: `timestamp without time zone`, `Attempts`: `integer`, `NextAttemptAt`: `timestamp without time zone`, `LastError`: `text`, `PublishedAt`: `timestamp without time zone`}
+	_             = bytes.MinRead
+)
+
+func testOutboxesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(outboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(outboxAllColumns) == len(outboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOutboxesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(outboxAllColumns) == len(outboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Outbox{}
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, outboxDBTypes, true, outboxPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(outboxAllColumns, outboxPrimaryKeyColumns) {
+		fields = outboxAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			outboxAllColumns,
+			outboxPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OutboxSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOutboxesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(outboxAllColumns) == len(outboxPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Outbox{}
+	if err = randomize.Struct(seed, &o, outboxDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Outbox: %s", err)
+	}
+
+	count, err := Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, outboxDBTypes, false, outboxPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Outbox struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Outbox: %s", err)
+	}
+
+	count, err = Outboxes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
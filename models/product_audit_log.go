@@ -0,0 +1,1417 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// ProductAuditLog is an object representing the database table.
+type ProductAuditLog struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Action      string      `boil:"action" json:"action" toml:"action" yaml:"action"`
+	ProductID   string      `boil:"product_id" json:"product_id" toml:"product_id" yaml:"product_id"`
+	ReceptionID string      `boil:"reception_id" json:"reception_id" toml:"reception_id" yaml:"reception_id"`
+	ProductType string      `boil:"product_type" json:"product_type" toml:"product_type" yaml:"product_type"`
+	Barcode     null.String `boil:"barcode" json:"barcode,omitempty" toml:"barcode" yaml:"barcode,omitempty"`
+	ActorID     null.String `boil:"actor_id" json:"actor_id,omitempty" toml:"actor_id" yaml:"actor_id,omitempty"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *productAuditLogR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L productAuditLogL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ProductAuditLogColumns = struct {
+	ID          string
+	Action      string
+	ProductID   string
+	ReceptionID string
+	ProductType string
+	Barcode     string
+	ActorID     string
+	CreatedAt   string
+}{
+	ID:          "id",
+	Action:      "action",
+	ProductID:   "product_id",
+	ReceptionID: "reception_id",
+	ProductType: "product_type",
+	Barcode:     "barcode",
+	ActorID:     "actor_id",
+	CreatedAt:   "created_at",
+}
+
+var ProductAuditLogTableColumns = struct {
+	ID          string
+	Action      string
+	ProductID   string
+	ReceptionID string
+	ProductType string
+	Barcode     string
+	ActorID     string
+	CreatedAt   string
+}{
+	ID:          "product_audit_log.id",
+	Action:      "product_audit_log.action",
+	ProductID:   "product_audit_log.product_id",
+	ReceptionID: "product_audit_log.reception_id",
+	ProductType: "product_audit_log.product_type",
+	Barcode:     "product_audit_log.barcode",
+	ActorID:     "product_audit_log.actor_id",
+	CreatedAt:   "product_audit_log.created_at",
+}
+
+// Generated where
+
+var ProductAuditLogWhere = struct {
+	ID          whereHelperstring
+	Action      whereHelperstring
+	ProductID   whereHelperstring
+	ReceptionID whereHelperstring
+	ProductType whereHelperstring
+	Barcode     whereHelpernull_String
+	ActorID     whereHelpernull_String
+	CreatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"product_audit_log\".\"id\""},
+	Action:      whereHelperstring{field: "\"product_audit_log\".\"action\""},
+	ProductID:   whereHelperstring{field: "\"product_audit_log\".\"product_id\""},
+	ReceptionID: whereHelperstring{field: "\"product_audit_log\".\"reception_id\""},
+	ProductType: whereHelperstring{field: "\"product_audit_log\".\"product_type\""},
+	Barcode:     whereHelpernull_String{field: "\"product_audit_log\".\"barcode\""},
+	ActorID:     whereHelpernull_String{field: "\"product_audit_log\".\"actor_id\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"product_audit_log\".\"created_at\""},
+}
+
+// ProductAuditLogRels is where relationship names are stored.
+var ProductAuditLogRels = struct {
+	Reception string
+	Actor     string
+}{
+	Reception: "Reception",
+	Actor:     "Actor",
+}
+
+// productAuditLogR is where relationships are stored.
+type productAuditLogR struct {
+	Reception *Reception `boil:"Reception" json:"Reception" toml:"Reception" yaml:"Reception"`
+	Actor     *User      `boil:"Actor" json:"Actor" toml:"Actor" yaml:"Actor"`
+}
+
+// NewStruct creates a new relationship struct
+func (*productAuditLogR) NewStruct() *productAuditLogR {
+	return &productAuditLogR{}
+}
+
+func (o *ProductAuditLog) GetReception() *Reception {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetReception()
+}
+
+func (r *productAuditLogR) GetReception() *Reception {
+	if r == nil {
+		return nil
+	}
+
+	return r.Reception
+}
+
+func (o *ProductAuditLog) GetActor() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetActor()
+}
+
+func (r *productAuditLogR) GetActor() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Actor
+}
+
+// productAuditLogL is where Load methods for each relationship are stored.
+type productAuditLogL struct{}
+
+var (
+	productAuditLogAllColumns            = []string{"id", "action", "product_id", "reception_id", "product_type", "barcode", "actor_id", "created_at"}
+	productAuditLogColumnsWithoutDefault = []string{"id", "action", "product_id", "reception_id", "product_type"}
+	productAuditLogColumnsWithDefault    = []string{"barcode", "actor_id", "created_at"}
+	productAuditLogPrimaryKeyColumns     = []string{"id"}
+	productAuditLogGeneratedColumns      = []string{}
+)
+
+type (
+	// ProductAuditLogSlice is an alias for a slice of pointers to ProductAuditLog.
+	// This should almost always be used instead of []ProductAuditLog.
+	ProductAuditLogSlice []*ProductAuditLog
+	// ProductAuditLogHook is the signature for custom ProductAuditLog hook methods
+	ProductAuditLogHook func(context.Context, boil.ContextExecutor, *ProductAuditLog) error
+
+	productAuditLogQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	productAuditLogType                 = reflect.TypeOf(&ProductAuditLog{})
+	productAuditLogMapping              = queries.MakeStructMapping(productAuditLogType)
+	productAuditLogPrimaryKeyMapping, _ = queries.BindMapping(productAuditLogType, productAuditLogMapping, productAuditLogPrimaryKeyColumns)
+	productAuditLogInsertCacheMut       sync.RWMutex
+	productAuditLogInsertCache          = make(map[string]insertCache)
+	productAuditLogUpdateCacheMut       sync.RWMutex
+	productAuditLogUpdateCache          = make(map[string]updateCache)
+	productAuditLogUpsertCacheMut       sync.RWMutex
+	productAuditLogUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var productAuditLogAfterSelectMu sync.Mutex
+var productAuditLogAfterSelectHooks []ProductAuditLogHook
+
+var productAuditLogBeforeInsertMu sync.Mutex
+var productAuditLogBeforeInsertHooks []ProductAuditLogHook
+var productAuditLogAfterInsertMu sync.Mutex
+var productAuditLogAfterInsertHooks []ProductAuditLogHook
+
+var productAuditLogBeforeUpdateMu sync.Mutex
+var productAuditLogBeforeUpdateHooks []ProductAuditLogHook
+var productAuditLogAfterUpdateMu sync.Mutex
+var productAuditLogAfterUpdateHooks []ProductAuditLogHook
+
+var productAuditLogBeforeDeleteMu sync.Mutex
+var productAuditLogBeforeDeleteHooks []ProductAuditLogHook
+var productAuditLogAfterDeleteMu sync.Mutex
+var productAuditLogAfterDeleteHooks []ProductAuditLogHook
+
+var productAuditLogBeforeUpsertMu sync.Mutex
+var productAuditLogBeforeUpsertHooks []ProductAuditLogHook
+var productAuditLogAfterUpsertMu sync.Mutex
+var productAuditLogAfterUpsertHooks []ProductAuditLogHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ProductAuditLog) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAuditLogAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ProductAuditLog) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAuditLogBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ProductAuditLog) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAuditLogAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ProductAuditLog) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAuditLogBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ProductAuditLog) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAuditLogAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ProductAuditLog) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAuditLogBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ProductAuditLog) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAuditLogAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ProductAuditLog) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAuditLogBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ProductAuditLog) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productAuditLogAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddProductAuditLogHook registers your hook function for all future operations.
+func AddProductAuditLogHook(hookPoint boil.HookPoint, productAuditLogHook ProductAuditLogHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		productAuditLogAfterSelectMu.Lock()
+		productAuditLogAfterSelectHooks = append(productAuditLogAfterSelectHooks, productAuditLogHook)
+		productAuditLogAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		productAuditLogBeforeInsertMu.Lock()
+		productAuditLogBeforeInsertHooks = append(productAuditLogBeforeInsertHooks, productAuditLogHook)
+		productAuditLogBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		productAuditLogAfterInsertMu.Lock()
+		productAuditLogAfterInsertHooks = append(productAuditLogAfterInsertHooks, productAuditLogHook)
+		productAuditLogAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		productAuditLogBeforeUpdateMu.Lock()
+		productAuditLogBeforeUpdateHooks = append(productAuditLogBeforeUpdateHooks, productAuditLogHook)
+		productAuditLogBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		productAuditLogAfterUpdateMu.Lock()
+		productAuditLogAfterUpdateHooks = append(productAuditLogAfterUpdateHooks, productAuditLogHook)
+		productAuditLogAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		productAuditLogBeforeDeleteMu.Lock()
+		productAuditLogBeforeDeleteHooks = append(productAuditLogBeforeDeleteHooks, productAuditLogHook)
+		productAuditLogBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		productAuditLogAfterDeleteMu.Lock()
+		productAuditLogAfterDeleteHooks = append(productAuditLogAfterDeleteHooks, productAuditLogHook)
+		productAuditLogAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		productAuditLogBeforeUpsertMu.Lock()
+		productAuditLogBeforeUpsertHooks = append(productAuditLogBeforeUpsertHooks, productAuditLogHook)
+		productAuditLogBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		productAuditLogAfterUpsertMu.Lock()
+		productAuditLogAfterUpsertHooks = append(productAuditLogAfterUpsertHooks, productAuditLogHook)
+		productAuditLogAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single productAuditLog record from the query.
+func (q productAuditLogQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ProductAuditLog, error) {
+	o := &ProductAuditLog{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for product_audit_log")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ProductAuditLog records from the query.
+func (q productAuditLogQuery) All(ctx context.Context, exec boil.ContextExecutor) (ProductAuditLogSlice, error) {
+	var o []*ProductAuditLog
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ProductAuditLog slice")
+	}
+
+	if len(productAuditLogAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ProductAuditLog records in the query.
+func (q productAuditLogQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count product_audit_log rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q productAuditLogQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if product_audit_log exists")
+	}
+
+	return count > 0, nil
+}
+
+// Reception pointed to by the foreign key.
+func (o *ProductAuditLog) Reception(mods ...qm.QueryMod) receptionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReceptionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Receptions(queryMods...)
+}
+
+// Actor pointed to by the foreign key.
+func (o *ProductAuditLog) Actor(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ActorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadReception allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productAuditLogL) LoadReception(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductAuditLog interface{}, mods queries.Applicator) error {
+	var slice []*ProductAuditLog
+	var object *ProductAuditLog
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductAuditLog.(*ProductAuditLog)
+		if !ok {
+			object = new(ProductAuditLog)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductAuditLog))
+			}
+		}
+	} else {
+		s, ok := maybeProductAuditLog.(*[]*ProductAuditLog)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductAuditLog))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productAuditLogR{}
+		}
+		args[object.ReceptionID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productAuditLogR{}
+			}
+
+			args[obj.ReceptionID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`receptions`),
+		qm.WhereIn(`receptions.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Reception")
+	}
+
+	var resultSlice []*Reception
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Reception")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for receptions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for receptions")
+	}
+
+	if len(receptionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Reception = foreign
+		if foreign.R == nil {
+			foreign.R = &receptionR{}
+		}
+		foreign.R.ProductAuditLogs = append(foreign.R.ProductAuditLogs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ReceptionID == foreign.ID {
+				local.R.Reception = foreign
+				if foreign.R == nil {
+					foreign.R = &receptionR{}
+				}
+				foreign.R.ProductAuditLogs = append(foreign.R.ProductAuditLogs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadActor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productAuditLogL) LoadActor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductAuditLog interface{}, mods queries.Applicator) error {
+	var slice []*ProductAuditLog
+	var object *ProductAuditLog
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductAuditLog.(*ProductAuditLog)
+		if !ok {
+			object = new(ProductAuditLog)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductAuditLog))
+			}
+		}
+	} else {
+		s, ok := maybeProductAuditLog.(*[]*ProductAuditLog)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductAuditLog)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductAuditLog))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productAuditLogR{}
+		}
+		if !queries.IsNil(object.ActorID) {
+			args[object.ActorID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productAuditLogR{}
+			}
+
+			if !queries.IsNil(obj.ActorID) {
+				args[obj.ActorID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Actor = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ActorProductAuditLogs = append(foreign.R.ActorProductAuditLogs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ActorID, foreign.ID) {
+				local.R.Actor = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ActorProductAuditLogs = append(foreign.R.ActorProductAuditLogs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetReception of the productAuditLog to the related item.
+// Sets o.R.Reception to related.
+// Adds o to related.R.ProductAuditLogs.
+func (o *ProductAuditLog) SetReception(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Reception) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"product_audit_log\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"reception_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productAuditLogPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ReceptionID = related.ID
+	if o.R == nil {
+		o.R = &productAuditLogR{
+			Reception: related,
+		}
+	} else {
+		o.R.Reception = related
+	}
+
+	if related.R == nil {
+		related.R = &receptionR{
+			ProductAuditLogs: ProductAuditLogSlice{o},
+		}
+	} else {
+		related.R.ProductAuditLogs = append(related.R.ProductAuditLogs, o)
+	}
+
+	return nil
+}
+
+// SetActor of the productAuditLog to the related item.
+// Sets o.R.Actor to related.
+// Adds o to related.R.ActorProductAuditLogs.
+func (o *ProductAuditLog) SetActor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"product_audit_log\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productAuditLogPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ActorID, related.ID)
+	if o.R == nil {
+		o.R = &productAuditLogR{
+			Actor: related,
+		}
+	} else {
+		o.R.Actor = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ActorProductAuditLogs: ProductAuditLogSlice{o},
+		}
+	} else {
+		related.R.ActorProductAuditLogs = append(related.R.ActorProductAuditLogs, o)
+	}
+
+	return nil
+}
+
+// RemoveActor relationship.
+// Sets o.R.Actor to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ProductAuditLog) RemoveActor(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ActorID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("actor_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Actor = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ActorProductAuditLogs {
+		if queries.Equal(o.ActorID, ri.ActorID) {
+			continue
+		}
+
+		ln := len(related.R.ActorProductAuditLogs)
+		if ln > 1 && i < ln-1 {
+			related.R.ActorProductAuditLogs[i] = related.R.ActorProductAuditLogs[ln-1]
+		}
+		related.R.ActorProductAuditLogs = related.R.ActorProductAuditLogs[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ProductAuditLogs retrieves all the records using an executor.
+func ProductAuditLogs(mods ...qm.QueryMod) productAuditLogQuery {
+	mods = append(mods, qm.From("\"product_audit_log\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"product_audit_log\".*"})
+	}
+
+	return productAuditLogQuery{q}
+}
+
+// FindProductAuditLog retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindProductAuditLog(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ProductAuditLog, error) {
+	productAuditLogObj := &ProductAuditLog{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"product_audit_log\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, productAuditLogObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from product_audit_log")
+	}
+
+	if err = productAuditLogObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return productAuditLogObj, err
+	}
+
+	return productAuditLogObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ProductAuditLog) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no product_audit_log provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productAuditLogColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	productAuditLogInsertCacheMut.RLock()
+	cache, cached := productAuditLogInsertCache[key]
+	productAuditLogInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			productAuditLogAllColumns,
+			productAuditLogColumnsWithDefault,
+			productAuditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(productAuditLogType, productAuditLogMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(productAuditLogType, productAuditLogMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"product_audit_log\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"product_audit_log\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into product_audit_log")
+	}
+
+	if !cached {
+		productAuditLogInsertCacheMut.Lock()
+		productAuditLogInsertCache[key] = cache
+		productAuditLogInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ProductAuditLog.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ProductAuditLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	productAuditLogUpdateCacheMut.RLock()
+	cache, cached := productAuditLogUpdateCache[key]
+	productAuditLogUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			productAuditLogAllColumns,
+			productAuditLogPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update product_audit_log, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"product_audit_log\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, productAuditLogPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(productAuditLogType, productAuditLogMapping, append(wl, productAuditLogPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update product_audit_log row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for product_audit_log")
+	}
+
+	if !cached {
+		productAuditLogUpdateCacheMut.Lock()
+		productAuditLogUpdateCache[key] = cache
+		productAuditLogUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q productAuditLogQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for product_audit_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for product_audit_log")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ProductAuditLogSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productAuditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"product_audit_log\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, productAuditLogPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in productAuditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all productAuditLog")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ProductAuditLog) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no product_audit_log provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productAuditLogColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	productAuditLogUpsertCacheMut.RLock()
+	cache, cached := productAuditLogUpsertCache[key]
+	productAuditLogUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			productAuditLogAllColumns,
+			productAuditLogColumnsWithDefault,
+			productAuditLogColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			productAuditLogAllColumns,
+			productAuditLogPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert product_audit_log, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(productAuditLogAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(productAuditLogPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert product_audit_log, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(productAuditLogPrimaryKeyColumns))
+			copy(conflict, productAuditLogPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"product_audit_log\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(productAuditLogType, productAuditLogMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(productAuditLogType, productAuditLogMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert product_audit_log")
+	}
+
+	if !cached {
+		productAuditLogUpsertCacheMut.Lock()
+		productAuditLogUpsertCache[key] = cache
+		productAuditLogUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ProductAuditLog record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ProductAuditLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ProductAuditLog provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), productAuditLogPrimaryKeyMapping)
+	sql := "DELETE FROM \"product_audit_log\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from product_audit_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for product_audit_log")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q productAuditLogQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no productAuditLogQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from product_audit_log")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_audit_log")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ProductAuditLogSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(productAuditLogBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productAuditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"product_audit_log\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productAuditLogPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from productAuditLog slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_audit_log")
+	}
+
+	if len(productAuditLogAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ProductAuditLog) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindProductAuditLog(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ProductAuditLogSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ProductAuditLogSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productAuditLogPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"product_audit_log\".* FROM \"product_audit_log\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productAuditLogPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ProductAuditLogSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ProductAuditLogExists checks if the ProductAuditLog row exists.
+func ProductAuditLogExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"product_audit_log\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if product_audit_log exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ProductAuditLog row exists.
+func (o *ProductAuditLog) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ProductAuditLogExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1020 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testProductAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	query := ProductAuditLogs()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testProductAuditLogsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testProductAuditLogsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ProductAuditLogs().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testProductAuditLogsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ProductAuditLogSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testProductAuditLogsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ProductAuditLogExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ProductAuditLog exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ProductAuditLogExists to return true, but got false.")
+	}
+}
+
+func testProductAuditLogsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	productAuditLogFound, err := FindProductAuditLog(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if productAuditLogFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testProductAuditLogsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ProductAuditLogs().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testProductAuditLogsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ProductAuditLogs().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testProductAuditLogsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	productAuditLogOne := &ProductAuditLog{}
+	productAuditLogTwo := &ProductAuditLog{}
+	if err = randomize.Struct(seed, productAuditLogOne, productAuditLogDBTypes, false, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, productAuditLogTwo, productAuditLogDBTypes, false, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = productAuditLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = productAuditLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ProductAuditLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testProductAuditLogsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	productAuditLogOne := &ProductAuditLog{}
+	productAuditLogTwo := &ProductAuditLog{}
+	if err = randomize.Struct(seed, productAuditLogOne, productAuditLogDBTypes, false, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+	if err = randomize.Struct(seed, productAuditLogTwo, productAuditLogDBTypes, false, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = productAuditLogOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = productAuditLogTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func productAuditLogBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ProductAuditLog) error {
+	*o = ProductAuditLog{}
+	return nil
+}
+
+func productAuditLogAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ProductAuditLog) error {
+	*o = ProductAuditLog{}
+	return nil
+}
+
+func productAuditLogAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ProductAuditLog) error {
+	*o = ProductAuditLog{}
+	return nil
+}
+
+func productAuditLogBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ProductAuditLog) error {
+	*o = ProductAuditLog{}
+	return nil
+}
+
+func productAuditLogAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ProductAuditLog) error {
+	*o = ProductAuditLog{}
+	return nil
+}
+
+func productAuditLogBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ProductAuditLog) error {
+	*o = ProductAuditLog{}
+	return nil
+}
+
+func productAuditLogAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ProductAuditLog) error {
+	*o = ProductAuditLog{}
+	return nil
+}
+
+func productAuditLogBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ProductAuditLog) error {
+	*o = ProductAuditLog{}
+	return nil
+}
+
+func productAuditLogAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ProductAuditLog) error {
+	*o = ProductAuditLog{}
+	return nil
+}
+
+func testProductAuditLogsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ProductAuditLog{}
+	o := &ProductAuditLog{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog object: %s", err)
+	}
+
+	AddProductAuditLogHook(boil.BeforeInsertHook, productAuditLogBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	productAuditLogBeforeInsertHooks = []ProductAuditLogHook{}
+
+	AddProductAuditLogHook(boil.AfterInsertHook, productAuditLogAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	productAuditLogAfterInsertHooks = []ProductAuditLogHook{}
+
+	AddProductAuditLogHook(boil.AfterSelectHook, productAuditLogAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	productAuditLogAfterSelectHooks = []ProductAuditLogHook{}
+
+	AddProductAuditLogHook(boil.BeforeUpdateHook, productAuditLogBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	productAuditLogBeforeUpdateHooks = []ProductAuditLogHook{}
+
+	AddProductAuditLogHook(boil.AfterUpdateHook, productAuditLogAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	productAuditLogAfterUpdateHooks = []ProductAuditLogHook{}
+
+	AddProductAuditLogHook(boil.BeforeDeleteHook, productAuditLogBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	productAuditLogBeforeDeleteHooks = []ProductAuditLogHook{}
+
+	AddProductAuditLogHook(boil.AfterDeleteHook, productAuditLogAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	productAuditLogAfterDeleteHooks = []ProductAuditLogHook{}
+
+	AddProductAuditLogHook(boil.BeforeUpsertHook, productAuditLogBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	productAuditLogBeforeUpsertHooks = []ProductAuditLogHook{}
+
+	AddProductAuditLogHook(boil.AfterUpsertHook, productAuditLogAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	productAuditLogAfterUpsertHooks = []ProductAuditLogHook{}
+}
+
+func testProductAuditLogsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testProductAuditLogsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(productAuditLogPrimaryKeyColumns, productAuditLogColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testProductAuditLogToOneReceptionUsingReception(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ProductAuditLog
+	var foreign Reception
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, productAuditLogDBTypes, false, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, receptionDBTypes, false, receptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Reception struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ReceptionID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Reception().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddReceptionHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Reception) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ProductAuditLogSlice{&local}
+	if err = local.L.LoadReception(ctx, tx, false, (*[]*ProductAuditLog)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Reception == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Reception = nil
+	if err = local.L.LoadReception(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Reception == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testProductAuditLogToOneUserUsingActor(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local ProductAuditLog
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ActorID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Actor().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ProductAuditLogSlice{&local}
+	if err = local.L.LoadActor(ctx, tx, false, (*[]*ProductAuditLog)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Actor == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Actor = nil
+	if err = local.L.LoadActor(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Actor == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testProductAuditLogToOneSetOpReceptionUsingReception(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ProductAuditLog
+	var b, c Reception
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productAuditLogDBTypes, false, strmangle.SetComplement(productAuditLogPrimaryKeyColumns, productAuditLogColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Reception{&b, &c} {
+		err = a.SetReception(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Reception != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ProductAuditLogs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ReceptionID != x.ID {
+			t.Error("foreign key was wrong value", a.ReceptionID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ReceptionID))
+		reflect.Indirect(reflect.ValueOf(&a.ReceptionID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ReceptionID != x.ID {
+			t.Error("foreign key was wrong value", a.ReceptionID, x.ID)
+		}
+	}
+}
+func testProductAuditLogToOneSetOpUserUsingActor(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ProductAuditLog
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productAuditLogDBTypes, false, strmangle.SetComplement(productAuditLogPrimaryKeyColumns, productAuditLogColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetActor(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Actor != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ActorProductAuditLogs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ActorID, x.ID) {
+			t.Error("foreign key was wrong value", a.ActorID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ActorID))
+		reflect.Indirect(reflect.ValueOf(&a.ActorID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ActorID, x.ID) {
+			t.Error("foreign key was wrong value", a.ActorID, x.ID)
+		}
+	}
+}
+
+func testProductAuditLogToOneRemoveOpUserUsingActor(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a ProductAuditLog
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productAuditLogDBTypes, false, strmangle.SetComplement(productAuditLogPrimaryKeyColumns, productAuditLogColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetActor(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveActor(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Actor().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Actor != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ActorID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ActorProductAuditLogs) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testProductAuditLogsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testProductAuditLogsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ProductAuditLogSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testProductAuditLogsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ProductAuditLogs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	productAuditLogDBTypes = map[string]string{`ID`: `uuid`, `Action`: `character varying`, `ProductID`: `uuid`, `ReceptionID`: `uuid`, `ProductType`: `character varying`, `Barcode`: `character varying`, `ActorID`: `uuid`, `CreatedAt`: `timestamp without time zone`}
+	_                      = bytes.MinRead
+)
+
+func testProductAuditLogsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(productAuditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(productAuditLogAllColumns) == len(productAuditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testProductAuditLogsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(productAuditLogAllColumns) == len(productAuditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ProductAuditLog{}
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, productAuditLogDBTypes, true, productAuditLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(productAuditLogAllColumns, productAuditLogPrimaryKeyColumns) {
+		fields = productAuditLogAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			productAuditLogAllColumns,
+			productAuditLogPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ProductAuditLogSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testProductAuditLogsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(productAuditLogAllColumns) == len(productAuditLogPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ProductAuditLog{}
+	if err = randomize.Struct(seed, &o, productAuditLogDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ProductAuditLog: %s", err)
+	}
+
+	count, err := ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, productAuditLogDBTypes, false, productAuditLogPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ProductAuditLog struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ProductAuditLog: %s", err)
+	}
+
+	count, err = ProductAuditLogs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1187 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// ProductType is an object representing the database table.
+type ProductType struct {
+	Code        string    `boil:"code" json:"code" toml:"code" yaml:"code"`
+	NameRu      string    `boil:"name_ru" json:"name_ru" toml:"name_ru" yaml:"name_ru"`
+	NameEn      string    `boil:"name_en" json:"name_en" toml:"name_en" yaml:"name_en"`
+	IsActive    bool      `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	StorageDays int       `boil:"storage_days" json:"storage_days" toml:"storage_days" yaml:"storage_days"`
+
+	R *productTypeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L productTypeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ProductTypeColumns = struct {
+	Code        string
+	NameRu      string
+	NameEn      string
+	IsActive    string
+	CreatedAt   string
+	UpdatedAt   string
+	StorageDays string
+}{
+	Code:        "code",
+	NameRu:      "name_ru",
+	NameEn:      "name_en",
+	IsActive:    "is_active",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	StorageDays: "storage_days",
+}
+
+var ProductTypeTableColumns = struct {
+	Code        string
+	NameRu      string
+	NameEn      string
+	IsActive    string
+	CreatedAt   string
+	UpdatedAt   string
+	StorageDays string
+}{
+	Code:        "product_types.code",
+	NameRu:      "product_types.name_ru",
+	NameEn:      "product_types.name_en",
+	IsActive:    "product_types.is_active",
+	CreatedAt:   "product_types.created_at",
+	UpdatedAt:   "product_types.updated_at",
+	StorageDays: "product_types.storage_days",
+}
+
+// Generated where
+
+var ProductTypeWhere = struct {
+	Code        whereHelperstring
+	NameRu      whereHelperstring
+	NameEn      whereHelperstring
+	IsActive    whereHelperbool
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+	StorageDays whereHelperint
+}{
+	Code:        whereHelperstring{field: "\"product_types\".\"code\""},
+	NameRu:      whereHelperstring{field: "\"product_types\".\"name_ru\""},
+	NameEn:      whereHelperstring{field: "\"product_types\".\"name_en\""},
+	IsActive:    whereHelperbool{field: "\"product_types\".\"is_active\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"product_types\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"product_types\".\"updated_at\""},
+	StorageDays: whereHelperint{field: "\"product_types\".\"storage_days\""},
+}
+
+// ProductTypeRels is where relationship names are stored.
+var ProductTypeRels = struct {
+	TypeProducts string
+}{
+	TypeProducts: "TypeProducts",
+}
+
+// productTypeR is where relationships are stored.
+type productTypeR struct {
+	TypeProducts ProductSlice `boil:"TypeProducts" json:"TypeProducts" toml:"TypeProducts" yaml:"TypeProducts"`
+}
+
+// NewStruct creates a new relationship struct
+func (*productTypeR) NewStruct() *productTypeR {
+	return &productTypeR{}
+}
+
+func (o *ProductType) GetTypeProducts() ProductSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTypeProducts()
+}
+
+func (r *productTypeR) GetTypeProducts() ProductSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TypeProducts
+}
+
+// productTypeL is where Load methods for each relationship are stored.
+type productTypeL struct{}
+
+var (
+	productTypeAllColumns            = []string{"code", "name_ru", "name_en", "is_active", "created_at", "updated_at", "storage_days"}
+	productTypeColumnsWithoutDefault = []string{"code", "name_ru"}
+	productTypeColumnsWithDefault    = []string{"name_en", "is_active", "created_at", "updated_at", "storage_days"}
+	productTypePrimaryKeyColumns     = []string{"code"}
+	productTypeGeneratedColumns      = []string{}
+)
+
+type (
+	// ProductTypeSlice is an alias for a slice of pointers to ProductType.
+	// This should almost always be used instead of []ProductType.
+	ProductTypeSlice []*ProductType
+	// ProductTypeHook is the signature for custom ProductType hook methods
+	ProductTypeHook func(context.Context, boil.ContextExecutor, *ProductType) error
+
+	productTypeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	productTypeType                 = reflect.TypeOf(&ProductType{})
+	productTypeMapping              = queries.MakeStructMapping(productTypeType)
+	productTypePrimaryKeyMapping, _ = queries.BindMapping(productTypeType, productTypeMapping, productTypePrimaryKeyColumns)
+	productTypeInsertCacheMut       sync.RWMutex
+	productTypeInsertCache          = make(map[string]insertCache)
+	productTypeUpdateCacheMut       sync.RWMutex
+	productTypeUpdateCache          = make(map[string]updateCache)
+	productTypeUpsertCacheMut       sync.RWMutex
+	productTypeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var productTypeAfterSelectMu sync.Mutex
+var productTypeAfterSelectHooks []ProductTypeHook
+
+var productTypeBeforeInsertMu sync.Mutex
+var productTypeBeforeInsertHooks []ProductTypeHook
+var productTypeAfterInsertMu sync.Mutex
+var productTypeAfterInsertHooks []ProductTypeHook
+
+var productTypeBeforeUpdateMu sync.Mutex
+var productTypeBeforeUpdateHooks []ProductTypeHook
+var productTypeAfterUpdateMu sync.Mutex
+var productTypeAfterUpdateHooks []ProductTypeHook
+
+var productTypeBeforeDeleteMu sync.Mutex
+var productTypeBeforeDeleteHooks []ProductTypeHook
+var productTypeAfterDeleteMu sync.Mutex
+var productTypeAfterDeleteHooks []ProductTypeHook
+
+var productTypeBeforeUpsertMu sync.Mutex
+var productTypeBeforeUpsertHooks []ProductTypeHook
+var productTypeAfterUpsertMu sync.Mutex
+var productTypeAfterUpsertHooks []ProductTypeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ProductType) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productTypeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ProductType) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productTypeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ProductType) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productTypeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ProductType) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productTypeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ProductType) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productTypeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ProductType) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productTypeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ProductType) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productTypeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ProductType) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productTypeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ProductType) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range productTypeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddProductTypeHook registers your hook function for all future operations.
+func AddProductTypeHook(hookPoint boil.HookPoint, productTypeHook ProductTypeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		productTypeAfterSelectMu.Lock()
+		productTypeAfterSelectHooks = append(productTypeAfterSelectHooks, productTypeHook)
+		productTypeAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		productTypeBeforeInsertMu.Lock()
+		productTypeBeforeInsertHooks = append(productTypeBeforeInsertHooks, productTypeHook)
+		productTypeBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		productTypeAfterInsertMu.Lock()
+		productTypeAfterInsertHooks = append(productTypeAfterInsertHooks, productTypeHook)
+		productTypeAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		productTypeBeforeUpdateMu.Lock()
+		productTypeBeforeUpdateHooks = append(productTypeBeforeUpdateHooks, productTypeHook)
+		productTypeBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		productTypeAfterUpdateMu.Lock()
+		productTypeAfterUpdateHooks = append(productTypeAfterUpdateHooks, productTypeHook)
+		productTypeAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		productTypeBeforeDeleteMu.Lock()
+		productTypeBeforeDeleteHooks = append(productTypeBeforeDeleteHooks, productTypeHook)
+		productTypeBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		productTypeAfterDeleteMu.Lock()
+		productTypeAfterDeleteHooks = append(productTypeAfterDeleteHooks, productTypeHook)
+		productTypeAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		productTypeBeforeUpsertMu.Lock()
+		productTypeBeforeUpsertHooks = append(productTypeBeforeUpsertHooks, productTypeHook)
+		productTypeBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		productTypeAfterUpsertMu.Lock()
+		productTypeAfterUpsertHooks = append(productTypeAfterUpsertHooks, productTypeHook)
+		productTypeAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single productType record from the query.
+func (q productTypeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ProductType, error) {
+	o := &ProductType{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for product_types")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ProductType records from the query.
+func (q productTypeQuery) All(ctx context.Context, exec boil.ContextExecutor) (ProductTypeSlice, error) {
+	var o []*ProductType
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ProductType slice")
+	}
+
+	if len(productTypeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ProductType records in the query.
+func (q productTypeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count product_types rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q productTypeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if product_types exists")
+	}
+
+	return count > 0, nil
+}
+
+// TypeProducts retrieves all the product's Products with an executor via type column.
+func (o *ProductType) TypeProducts(mods ...qm.QueryMod) productQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"products\".\"type\"=?", o.Code),
+	)
+
+	return Products(queryMods...)
+}
+
+// LoadTypeProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productTypeL) LoadTypeProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProductType interface{}, mods queries.Applicator) error {
+	var slice []*ProductType
+	var object *ProductType
+
+	if singular {
+		var ok bool
+		object, ok = maybeProductType.(*ProductType)
+		if !ok {
+			object = new(ProductType)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProductType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProductType))
+			}
+		}
+	} else {
+		s, ok := maybeProductType.(*[]*ProductType)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProductType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProductType))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productTypeR{}
+		}
+		args[object.Code] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productTypeR{}
+			}
+			args[obj.Code] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.type in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load products")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice products")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TypeProducts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.TypeProductType = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Code == foreign.Type {
+				local.R.TypeProducts = append(local.R.TypeProducts, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.TypeProductType = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddTypeProducts adds the given related objects to the existing relationships
+// of the product_type, optionally inserting them as new records.
+// Appends related to o.R.TypeProducts.
+// Sets related.R.TypeProductType appropriately.
+func (o *ProductType) AddTypeProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Type = o.Code
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"products\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"type"}),
+				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+			)
+			values := []interface{}{o.Code, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Type = o.Code
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productTypeR{
+			TypeProducts: related,
+		}
+	} else {
+		o.R.TypeProducts = append(o.R.TypeProducts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productR{
+				TypeProductType: o,
+			}
+		} else {
+			rel.R.TypeProductType = o
+		}
+	}
+	return nil
+}
+
+// ProductTypes retrieves all the records using an executor.
+func ProductTypes(mods ...qm.QueryMod) productTypeQuery {
+	mods = append(mods, qm.From("\"product_types\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"product_types\".*"})
+	}
+
+	return productTypeQuery{q}
+}
+
+// FindProductType retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindProductType(ctx context.Context, exec boil.ContextExecutor, code string, selectCols ...string) (*ProductType, error) {
+	productTypeObj := &ProductType{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"product_types\" where \"code\"=$1", sel,
+	)
+
+	q := queries.Raw(query, code)
+
+	err := q.Bind(ctx, exec, productTypeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from product_types")
+	}
+
+	if err = productTypeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return productTypeObj, err
+	}
+
+	return productTypeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ProductType) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no product_types provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productTypeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	productTypeInsertCacheMut.RLock()
+	cache, cached := productTypeInsertCache[key]
+	productTypeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			productTypeAllColumns,
+			productTypeColumnsWithDefault,
+			productTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(productTypeType, productTypeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(productTypeType, productTypeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"product_types\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"product_types\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into product_types")
+	}
+
+	if !cached {
+		productTypeInsertCacheMut.Lock()
+		productTypeInsertCache[key] = cache
+		productTypeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ProductType.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ProductType) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	productTypeUpdateCacheMut.RLock()
+	cache, cached := productTypeUpdateCache[key]
+	productTypeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			productTypeAllColumns,
+			productTypePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update product_types, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"product_types\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, productTypePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(productTypeType, productTypeMapping, append(wl, productTypePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update product_types row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for product_types")
+	}
+
+	if !cached {
+		productTypeUpdateCacheMut.Lock()
+		productTypeUpdateCache[key] = cache
+		productTypeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q productTypeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for product_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for product_types")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ProductTypeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"product_types\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, productTypePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in productType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all productType")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ProductType) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no product_types provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(productTypeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	productTypeUpsertCacheMut.RLock()
+	cache, cached := productTypeUpsertCache[key]
+	productTypeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			productTypeAllColumns,
+			productTypeColumnsWithDefault,
+			productTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			productTypeAllColumns,
+			productTypePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert product_types, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(productTypeAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(productTypePrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert product_types, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(productTypePrimaryKeyColumns))
+			copy(conflict, productTypePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"product_types\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(productTypeType, productTypeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(productTypeType, productTypeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert product_types")
+	}
+
+	if !cached {
+		productTypeUpsertCacheMut.Lock()
+		productTypeUpsertCache[key] = cache
+		productTypeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ProductType record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ProductType) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ProductType provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), productTypePrimaryKeyMapping)
+	sql := "DELETE FROM \"product_types\" WHERE \"code\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from product_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for product_types")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q productTypeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no productTypeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from product_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_types")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ProductTypeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(productTypeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"product_types\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productTypePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from productType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for product_types")
+	}
+
+	if len(productTypeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ProductType) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindProductType(ctx, exec, o.Code)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ProductTypeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ProductTypeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), productTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"product_types\".* FROM \"product_types\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, productTypePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ProductTypeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ProductTypeExists checks if the ProductType row exists.
+func ProductTypeExists(ctx context.Context, exec boil.ContextExecutor, code string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"product_types\" where \"code\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, code)
+	}
+	row := exec.QueryRowContext(ctx, sql, code)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if product_types exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ProductType row exists.
+func (o *ProductType) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ProductTypeExists(ctx, exec, o.Code)
+}
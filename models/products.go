@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,145 +24,340 @@ import (
 
 // Product is an object representing the database table.
 type Product struct {
-	ID          string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ReceptionID string    `boil:"reception_id" json:"reception_id" toml:"reception_id" yaml:"reception_id"`
-	Type        string    `boil:"type" json:"type" toml:"type" yaml:"type"`
-	AddedAt     time.Time `boil:"added_at" json:"added_at" toml:"added_at" yaml:"added_at"`
+	ID                string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ReceptionID       string      `boil:"reception_id" json:"reception_id" toml:"reception_id" yaml:"reception_id"`
+	Type              string      `boil:"type" json:"type" toml:"type" yaml:"type"`
+	AddedAt           time.Time   `boil:"added_at" json:"added_at" toml:"added_at" yaml:"added_at"`
+	CreatedBy         null.String `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	Barcode           null.String `boil:"barcode" json:"barcode,omitempty" toml:"barcode" yaml:"barcode,omitempty"`
+	Sku               null.String `boil:"sku" json:"sku,omitempty" toml:"sku" yaml:"sku,omitempty"`
+	OrderNumber       null.String `boil:"order_number" json:"order_number,omitempty" toml:"order_number" yaml:"order_number,omitempty"`
+	WeightGrams       null.Int    `boil:"weight_grams" json:"weight_grams,omitempty" toml:"weight_grams" yaml:"weight_grams,omitempty"`
+	LengthMM          null.Int    `boil:"length_mm" json:"length_mm,omitempty" toml:"length_mm" yaml:"length_mm,omitempty"`
+	WidthMM           null.Int    `boil:"width_mm" json:"width_mm,omitempty" toml:"width_mm" yaml:"width_mm,omitempty"`
+	HeightMM          null.Int    `boil:"height_mm" json:"height_mm,omitempty" toml:"height_mm" yaml:"height_mm,omitempty"`
+	Status            string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	PickupCode        null.String `boil:"pickup_code" json:"pickup_code,omitempty" toml:"pickup_code" yaml:"pickup_code,omitempty"`
+	StatusChangedAt   null.Time   `boil:"status_changed_at" json:"status_changed_at,omitempty" toml:"status_changed_at" yaml:"status_changed_at,omitempty"`
+	IssuedAt          null.Time   `boil:"issued_at" json:"issued_at,omitempty" toml:"issued_at" yaml:"issued_at,omitempty"`
+	IssuedBy          null.String `boil:"issued_by" json:"issued_by,omitempty" toml:"issued_by" yaml:"issued_by,omitempty"`
+	ReturnReason      null.String `boil:"return_reason" json:"return_reason,omitempty" toml:"return_reason" yaml:"return_reason,omitempty"`
+	ReturnComment     null.String `boil:"return_comment" json:"return_comment,omitempty" toml:"return_comment" yaml:"return_comment,omitempty"`
+	OriginalProductID null.String `boil:"original_product_id" json:"original_product_id,omitempty" toml:"original_product_id" yaml:"original_product_id,omitempty"`
+	StorageExpiresAt  null.Time   `boil:"storage_expires_at" json:"storage_expires_at,omitempty" toml:"storage_expires_at" yaml:"storage_expires_at,omitempty"`
+	OverdueReportedAt null.Time   `boil:"overdue_reported_at" json:"overdue_reported_at,omitempty" toml:"overdue_reported_at" yaml:"overdue_reported_at,omitempty"`
+	CellID            null.String `boil:"cell_id" json:"cell_id,omitempty" toml:"cell_id" yaml:"cell_id,omitempty"`
 
 	R *productR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L productL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ProductColumns = struct {
-	ID          string
-	ReceptionID string
-	Type        string
-	AddedAt     string
+	ID                string
+	ReceptionID       string
+	Type              string
+	AddedAt           string
+	CreatedBy         string
+	Barcode           string
+	Sku               string
+	OrderNumber       string
+	WeightGrams       string
+	LengthMM          string
+	WidthMM           string
+	HeightMM          string
+	Status            string
+	PickupCode        string
+	StatusChangedAt   string
+	IssuedAt          string
+	IssuedBy          string
+	ReturnReason      string
+	ReturnComment     string
+	OriginalProductID string
+	StorageExpiresAt  string
+	OverdueReportedAt string
+	CellID            string
 }{
-	ID:          "id",
-	ReceptionID: "reception_id",
-	Type:        "type",
-	AddedAt:     "added_at",
+	ID:                "id",
+	ReceptionID:       "reception_id",
+	Type:              "type",
+	AddedAt:           "added_at",
+	CreatedBy:         "created_by",
+	Barcode:           "barcode",
+	Sku:               "sku",
+	OrderNumber:       "order_number",
+	WeightGrams:       "weight_grams",
+	LengthMM:          "length_mm",
+	WidthMM:           "width_mm",
+	HeightMM:          "height_mm",
+	Status:            "status",
+	PickupCode:        "pickup_code",
+	StatusChangedAt:   "status_changed_at",
+	IssuedAt:          "issued_at",
+	IssuedBy:          "issued_by",
+	ReturnReason:      "return_reason",
+	ReturnComment:     "return_comment",
+	OriginalProductID: "original_product_id",
+	StorageExpiresAt:  "storage_expires_at",
+	OverdueReportedAt: "overdue_reported_at",
+	CellID:            "cell_id",
 }
 
 var ProductTableColumns = struct {
-	ID          string
-	ReceptionID string
-	Type        string
-	AddedAt     string
+	ID                string
+	ReceptionID       string
+	Type              string
+	AddedAt           string
+	CreatedBy         string
+	Barcode           string
+	Sku               string
+	OrderNumber       string
+	WeightGrams       string
+	LengthMM          string
+	WidthMM           string
+	HeightMM          string
+	Status            string
+	PickupCode        string
+	StatusChangedAt   string
+	IssuedAt          string
+	IssuedBy          string
+	ReturnReason      string
+	ReturnComment     string
+	OriginalProductID string
+	StorageExpiresAt  string
+	OverdueReportedAt string
+	CellID            string
 }{
-	ID:          "products.id",
-	ReceptionID: "products.reception_id",
-	Type:        "products.type",
-	AddedAt:     "products.added_at",
+	ID:                "products.id",
+	ReceptionID:       "products.reception_id",
+	Type:              "products.type",
+	AddedAt:           "products.added_at",
+	CreatedBy:         "products.created_by",
+	Barcode:           "products.barcode",
+	Sku:               "products.sku",
+	OrderNumber:       "products.order_number",
+	WeightGrams:       "products.weight_grams",
+	LengthMM:          "products.length_mm",
+	WidthMM:           "products.width_mm",
+	HeightMM:          "products.height_mm",
+	Status:            "products.status",
+	PickupCode:        "products.pickup_code",
+	StatusChangedAt:   "products.status_changed_at",
+	IssuedAt:          "products.issued_at",
+	IssuedBy:          "products.issued_by",
+	ReturnReason:      "products.return_reason",
+	ReturnComment:     "products.return_comment",
+	OriginalProductID: "products.original_product_id",
+	StorageExpiresAt:  "products.storage_expires_at",
+	OverdueReportedAt: "products.overdue_reported_at",
+	CellID:            "products.cell_id",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
+var ProductWhere = struct {
+	ID                whereHelperstring
+	ReceptionID       whereHelperstring
+	Type              whereHelperstring
+	AddedAt           whereHelpertime_Time
+	CreatedBy         whereHelpernull_String
+	Barcode           whereHelpernull_String
+	Sku               whereHelpernull_String
+	OrderNumber       whereHelpernull_String
+	WeightGrams       whereHelpernull_Int
+	LengthMM          whereHelpernull_Int
+	WidthMM           whereHelpernull_Int
+	HeightMM          whereHelpernull_Int
+	Status            whereHelperstring
+	PickupCode        whereHelpernull_String
+	StatusChangedAt   whereHelpernull_Time
+	IssuedAt          whereHelpernull_Time
+	IssuedBy          whereHelpernull_String
+	ReturnReason      whereHelpernull_String
+	ReturnComment     whereHelpernull_String
+	OriginalProductID whereHelpernull_String
+	StorageExpiresAt  whereHelpernull_Time
+	OverdueReportedAt whereHelpernull_Time
+	CellID            whereHelpernull_String
+}{
+	ID:                whereHelperstring{field: "\"products\".\"id\""},
+	ReceptionID:       whereHelperstring{field: "\"products\".\"reception_id\""},
+	Type:              whereHelperstring{field: "\"products\".\"type\""},
+	AddedAt:           whereHelpertime_Time{field: "\"products\".\"added_at\""},
+	CreatedBy:         whereHelpernull_String{field: "\"products\".\"created_by\""},
+	Barcode:           whereHelpernull_String{field: "\"products\".\"barcode\""},
+	Sku:               whereHelpernull_String{field: "\"products\".\"sku\""},
+	OrderNumber:       whereHelpernull_String{field: "\"products\".\"order_number\""},
+	WeightGrams:       whereHelpernull_Int{field: "\"products\".\"weight_grams\""},
+	LengthMM:          whereHelpernull_Int{field: "\"products\".\"length_mm\""},
+	WidthMM:           whereHelpernull_Int{field: "\"products\".\"width_mm\""},
+	HeightMM:          whereHelpernull_Int{field: "\"products\".\"height_mm\""},
+	Status:            whereHelperstring{field: "\"products\".\"status\""},
+	PickupCode:        whereHelpernull_String{field: "\"products\".\"pickup_code\""},
+	StatusChangedAt:   whereHelpernull_Time{field: "\"products\".\"status_changed_at\""},
+	IssuedAt:          whereHelpernull_Time{field: "\"products\".\"issued_at\""},
+	IssuedBy:          whereHelpernull_String{field: "\"products\".\"issued_by\""},
+	ReturnReason:      whereHelpernull_String{field: "\"products\".\"return_reason\""},
+	ReturnComment:     whereHelpernull_String{field: "\"products\".\"return_comment\""},
+	OriginalProductID: whereHelpernull_String{field: "\"products\".\"original_product_id\""},
+	StorageExpiresAt:  whereHelpernull_Time{field: "\"products\".\"storage_expires_at\""},
+	OverdueReportedAt: whereHelpernull_Time{field: "\"products\".\"overdue_reported_at\""},
+	CellID:            whereHelpernull_String{field: "\"products\".\"cell_id\""},
+}
+
+// ProductRels is where relationship names are stored.
+var ProductRels = struct {
+	Reception               string
+	CreatedByUser           string
+	IssuedByUser            string
+	OriginalProduct         string
+	TypeProductType         string
+	Cell                    string
+	OriginalProductProducts string
+}{
+	Reception:               "Reception",
+	CreatedByUser:           "CreatedByUser",
+	IssuedByUser:            "IssuedByUser",
+	OriginalProduct:         "OriginalProduct",
+	TypeProductType:         "TypeProductType",
+	Cell:                    "Cell",
+	OriginalProductProducts: "OriginalProductProducts",
+}
 
-func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod    { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod   { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod   { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) SIMILAR(x string) qm.QueryMod { return qm.Where(w.field+" SIMILAR TO ?", x) }
-func (w whereHelperstring) NSIMILAR(x string) qm.QueryMod {
-	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+// productR is where relationships are stored.
+type productR struct {
+	Reception               *Reception   `boil:"Reception" json:"Reception" toml:"Reception" yaml:"Reception"`
+	CreatedByUser           *User        `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	IssuedByUser            *User        `boil:"IssuedByUser" json:"IssuedByUser" toml:"IssuedByUser" yaml:"IssuedByUser"`
+	OriginalProduct         *Product     `boil:"OriginalProduct" json:"OriginalProduct" toml:"OriginalProduct" yaml:"OriginalProduct"`
+	TypeProductType         *ProductType `boil:"TypeProductType" json:"TypeProductType" toml:"TypeProductType" yaml:"TypeProductType"`
+	Cell                    *PVZCell     `boil:"Cell" json:"Cell" toml:"Cell" yaml:"Cell"`
+	OriginalProductProducts ProductSlice `boil:"OriginalProductProducts" json:"OriginalProductProducts" toml:"OriginalProductProducts" yaml:"OriginalProductProducts"`
+}
+
+// NewStruct creates a new relationship struct
+func (*productR) NewStruct() *productR {
+	return &productR{}
 }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (o *Product) GetReception() *Reception {
+	if o == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+
+	return o.R.GetReception()
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *productR) GetReception() *Reception {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+
+	return r.Reception
 }
 
-type whereHelpertime_Time struct{ field string }
+func (o *Product) GetCreatedByUser() *User {
+	if o == nil {
+		return nil
+	}
 
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+	return o.R.GetCreatedByUser()
 }
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+
+func (r *productR) GetCreatedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByUser
 }
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
+
+func (o *Product) GetIssuedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetIssuedByUser()
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+
+func (r *productR) GetIssuedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.IssuedByUser
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
+
+func (o *Product) GetOriginalProduct() *Product {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOriginalProduct()
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+
+func (r *productR) GetOriginalProduct() *Product {
+	if r == nil {
+		return nil
+	}
+
+	return r.OriginalProduct
 }
 
-var ProductWhere = struct {
-	ID          whereHelperstring
-	ReceptionID whereHelperstring
-	Type        whereHelperstring
-	AddedAt     whereHelpertime_Time
-}{
-	ID:          whereHelperstring{field: "\"products\".\"id\""},
-	ReceptionID: whereHelperstring{field: "\"products\".\"reception_id\""},
-	Type:        whereHelperstring{field: "\"products\".\"type\""},
-	AddedAt:     whereHelpertime_Time{field: "\"products\".\"added_at\""},
+func (o *Product) GetTypeProductType() *ProductType {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTypeProductType()
 }
 
-// ProductRels is where relationship names are stored.
-var ProductRels = struct {
-	Reception string
-}{
-	Reception: "Reception",
+func (r *productR) GetTypeProductType() *ProductType {
+	if r == nil {
+		return nil
+	}
+
+	return r.TypeProductType
 }
 
-// productR is where relationships are stored.
-type productR struct {
-	Reception *Reception `boil:"Reception" json:"Reception" toml:"Reception" yaml:"Reception"`
+func (o *Product) GetCell() *PVZCell {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCell()
 }
 
-// NewStruct creates a new relationship struct
-func (*productR) NewStruct() *productR {
-	return &productR{}
+func (r *productR) GetCell() *PVZCell {
+	if r == nil {
+		return nil
+	}
+
+	return r.Cell
 }
 
-func (o *Product) GetReception() *Reception {
+func (o *Product) GetOriginalProductProducts() ProductSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetReception()
+	return o.R.GetOriginalProductProducts()
 }
 
-func (r *productR) GetReception() *Reception {
+func (r *productR) GetOriginalProductProducts() ProductSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.Reception
+	return r.OriginalProductProducts
 }
 
 // productL is where Load methods for each relationship are stored.
 type productL struct{}
 
 var (
-	productAllColumns            = []string{"id", "reception_id", "type", "added_at"}
+	productAllColumns            = []string{"id", "reception_id", "type", "added_at", "created_by", "barcode", "sku", "order_number", "weight_grams", "length_mm", "width_mm", "height_mm", "status", "pickup_code", "status_changed_at", "issued_at", "issued_by", "return_reason", "return_comment", "original_product_id", "storage_expires_at", "overdue_reported_at", "cell_id"}
 	productColumnsWithoutDefault = []string{"id", "reception_id", "type"}
-	productColumnsWithDefault    = []string{"added_at"}
+	productColumnsWithDefault    = []string{"added_at", "created_by", "barcode", "sku", "order_number", "weight_grams", "length_mm", "width_mm", "height_mm", "status", "pickup_code", "status_changed_at", "issued_at", "issued_by", "return_reason", "return_comment", "original_product_id", "storage_expires_at", "overdue_reported_at", "cell_id"}
 	productPrimaryKeyColumns     = []string{"id"}
 	productGeneratedColumns      = []string{}
 )
@@ -482,6 +678,75 @@ func (o *Product) Reception(mods ...qm.QueryMod) receptionQuery {
 	return Receptions(queryMods...)
 }
 
+// CreatedByUser pointed to by the foreign key.
+func (o *Product) CreatedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// IssuedByUser pointed to by the foreign key.
+func (o *Product) IssuedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.IssuedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// OriginalProduct pointed to by the foreign key.
+func (o *Product) OriginalProduct(mods ...qm.QueryMod) productQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OriginalProductID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Products(queryMods...)
+}
+
+// TypeProductType pointed to by the foreign key.
+func (o *Product) TypeProductType(mods ...qm.QueryMod) productTypeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"code\" = ?", o.Type),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ProductTypes(queryMods...)
+}
+
+// Cell pointed to by the foreign key.
+func (o *Product) Cell(mods ...qm.QueryMod) pvzCellQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CellID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PVZCells(queryMods...)
+}
+
+// OriginalProductProducts retrieves all the product's Products with an executor via original_product_id column.
+func (o *Product) OriginalProductProducts(mods ...qm.QueryMod) productQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"products\".\"original_product_id\"=?", o.ID),
+	)
+
+	return Products(queryMods...)
+}
+
 // LoadReception allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (productL) LoadReception(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
@@ -602,48 +867,1271 @@ func (productL) LoadReception(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// SetReception of the product to the related item.
-// Sets o.R.Reception to related.
-// Adds o to related.R.Products.
-func (o *Product) SetReception(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Reception) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadCreatedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"products\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"reception_id"}),
-		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		if !queries.IsNil(object.CreatedBy) {
+			args[object.CreatedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			if !queries.IsNil(obj.CreatedBy) {
+				args[obj.CreatedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
-	o.ReceptionID = related.ID
-	if o.R == nil {
-		o.R = &productR{
-			Reception: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.Reception = related
 	}
 
-	if related.R == nil {
-		related.R = &receptionR{
-			Products: ProductSlice{o},
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByProducts = append(foreign.R.CreatedByProducts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CreatedBy, foreign.ID) {
+				local.R.CreatedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByProducts = append(foreign.R.CreatedByProducts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIssuedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productL) LoadIssuedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		if !queries.IsNil(object.IssuedBy) {
+			args[object.IssuedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			if !queries.IsNil(obj.IssuedBy) {
+				args[obj.IssuedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.IssuedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.IssuedByProducts = append(foreign.R.IssuedByProducts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.IssuedBy, foreign.ID) {
+				local.R.IssuedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.IssuedByProducts = append(foreign.R.IssuedByProducts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOriginalProduct allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productL) LoadOriginalProduct(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		if !queries.IsNil(object.OriginalProductID) {
+			args[object.OriginalProductID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			if !queries.IsNil(obj.OriginalProductID) {
+				args[obj.OriginalProductID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Product")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Product")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OriginalProduct = foreign
+		if foreign.R == nil {
+			foreign.R = &productR{}
+		}
+		foreign.R.OriginalProductProducts = append(foreign.R.OriginalProductProducts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OriginalProductID, foreign.ID) {
+				local.R.OriginalProduct = foreign
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.OriginalProductProducts = append(foreign.R.OriginalProductProducts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTypeProductType allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productL) LoadTypeProductType(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args[object.Type] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			args[obj.Type] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`product_types`),
+		qm.WhereIn(`product_types.code in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ProductType")
+	}
+
+	var resultSlice []*ProductType
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ProductType")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for product_types")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_types")
+	}
+
+	if len(productTypeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TypeProductType = foreign
+		if foreign.R == nil {
+			foreign.R = &productTypeR{}
+		}
+		foreign.R.TypeProducts = append(foreign.R.TypeProducts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Type == foreign.Code {
+				local.R.TypeProductType = foreign
+				if foreign.R == nil {
+					foreign.R = &productTypeR{}
+				}
+				foreign.R.TypeProducts = append(foreign.R.TypeProducts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCell allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (productL) LoadCell(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		if !queries.IsNil(object.CellID) {
+			args[object.CellID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+
+			if !queries.IsNil(obj.CellID) {
+				args[obj.CellID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`pvz_cells`),
+		qm.WhereIn(`pvz_cells.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PVZCell")
+	}
+
+	var resultSlice []*PVZCell
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PVZCell")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for pvz_cells")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pvz_cells")
+	}
+
+	if len(pvzCellAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Cell = foreign
+		if foreign.R == nil {
+			foreign.R = &pvzCellR{}
+		}
+		foreign.R.CellProducts = append(foreign.R.CellProducts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CellID, foreign.ID) {
+				local.R.Cell = foreign
+				if foreign.R == nil {
+					foreign.R = &pvzCellR{}
+				}
+				foreign.R.CellProducts = append(foreign.R.CellProducts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOriginalProductProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (productL) LoadOriginalProductProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeProduct interface{}, mods queries.Applicator) error {
+	var slice []*Product
+	var object *Product
+
+	if singular {
+		var ok bool
+		object, ok = maybeProduct.(*Product)
+		if !ok {
+			object = new(Product)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeProduct))
+			}
+		}
+	} else {
+		s, ok := maybeProduct.(*[]*Product)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeProduct)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeProduct))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &productR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &productR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.original_product_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load products")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice products")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OriginalProductProducts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.OriginalProduct = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OriginalProductID) {
+				local.R.OriginalProductProducts = append(local.R.OriginalProductProducts, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.OriginalProduct = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetReception of the product to the related item.
+// Sets o.R.Reception to related.
+// Adds o to related.R.Products.
+func (o *Product) SetReception(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Reception) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"products\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"reception_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ReceptionID = related.ID
+	if o.R == nil {
+		o.R = &productR{
+			Reception: related,
+		}
+	} else {
+		o.R.Reception = related
+	}
+
+	if related.R == nil {
+		related.R = &receptionR{
+			Products: ProductSlice{o},
+		}
+	} else {
+		related.R.Products = append(related.R.Products, o)
+	}
+
+	return nil
+}
+
+// SetCreatedByUser of the product to the related item.
+// Sets o.R.CreatedByUser to related.
+// Adds o to related.R.CreatedByProducts.
+func (o *Product) SetCreatedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"products\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreatedBy, related.ID)
+	if o.R == nil {
+		o.R = &productR{
+			CreatedByUser: related,
+		}
+	} else {
+		o.R.CreatedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByProducts: ProductSlice{o},
+		}
+	} else {
+		related.R.CreatedByProducts = append(related.R.CreatedByProducts, o)
+	}
+
+	return nil
+}
+
+// RemoveCreatedByUser relationship.
+// Sets o.R.CreatedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Product) RemoveCreatedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CreatedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CreatedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreatedByProducts {
+		if queries.Equal(o.CreatedBy, ri.CreatedBy) {
+			continue
+		}
+
+		ln := len(related.R.CreatedByProducts)
+		if ln > 1 && i < ln-1 {
+			related.R.CreatedByProducts[i] = related.R.CreatedByProducts[ln-1]
+		}
+		related.R.CreatedByProducts = related.R.CreatedByProducts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetIssuedByUser of the product to the related item.
+// Sets o.R.IssuedByUser to related.
+// Adds o to related.R.IssuedByProducts.
+func (o *Product) SetIssuedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"products\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"issued_by"}),
+		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.IssuedBy, related.ID)
+	if o.R == nil {
+		o.R = &productR{
+			IssuedByUser: related,
+		}
+	} else {
+		o.R.IssuedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			IssuedByProducts: ProductSlice{o},
+		}
+	} else {
+		related.R.IssuedByProducts = append(related.R.IssuedByProducts, o)
+	}
+
+	return nil
+}
+
+// RemoveIssuedByUser relationship.
+// Sets o.R.IssuedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Product) RemoveIssuedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.IssuedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("issued_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.IssuedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.IssuedByProducts {
+		if queries.Equal(o.IssuedBy, ri.IssuedBy) {
+			continue
+		}
+
+		ln := len(related.R.IssuedByProducts)
+		if ln > 1 && i < ln-1 {
+			related.R.IssuedByProducts[i] = related.R.IssuedByProducts[ln-1]
+		}
+		related.R.IssuedByProducts = related.R.IssuedByProducts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetOriginalProduct of the product to the related item.
+// Sets o.R.OriginalProduct to related.
+// Adds o to related.R.OriginalProductProducts.
+func (o *Product) SetOriginalProduct(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Product) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"products\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"original_product_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OriginalProductID, related.ID)
+	if o.R == nil {
+		o.R = &productR{
+			OriginalProduct: related,
+		}
+	} else {
+		o.R.OriginalProduct = related
+	}
+
+	if related.R == nil {
+		related.R = &productR{
+			OriginalProductProducts: ProductSlice{o},
+		}
+	} else {
+		related.R.OriginalProductProducts = append(related.R.OriginalProductProducts, o)
+	}
+
+	return nil
+}
+
+// RemoveOriginalProduct relationship.
+// Sets o.R.OriginalProduct to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Product) RemoveOriginalProduct(ctx context.Context, exec boil.ContextExecutor, related *Product) error {
+	var err error
+
+	queries.SetScanner(&o.OriginalProductID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("original_product_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.OriginalProduct = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OriginalProductProducts {
+		if queries.Equal(o.OriginalProductID, ri.OriginalProductID) {
+			continue
+		}
+
+		ln := len(related.R.OriginalProductProducts)
+		if ln > 1 && i < ln-1 {
+			related.R.OriginalProductProducts[i] = related.R.OriginalProductProducts[ln-1]
+		}
+		related.R.OriginalProductProducts = related.R.OriginalProductProducts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTypeProductType of the product to the related item.
+// Sets o.R.TypeProductType to related.
+// Adds o to related.R.TypeProducts.
+func (o *Product) SetTypeProductType(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ProductType) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"products\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"type"}),
+		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Code, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Type = related.Code
+	if o.R == nil {
+		o.R = &productR{
+			TypeProductType: related,
+		}
+	} else {
+		o.R.TypeProductType = related
+	}
+
+	if related.R == nil {
+		related.R = &productTypeR{
+			TypeProducts: ProductSlice{o},
+		}
+	} else {
+		related.R.TypeProducts = append(related.R.TypeProducts, o)
+	}
+
+	return nil
+}
+
+// SetCell of the product to the related item.
+// Sets o.R.Cell to related.
+// Adds o to related.R.CellProducts.
+func (o *Product) SetCell(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PVZCell) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"products\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"cell_id"}),
+		strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CellID, related.ID)
+	if o.R == nil {
+		o.R = &productR{
+			Cell: related,
+		}
+	} else {
+		o.R.Cell = related
+	}
+
+	if related.R == nil {
+		related.R = &pvzCellR{
+			CellProducts: ProductSlice{o},
+		}
+	} else {
+		related.R.CellProducts = append(related.R.CellProducts, o)
+	}
+
+	return nil
+}
+
+// RemoveCell relationship.
+// Sets o.R.Cell to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Product) RemoveCell(ctx context.Context, exec boil.ContextExecutor, related *PVZCell) error {
+	var err error
+
+	queries.SetScanner(&o.CellID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("cell_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Cell = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CellProducts {
+		if queries.Equal(o.CellID, ri.CellID) {
+			continue
+		}
+
+		ln := len(related.R.CellProducts)
+		if ln > 1 && i < ln-1 {
+			related.R.CellProducts[i] = related.R.CellProducts[ln-1]
+		}
+		related.R.CellProducts = related.R.CellProducts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddOriginalProductProducts adds the given related objects to the existing relationships
+// of the product, optionally inserting them as new records.
+// Appends related to o.R.OriginalProductProducts.
+// Sets related.R.OriginalProduct appropriately.
+func (o *Product) AddOriginalProductProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OriginalProductID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"products\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"original_product_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OriginalProductID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &productR{
+			OriginalProductProducts: related,
+		}
+	} else {
+		o.R.OriginalProductProducts = append(o.R.OriginalProductProducts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productR{
+				OriginalProduct: o,
+			}
+		} else {
+			rel.R.OriginalProduct = o
+		}
+	}
+	return nil
+}
+
+// SetOriginalProductProducts removes all previously related items of the
+// product replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.OriginalProduct's OriginalProductProducts accordingly.
+// Replaces o.R.OriginalProductProducts with related.
+// Sets related.R.OriginalProduct's OriginalProductProducts accordingly.
+func (o *Product) SetOriginalProductProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	query := "update \"products\" set \"original_product_id\" = null where \"original_product_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.OriginalProductProducts {
+			queries.SetScanner(&rel.OriginalProductID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.OriginalProduct = nil
+		}
+		o.R.OriginalProductProducts = nil
+	}
+
+	return o.AddOriginalProductProducts(ctx, exec, insert, related...)
+}
+
+// RemoveOriginalProductProducts relationships from objects passed in.
+// Removes related items from R.OriginalProductProducts (uses pointer comparison, removal does not keep order)
+// Sets related.R.OriginalProduct.
+func (o *Product) RemoveOriginalProductProducts(ctx context.Context, exec boil.ContextExecutor, related ...*Product) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OriginalProductID, nil)
+		if rel.R != nil {
+			rel.R.OriginalProduct = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("original_product_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.OriginalProductProducts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.OriginalProductProducts)
+			if ln > 1 && i < ln-1 {
+				o.R.OriginalProductProducts[i] = o.R.OriginalProductProducts[ln-1]
+			}
+			o.R.OriginalProductProducts = o.R.OriginalProductProducts[:ln-1]
+			break
 		}
-	} else {
-		related.R.Products = append(related.R.Products, o)
 	}
 
 	return nil
@@ -494,6 +494,334 @@ func testProductsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testProductToManyOriginalProductProducts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, true, productColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Product struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.OriginalProductID, a.ID)
+	queries.Assign(&c.OriginalProductID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OriginalProductProducts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.OriginalProductID, b.OriginalProductID) {
+			bFound = true
+		}
+		if queries.Equal(v.OriginalProductID, c.OriginalProductID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ProductSlice{&a}
+	if err = a.L.LoadOriginalProductProducts(ctx, tx, false, (*[]*Product)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OriginalProductProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OriginalProductProducts = nil
+	if err = a.L.LoadOriginalProductProducts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OriginalProductProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testProductToManyAddOpOriginalProductProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Product{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOriginalProductProducts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.OriginalProductID) {
+			t.Error("foreign key was wrong value", a.ID, first.OriginalProductID)
+		}
+		if !queries.Equal(a.ID, second.OriginalProductID) {
+			t.Error("foreign key was wrong value", a.ID, second.OriginalProductID)
+		}
+
+		if first.R.OriginalProduct != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.OriginalProduct != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OriginalProductProducts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OriginalProductProducts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OriginalProductProducts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testProductToManySetOpOriginalProductProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetOriginalProductProducts(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.OriginalProductProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetOriginalProductProducts(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.OriginalProductProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OriginalProductID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OriginalProductID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.OriginalProductID) {
+		t.Error("foreign key was wrong value", a.ID, d.OriginalProductID)
+	}
+	if !queries.Equal(a.ID, e.OriginalProductID) {
+		t.Error("foreign key was wrong value", a.ID, e.OriginalProductID)
+	}
+
+	if b.R.OriginalProduct != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.OriginalProduct != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.OriginalProduct != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.OriginalProduct != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.OriginalProductProducts[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.OriginalProductProducts[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testProductToManyRemoveOpOriginalProductProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddOriginalProductProducts(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.OriginalProductProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveOriginalProductProducts(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.OriginalProductProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OriginalProductID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OriginalProductID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.OriginalProduct != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.OriginalProduct != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.OriginalProduct != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.OriginalProduct != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.OriginalProductProducts) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.OriginalProductProducts[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.OriginalProductProducts[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testProductToOneReceptionUsingReception(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -555,43 +883,348 @@ func testProductToOneReceptionUsingReception(t *testing.T) {
 	}
 }
 
-func testProductToOneSetOpReceptionUsingReception(t *testing.T) {
-	var err error
-
+func testProductToOneUserUsingCreatedByUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
-	var a Product
-	var b, c Reception
+	var local Product
+	var foreign User
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+	if err := randomize.Struct(seed, &local, productDBTypes, true, productColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Product struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &b, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+
+	queries.Assign(&local.CreatedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+
+	check, err := local.CreatedByUser().One(ctx, tx)
+	if err != nil {
 		t.Fatal(err)
 	}
 
-	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ProductSlice{&local}
+	if err = local.L.LoadCreatedByUser(ctx, tx, false, (*[]*Product)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+	if local.R.CreatedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.CreatedByUser = nil
+	if err = local.L.LoadCreatedByUser(ctx, tx, true, &local, nil); err != nil {
 		t.Fatal(err)
 	}
+	if local.R.CreatedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
 
-	for i, x := range []*Reception{&b, &c} {
-		err = a.SetReception(ctx, tx, i != 0, x)
-		if err != nil {
-			t.Fatal(err)
-		}
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
 
-		if a.R.Reception != x {
-			t.Error("relationship struct not set to correct value")
-		}
+func testProductToOneUserUsingIssuedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Product
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, productDBTypes, true, productColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Product struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.IssuedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.IssuedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ProductSlice{&local}
+	if err = local.L.LoadIssuedByUser(ctx, tx, false, (*[]*Product)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.IssuedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.IssuedByUser = nil
+	if err = local.L.LoadIssuedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.IssuedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testProductToOneProductUsingOriginalProduct(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Product
+	var foreign Product
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, productDBTypes, true, productColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Product struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Product struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.OriginalProductID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OriginalProduct().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddProductHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Product) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ProductSlice{&local}
+	if err = local.L.LoadOriginalProduct(ctx, tx, false, (*[]*Product)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OriginalProduct == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OriginalProduct = nil
+	if err = local.L.LoadOriginalProduct(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OriginalProduct == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testProductToOneProductTypeUsingTypeProductType(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Product
+	var foreign ProductType
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Product struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, productTypeDBTypes, false, productTypeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ProductType struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.Type = foreign.Code
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.TypeProductType().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.Code != foreign.Code {
+		t.Errorf("want: %v, got %v", foreign.Code, check.Code)
+	}
+
+	ranAfterSelectHook := false
+	AddProductTypeHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *ProductType) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ProductSlice{&local}
+	if err = local.L.LoadTypeProductType(ctx, tx, false, (*[]*Product)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TypeProductType == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.TypeProductType = nil
+	if err = local.L.LoadTypeProductType(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.TypeProductType == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testProductToOnePVZCellUsingCell(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Product
+	var foreign PVZCell
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, productDBTypes, true, productColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Product struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, pvzCellDBTypes, false, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CellID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Cell().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddPVZCellHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ProductSlice{&local}
+	if err = local.L.LoadCell(ctx, tx, false, (*[]*Product)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Cell == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Cell = nil
+	if err = local.L.LoadCell(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Cell == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testProductToOneSetOpReceptionUsingReception(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c Reception
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Reception{&b, &c} {
+		err = a.SetReception(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Reception != x {
+			t.Error("relationship struct not set to correct value")
+		}
 
 		if x.R.Products[0] != &a {
 			t.Error("failed to append to foreign relationship struct")
@@ -612,6 +1245,498 @@ func testProductToOneSetOpReceptionUsingReception(t *testing.T) {
 		}
 	}
 }
+func testProductToOneSetOpUserUsingCreatedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetCreatedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.CreatedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CreatedByProducts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CreatedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.CreatedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CreatedBy))
+		reflect.Indirect(reflect.ValueOf(&a.CreatedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CreatedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.CreatedBy, x.ID)
+		}
+	}
+}
+
+func testProductToOneRemoveOpUserUsingCreatedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCreatedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCreatedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.CreatedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.CreatedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CreatedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CreatedByProducts) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testProductToOneSetOpUserUsingIssuedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetIssuedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.IssuedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.IssuedByProducts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.IssuedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.IssuedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.IssuedBy))
+		reflect.Indirect(reflect.ValueOf(&a.IssuedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.IssuedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.IssuedBy, x.ID)
+		}
+	}
+}
+
+func testProductToOneRemoveOpUserUsingIssuedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetIssuedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveIssuedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.IssuedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.IssuedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.IssuedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.IssuedByProducts) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testProductToOneSetOpProductUsingOriginalProduct(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Product{&b, &c} {
+		err = a.SetOriginalProduct(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OriginalProduct != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OriginalProductProducts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.OriginalProductID, x.ID) {
+			t.Error("foreign key was wrong value", a.OriginalProductID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OriginalProductID))
+		reflect.Indirect(reflect.ValueOf(&a.OriginalProductID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.OriginalProductID, x.ID) {
+			t.Error("foreign key was wrong value", a.OriginalProductID, x.ID)
+		}
+	}
+}
+
+func testProductToOneRemoveOpProductUsingOriginalProduct(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetOriginalProduct(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveOriginalProduct(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.OriginalProduct().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.OriginalProduct != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.OriginalProductID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.OriginalProductProducts) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testProductToOneSetOpProductTypeUsingTypeProductType(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c ProductType
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, productTypeDBTypes, false, strmangle.SetComplement(productTypePrimaryKeyColumns, productTypeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, productTypeDBTypes, false, strmangle.SetComplement(productTypePrimaryKeyColumns, productTypeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*ProductType{&b, &c} {
+		err = a.SetTypeProductType(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.TypeProductType != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TypeProducts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.Type != x.Code {
+			t.Error("foreign key was wrong value", a.Type)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.Type))
+		reflect.Indirect(reflect.ValueOf(&a.Type)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.Type != x.Code {
+			t.Error("foreign key was wrong value", a.Type, x.Code)
+		}
+	}
+}
+func testProductToOneSetOpPVZCellUsingCell(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b, c PVZCell
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, pvzCellDBTypes, false, strmangle.SetComplement(pvzCellPrimaryKeyColumns, pvzCellColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pvzCellDBTypes, false, strmangle.SetComplement(pvzCellPrimaryKeyColumns, pvzCellColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*PVZCell{&b, &c} {
+		err = a.SetCell(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Cell != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CellProducts[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CellID, x.ID) {
+			t.Error("foreign key was wrong value", a.CellID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CellID))
+		reflect.Indirect(reflect.ValueOf(&a.CellID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CellID, x.ID) {
+			t.Error("foreign key was wrong value", a.CellID, x.ID)
+		}
+	}
+}
+
+func testProductToOneRemoveOpPVZCellUsingCell(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Product
+	var b PVZCell
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, pvzCellDBTypes, false, strmangle.SetComplement(pvzCellPrimaryKeyColumns, pvzCellColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCell(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCell(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Cell().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Cell != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CellID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CellProducts) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testProductsReload(t *testing.T) {
 	t.Parallel()
@@ -687,7 +1812,7 @@ func testProductsSelect(t *testing.T) {
 }
 
 var (
-	productDBTypes = map[string]string{`ID`: `uuid`, `ReceptionID`: `uuid`, `Type`: `character varying`, `AddedAt`: `timestamp without time zone`}
+	productDBTypes = map[string]string{`ID`: `uuid`, `ReceptionID`: `uuid`, `Type`: `character varying`, `AddedAt`: `timestamp without time zone`, `CreatedBy`: `uuid`, `Barcode`: `character varying`, `Sku`: `character varying`, `OrderNumber`: `character varying`, `WeightGrams`: `integer`, `LengthMM`: `integer`, `WidthMM`: `integer`, `HeightMM`: `integer`, `Status`: `character varying`, `PickupCode`: `character varying`, `StatusChangedAt`: `timestamp without time zone`, `IssuedAt`: `timestamp without time zone`, `IssuedBy`: `uuid`, `ReturnReason`: `character varying`, `ReturnComment`: `text`, `OriginalProductID`: `uuid`, `StorageExpiresAt`: `timestamp without time zone`, `OverdueReportedAt`: `timestamp without time zone`, `CellID`: `uuid`}
 	_              = bytes.MinRead
 )
 
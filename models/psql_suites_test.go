@@ -6,13 +6,37 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("Cities", testCitiesUpsert)
+
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpsert)
+
+	t.Run("Outboxes", testOutboxesUpsert)
+
+	t.Run("ProductAuditLogs", testProductAuditLogsUpsert)
+
+	t.Run("ProductTypes", testProductTypesUpsert)
+
 	t.Run("Products", testProductsUpsert)
 
 	t.Run("PVZS", testPVZSUpsert)
 
+	t.Run("PVZCells", testPVZCellsUpsert)
+
+	t.Run("PVZStaffs", testPVZStaffsUpsert)
+
 	t.Run("Receptions", testReceptionsUpsert)
 
+	t.Run("RefreshTokens", testRefreshTokensUpsert)
+
+	t.Run("RevokedTokens", testRevokedTokensUpsert)
+
 	t.Run("SchemaMigrations", testSchemaMigrationsUpsert)
 
+	t.Run("StatusHistories", testStatusHistoriesUpsert)
+
 	t.Run("Users", testUsersUpsert)
+
+	t.Run("WebhookDeliveries", testWebhookDeliveriesUpsert)
+
+	t.Run("Webhooks", testWebhooksUpsert)
 }
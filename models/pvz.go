@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,86 +24,89 @@ import (
 
 // PVZ is an object representing the database table.
 type PVZ struct {
-	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	City      string    `boil:"city" json:"city" toml:"city" yaml:"city"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID             int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name           string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	City           string    `boil:"city" json:"city" toml:"city" yaml:"city"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Capacity       null.Int  `boil:"capacity" json:"capacity,omitempty" toml:"capacity" yaml:"capacity,omitempty"`
+	CapacityPolicy string    `boil:"capacity_policy" json:"capacity_policy" toml:"capacity_policy" yaml:"capacity_policy"`
 
 	R *pvzR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L pvzL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PVZColumns = struct {
-	ID        string
-	Name      string
-	City      string
-	CreatedAt string
+	ID             string
+	Name           string
+	City           string
+	CreatedAt      string
+	Capacity       string
+	CapacityPolicy string
 }{
-	ID:        "id",
-	Name:      "name",
-	City:      "city",
-	CreatedAt: "created_at",
+	ID:             "id",
+	Name:           "name",
+	City:           "city",
+	CreatedAt:      "created_at",
+	Capacity:       "capacity",
+	CapacityPolicy: "capacity_policy",
 }
 
 var PVZTableColumns = struct {
-	ID        string
-	Name      string
-	City      string
-	CreatedAt string
+	ID             string
+	Name           string
+	City           string
+	CreatedAt      string
+	Capacity       string
+	CapacityPolicy string
 }{
-	ID:        "pvz.id",
-	Name:      "pvz.name",
-	City:      "pvz.city",
-	CreatedAt: "pvz.created_at",
+	ID:             "pvz.id",
+	Name:           "pvz.name",
+	City:           "pvz.city",
+	CreatedAt:      "pvz.created_at",
+	Capacity:       "pvz.capacity",
+	CapacityPolicy: "pvz.capacity_policy",
 }
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 var PVZWhere = struct {
-	ID        whereHelperint64
-	Name      whereHelperstring
-	City      whereHelperstring
-	CreatedAt whereHelpertime_Time
+	ID             whereHelperint64
+	Name           whereHelperstring
+	City           whereHelperstring
+	CreatedAt      whereHelpertime_Time
+	Capacity       whereHelpernull_Int
+	CapacityPolicy whereHelperstring
 }{
-	ID:        whereHelperint64{field: "\"pvz\".\"id\""},
-	Name:      whereHelperstring{field: "\"pvz\".\"name\""},
-	City:      whereHelperstring{field: "\"pvz\".\"city\""},
-	CreatedAt: whereHelpertime_Time{field: "\"pvz\".\"created_at\""},
+	ID:             whereHelperint64{field: "\"pvz\".\"id\""},
+	Name:           whereHelperstring{field: "\"pvz\".\"name\""},
+	City:           whereHelperstring{field: "\"pvz\".\"city\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"pvz\".\"created_at\""},
+	Capacity:       whereHelpernull_Int{field: "\"pvz\".\"capacity\""},
+	CapacityPolicy: whereHelperstring{field: "\"pvz\".\"capacity_policy\""},
 }
 
 // PVZRels is where relationship names are stored.
 var PVZRels = struct {
+	PVZCity    string
+	PVZCells   string
+	PVZStaffs  string
 	Receptions string
+	Webhooks   string
 }{
+	PVZCity:    "PVZCity",
+	PVZCells:   "PVZCells",
+	PVZStaffs:  "PVZStaffs",
 	Receptions: "Receptions",
+	Webhooks:   "Webhooks",
 }
 
 // pvzR is where relationships are stored.
 type pvzR struct {
+	PVZCity    *City          `boil:"PVZCity" json:"PVZCity" toml:"PVZCity" yaml:"PVZCity"`
+	PVZCells   PVZCellSlice   `boil:"PVZCells" json:"PVZCells" toml:"PVZCells" yaml:"PVZCells"`
+	PVZStaffs  PVZStaffSlice  `boil:"PVZStaffs" json:"PVZStaffs" toml:"PVZStaffs" yaml:"PVZStaffs"`
 	Receptions ReceptionSlice `boil:"Receptions" json:"Receptions" toml:"Receptions" yaml:"Receptions"`
+	Webhooks   WebhookSlice   `boil:"Webhooks" json:"Webhooks" toml:"Webhooks" yaml:"Webhooks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -110,6 +114,54 @@ func (*pvzR) NewStruct() *pvzR {
 	return &pvzR{}
 }
 
+func (o *PVZ) GetPVZCity() *City {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPVZCity()
+}
+
+func (r *pvzR) GetPVZCity() *City {
+	if r == nil {
+		return nil
+	}
+
+	return r.PVZCity
+}
+
+func (o *PVZ) GetPVZCells() PVZCellSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPVZCells()
+}
+
+func (r *pvzR) GetPVZCells() PVZCellSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PVZCells
+}
+
+func (o *PVZ) GetPVZStaffs() PVZStaffSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPVZStaffs()
+}
+
+func (r *pvzR) GetPVZStaffs() PVZStaffSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PVZStaffs
+}
+
 func (o *PVZ) GetReceptions() ReceptionSlice {
 	if o == nil {
 		return nil
@@ -126,13 +178,29 @@ func (r *pvzR) GetReceptions() ReceptionSlice {
 	return r.Receptions
 }
 
+func (o *PVZ) GetWebhooks() WebhookSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebhooks()
+}
+
+func (r *pvzR) GetWebhooks() WebhookSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Webhooks
+}
+
 // pvzL is where Load methods for each relationship are stored.
 type pvzL struct{}
 
 var (
-	pvzAllColumns            = []string{"id", "name", "city", "created_at"}
+	pvzAllColumns            = []string{"id", "name", "city", "created_at", "capacity", "capacity_policy"}
 	pvzColumnsWithoutDefault = []string{"name", "city"}
-	pvzColumnsWithDefault    = []string{"id", "created_at"}
+	pvzColumnsWithDefault    = []string{"id", "created_at", "capacity", "capacity_policy"}
 	pvzPrimaryKeyColumns     = []string{"id"}
 	pvzGeneratedColumns      = []string{}
 )
@@ -442,6 +510,45 @@ func (q pvzQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// PVZCity pointed to by the foreign key.
+func (o *PVZ) PVZCity(mods ...qm.QueryMod) cityQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"name\" = ?", o.City),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Cities(queryMods...)
+}
+
+// PVZCells retrieves all the pvz_cell's PVZCells with an executor.
+func (o *PVZ) PVZCells(mods ...qm.QueryMod) pvzCellQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"pvz_cells\".\"pvz_id\"=?", o.ID),
+	)
+
+	return PVZCells(queryMods...)
+}
+
+// PVZStaffs retrieves all the pvz_staff's PVZStaffs with an executor.
+func (o *PVZ) PVZStaffs(mods ...qm.QueryMod) pvzStaffQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"pvz_staff\".\"pvz_id\"=?", o.ID),
+	)
+
+	return PVZStaffs(queryMods...)
+}
+
 // Receptions retrieves all the reception's Receptions with an executor.
 func (o *PVZ) Receptions(mods ...qm.QueryMod) receptionQuery {
 	var queryMods []qm.QueryMod
@@ -456,9 +563,143 @@ func (o *PVZ) Receptions(mods ...qm.QueryMod) receptionQuery {
 	return Receptions(queryMods...)
 }
 
-// LoadReceptions allows an eager lookup of values, cached into the
+// Webhooks retrieves all the webhook's Webhooks with an executor.
+func (o *PVZ) Webhooks(mods ...qm.QueryMod) webhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhooks\".\"pvz_id\"=?", o.ID),
+	)
+
+	return Webhooks(queryMods...)
+}
+
+// LoadPVZCity allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pvzL) LoadPVZCity(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZ interface{}, mods queries.Applicator) error {
+	var slice []*PVZ
+	var object *PVZ
+
+	if singular {
+		var ok bool
+		object, ok = maybePVZ.(*PVZ)
+		if !ok {
+			object = new(PVZ)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePVZ)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePVZ))
+			}
+		}
+	} else {
+		s, ok := maybePVZ.(*[]*PVZ)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePVZ)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePVZ))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pvzR{}
+		}
+		args[object.City] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pvzR{}
+			}
+
+			args[obj.City] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`cities`),
+		qm.WhereIn(`cities.name in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load City")
+	}
+
+	var resultSlice []*City
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice City")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for cities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cities")
+	}
+
+	if len(cityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PVZCity = foreign
+		if foreign.R == nil {
+			foreign.R = &cityR{}
+		}
+		foreign.R.PVZS = append(foreign.R.PVZS, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.City == foreign.Name {
+				local.R.PVZCity = foreign
+				if foreign.R == nil {
+					foreign.R = &cityR{}
+				}
+				foreign.R.PVZS = append(foreign.R.PVZS, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPVZCells allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (pvzL) LoadReceptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZ interface{}, mods queries.Applicator) error {
+func (pvzL) LoadPVZCells(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZ interface{}, mods queries.Applicator) error {
 	var slice []*PVZ
 	var object *PVZ
 
@@ -511,8 +752,8 @@ func (pvzL) LoadReceptions(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`receptions`),
-		qm.WhereIn(`receptions.pvz_id in ?`, argsSlice...),
+		qm.From(`pvz_cells`),
+		qm.WhereIn(`pvz_cells.pvz_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -520,22 +761,22 @@ func (pvzL) LoadReceptions(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load receptions")
+		return errors.Wrap(err, "failed to eager load pvz_cells")
 	}
 
-	var resultSlice []*Reception
+	var resultSlice []*PVZCell
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice receptions")
+		return errors.Wrap(err, "failed to bind eager loaded slice pvz_cells")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on receptions")
+		return errors.Wrap(err, "failed to close results in eager load on pvz_cells")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for receptions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pvz_cells")
 	}
 
-	if len(receptionAfterSelectHooks) != 0 {
+	if len(pvzCellAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -543,10 +784,10 @@ func (pvzL) LoadReceptions(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.Receptions = resultSlice
+		object.R.PVZCells = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &receptionR{}
+				foreign.R = &pvzCellR{}
 			}
 			foreign.R.PVZ = object
 		}
@@ -556,9 +797,9 @@ func (pvzL) LoadReceptions(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.PVZID {
-				local.R.Receptions = append(local.R.Receptions, foreign)
+				local.R.PVZCells = append(local.R.PVZCells, foreign)
 				if foreign.R == nil {
-					foreign.R = &receptionR{}
+					foreign.R = &pvzCellR{}
 				}
 				foreign.R.PVZ = local
 				break
@@ -569,46 +810,538 @@ func (pvzL) LoadReceptions(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// AddReceptions adds the given related objects to the existing relationships
-// of the pvz, optionally inserting them as new records.
-// Appends related to o.R.Receptions.
-// Sets related.R.PVZ appropriately.
-func (o *PVZ) AddReceptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reception) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.PVZID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"receptions\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"pvz_id"}),
-				strmangle.WhereClause("\"", "\"", 2, receptionPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadPVZStaffs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pvzL) LoadPVZStaffs(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZ interface{}, mods queries.Applicator) error {
+	var slice []*PVZ
+	var object *PVZ
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybePVZ.(*PVZ)
+		if !ok {
+			object = new(PVZ)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePVZ)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePVZ))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybePVZ.(*[]*PVZ)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePVZ)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePVZ))
 			}
-
-			rel.PVZID = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &pvzR{
-			Receptions: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pvzR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.Receptions = append(o.R.Receptions, related...)
-	}
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pvzR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`pvz_staff`),
+		qm.WhereIn(`pvz_staff.pvz_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pvz_staff")
+	}
+
+	var resultSlice []*PVZStaff
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pvz_staff")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pvz_staff")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pvz_staff")
+	}
+
+	if len(pvzStaffAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PVZStaffs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pvzStaffR{}
+			}
+			foreign.R.PVZ = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PVZID {
+				local.R.PVZStaffs = append(local.R.PVZStaffs, foreign)
+				if foreign.R == nil {
+					foreign.R = &pvzStaffR{}
+				}
+				foreign.R.PVZ = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReceptions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pvzL) LoadReceptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZ interface{}, mods queries.Applicator) error {
+	var slice []*PVZ
+	var object *PVZ
+
+	if singular {
+		var ok bool
+		object, ok = maybePVZ.(*PVZ)
+		if !ok {
+			object = new(PVZ)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePVZ)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePVZ))
+			}
+		}
+	} else {
+		s, ok := maybePVZ.(*[]*PVZ)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePVZ)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePVZ))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pvzR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pvzR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`receptions`),
+		qm.WhereIn(`receptions.pvz_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load receptions")
+	}
+
+	var resultSlice []*Reception
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice receptions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on receptions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for receptions")
+	}
+
+	if len(receptionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Receptions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &receptionR{}
+			}
+			foreign.R.PVZ = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PVZID {
+				local.R.Receptions = append(local.R.Receptions, foreign)
+				if foreign.R == nil {
+					foreign.R = &receptionR{}
+				}
+				foreign.R.PVZ = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pvzL) LoadWebhooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZ interface{}, mods queries.Applicator) error {
+	var slice []*PVZ
+	var object *PVZ
+
+	if singular {
+		var ok bool
+		object, ok = maybePVZ.(*PVZ)
+		if !ok {
+			object = new(PVZ)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePVZ)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePVZ))
+			}
+		}
+	} else {
+		s, ok := maybePVZ.(*[]*PVZ)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePVZ)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePVZ))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pvzR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pvzR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhooks`),
+		qm.WhereIn(`webhooks.pvz_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhooks")
+	}
+
+	var resultSlice []*Webhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhooks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhooks")
+	}
+
+	if len(webhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Webhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookR{}
+			}
+			foreign.R.PVZ = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PVZID) {
+				local.R.Webhooks = append(local.R.Webhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookR{}
+				}
+				foreign.R.PVZ = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPVZCity of the pvz to the related item.
+// Sets o.R.PVZCity to related.
+// Adds o to related.R.PVZS.
+func (o *PVZ) SetPVZCity(ctx context.Context, exec boil.ContextExecutor, insert bool, related *City) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"pvz\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"city"}),
+		strmangle.WhereClause("\"", "\"", 2, pvzPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Name, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.City = related.Name
+	if o.R == nil {
+		o.R = &pvzR{
+			PVZCity: related,
+		}
+	} else {
+		o.R.PVZCity = related
+	}
+
+	if related.R == nil {
+		related.R = &cityR{
+			PVZS: PVZSlice{o},
+		}
+	} else {
+		related.R.PVZS = append(related.R.PVZS, o)
+	}
+
+	return nil
+}
+
+// AddPVZCells adds the given related objects to the existing relationships
+// of the pvz, optionally inserting them as new records.
+// Appends related to o.R.PVZCells.
+// Sets related.R.PVZ appropriately.
+func (o *PVZ) AddPVZCells(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PVZCell) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PVZID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"pvz_cells\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pvz_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pvzCellPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PVZID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pvzR{
+			PVZCells: related,
+		}
+	} else {
+		o.R.PVZCells = append(o.R.PVZCells, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pvzCellR{
+				PVZ: o,
+			}
+		} else {
+			rel.R.PVZ = o
+		}
+	}
+	return nil
+}
+
+// AddPVZStaffs adds the given related objects to the existing relationships
+// of the pvz, optionally inserting them as new records.
+// Appends related to o.R.PVZStaffs.
+// Sets related.R.PVZ appropriately.
+func (o *PVZ) AddPVZStaffs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PVZStaff) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PVZID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"pvz_staff\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pvz_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pvzStaffPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PVZID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PVZID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pvzR{
+			PVZStaffs: related,
+		}
+	} else {
+		o.R.PVZStaffs = append(o.R.PVZStaffs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pvzStaffR{
+				PVZ: o,
+			}
+		} else {
+			rel.R.PVZ = o
+		}
+	}
+	return nil
+}
+
+// AddReceptions adds the given related objects to the existing relationships
+// of the pvz, optionally inserting them as new records.
+// Appends related to o.R.Receptions.
+// Sets related.R.PVZ appropriately.
+func (o *PVZ) AddReceptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reception) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PVZID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"receptions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pvz_id"}),
+				strmangle.WhereClause("\"", "\"", 2, receptionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PVZID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pvzR{
+			Receptions: related,
+		}
+	} else {
+		o.R.Receptions = append(o.R.Receptions, related...)
+	}
 
 	for _, rel := range related {
 		if rel.R == nil {
@@ -622,6 +1355,133 @@ func (o *PVZ) AddReceptions(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddWebhooks adds the given related objects to the existing relationships
+// of the pvz, optionally inserting them as new records.
+// Appends related to o.R.Webhooks.
+// Sets related.R.PVZ appropriately.
+func (o *PVZ) AddWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Webhook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PVZID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhooks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pvz_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PVZID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pvzR{
+			Webhooks: related,
+		}
+	} else {
+		o.R.Webhooks = append(o.R.Webhooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookR{
+				PVZ: o,
+			}
+		} else {
+			rel.R.PVZ = o
+		}
+	}
+	return nil
+}
+
+// SetWebhooks removes all previously related items of the
+// pvz replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.PVZ's Webhooks accordingly.
+// Replaces o.R.Webhooks with related.
+// Sets related.R.PVZ's Webhooks accordingly.
+func (o *PVZ) SetWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Webhook) error {
+	query := "update \"webhooks\" set \"pvz_id\" = null where \"pvz_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Webhooks {
+			queries.SetScanner(&rel.PVZID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.PVZ = nil
+		}
+		o.R.Webhooks = nil
+	}
+
+	return o.AddWebhooks(ctx, exec, insert, related...)
+}
+
+// RemoveWebhooks relationships from objects passed in.
+// Removes related items from R.Webhooks (uses pointer comparison, removal does not keep order)
+// Sets related.R.PVZ.
+func (o *PVZ) RemoveWebhooks(ctx context.Context, exec boil.ContextExecutor, related ...*Webhook) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PVZID, nil)
+		if rel.R != nil {
+			rel.R.PVZ = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("pvz_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Webhooks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Webhooks)
+			if ln > 1 && i < ln-1 {
+				o.R.Webhooks[i] = o.R.Webhooks[ln-1]
+			}
+			o.R.Webhooks = o.R.Webhooks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // PVZS retrieves all the records using an executor.
 func PVZS(mods ...qm.QueryMod) pvzQuery {
 	mods = append(mods, qm.From("\"pvz\""))
@@ -0,0 +1,1434 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PVZCell is an object representing the database table.
+type PVZCell struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PVZID     int64     `boil:"pvz_id" json:"pvz_id" toml:"pvz_id" yaml:"pvz_id"`
+	Code      string    `boil:"code" json:"code" toml:"code" yaml:"code"`
+	Capacity  int       `boil:"capacity" json:"capacity" toml:"capacity" yaml:"capacity"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *pvzCellR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pvzCellL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PVZCellColumns = struct {
+	ID        string
+	PVZID     string
+	Code      string
+	Capacity  string
+	CreatedAt string
+}{
+	ID:        "id",
+	PVZID:     "pvz_id",
+	Code:      "code",
+	Capacity:  "capacity",
+	CreatedAt: "created_at",
+}
+
+var PVZCellTableColumns = struct {
+	ID        string
+	PVZID     string
+	Code      string
+	Capacity  string
+	CreatedAt string
+}{
+	ID:        "pvz_cells.id",
+	PVZID:     "pvz_cells.pvz_id",
+	Code:      "pvz_cells.code",
+	Capacity:  "pvz_cells.capacity",
+	CreatedAt: "pvz_cells.created_at",
+}
+
+// Generated where
+
+var PVZCellWhere = struct {
+	ID        whereHelperstring
+	PVZID     whereHelperint64
+	Code      whereHelperstring
+	Capacity  whereHelperint
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"pvz_cells\".\"id\""},
+	PVZID:     whereHelperint64{field: "\"pvz_cells\".\"pvz_id\""},
+	Code:      whereHelperstring{field: "\"pvz_cells\".\"code\""},
+	Capacity:  whereHelperint{field: "\"pvz_cells\".\"capacity\""},
+	CreatedAt: whereHelpertime_Time{field: "\"pvz_cells\".\"created_at\""},
+}
+
+// PVZCellRels is where relationship names are stored.
+var PVZCellRels = struct {
+	PVZ          string
+	CellProducts string
+}{
+	PVZ:          "PVZ",
+	CellProducts: "CellProducts",
+}
+
+// pvzCellR is where relationships are stored.
+type pvzCellR struct {
+	PVZ          *PVZ         `boil:"PVZ" json:"PVZ" toml:"PVZ" yaml:"PVZ"`
+	CellProducts ProductSlice `boil:"CellProducts" json:"CellProducts" toml:"CellProducts" yaml:"CellProducts"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pvzCellR) NewStruct() *pvzCellR {
+	return &pvzCellR{}
+}
+
+func (o *PVZCell) GetPVZ() *PVZ {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPVZ()
+}
+
+func (r *pvzCellR) GetPVZ() *PVZ {
+	if r == nil {
+		return nil
+	}
+
+	return r.PVZ
+}
+
+func (o *PVZCell) GetCellProducts() ProductSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCellProducts()
+}
+
+func (r *pvzCellR) GetCellProducts() ProductSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CellProducts
+}
+
+// pvzCellL is where Load methods for each relationship are stored.
+type pvzCellL struct{}
+
+var (
+	pvzCellAllColumns            = []string{"id", "pvz_id", "code", "capacity", "created_at"}
+	pvzCellColumnsWithoutDefault = []string{"id", "pvz_id", "code"}
+	pvzCellColumnsWithDefault    = []string{"capacity", "created_at"}
+	pvzCellPrimaryKeyColumns     = []string{"id"}
+	pvzCellGeneratedColumns      = []string{}
+)
+
+type (
+	// PVZCellSlice is an alias for a slice of pointers to PVZCell.
+	// This should almost always be used instead of []PVZCell.
+	PVZCellSlice []*PVZCell
+	// PVZCellHook is the signature for custom PVZCell hook methods
+	PVZCellHook func(context.Context, boil.ContextExecutor, *PVZCell) error
+
+	pvzCellQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pvzCellType                 = reflect.TypeOf(&PVZCell{})
+	pvzCellMapping              = queries.MakeStructMapping(pvzCellType)
+	pvzCellPrimaryKeyMapping, _ = queries.BindMapping(pvzCellType, pvzCellMapping, pvzCellPrimaryKeyColumns)
+	pvzCellInsertCacheMut       sync.RWMutex
+	pvzCellInsertCache          = make(map[string]insertCache)
+	pvzCellUpdateCacheMut       sync.RWMutex
+	pvzCellUpdateCache          = make(map[string]updateCache)
+	pvzCellUpsertCacheMut       sync.RWMutex
+	pvzCellUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var pvzCellAfterSelectMu sync.Mutex
+var pvzCellAfterSelectHooks []PVZCellHook
+
+var pvzCellBeforeInsertMu sync.Mutex
+var pvzCellBeforeInsertHooks []PVZCellHook
+var pvzCellAfterInsertMu sync.Mutex
+var pvzCellAfterInsertHooks []PVZCellHook
+
+var pvzCellBeforeUpdateMu sync.Mutex
+var pvzCellBeforeUpdateHooks []PVZCellHook
+var pvzCellAfterUpdateMu sync.Mutex
+var pvzCellAfterUpdateHooks []PVZCellHook
+
+var pvzCellBeforeDeleteMu sync.Mutex
+var pvzCellBeforeDeleteHooks []PVZCellHook
+var pvzCellAfterDeleteMu sync.Mutex
+var pvzCellAfterDeleteHooks []PVZCellHook
+
+var pvzCellBeforeUpsertMu sync.Mutex
+var pvzCellBeforeUpsertHooks []PVZCellHook
+var pvzCellAfterUpsertMu sync.Mutex
+var pvzCellAfterUpsertHooks []PVZCellHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PVZCell) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzCellAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PVZCell) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzCellBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PVZCell) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzCellAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PVZCell) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzCellBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PVZCell) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzCellAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PVZCell) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzCellBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PVZCell) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzCellAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PVZCell) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzCellBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PVZCell) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzCellAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPVZCellHook registers your hook function for all future operations.
+func AddPVZCellHook(hookPoint boil.HookPoint, pvzCellHook PVZCellHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		pvzCellAfterSelectMu.Lock()
+		pvzCellAfterSelectHooks = append(pvzCellAfterSelectHooks, pvzCellHook)
+		pvzCellAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		pvzCellBeforeInsertMu.Lock()
+		pvzCellBeforeInsertHooks = append(pvzCellBeforeInsertHooks, pvzCellHook)
+		pvzCellBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		pvzCellAfterInsertMu.Lock()
+		pvzCellAfterInsertHooks = append(pvzCellAfterInsertHooks, pvzCellHook)
+		pvzCellAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		pvzCellBeforeUpdateMu.Lock()
+		pvzCellBeforeUpdateHooks = append(pvzCellBeforeUpdateHooks, pvzCellHook)
+		pvzCellBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		pvzCellAfterUpdateMu.Lock()
+		pvzCellAfterUpdateHooks = append(pvzCellAfterUpdateHooks, pvzCellHook)
+		pvzCellAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		pvzCellBeforeDeleteMu.Lock()
+		pvzCellBeforeDeleteHooks = append(pvzCellBeforeDeleteHooks, pvzCellHook)
+		pvzCellBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		pvzCellAfterDeleteMu.Lock()
+		pvzCellAfterDeleteHooks = append(pvzCellAfterDeleteHooks, pvzCellHook)
+		pvzCellAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		pvzCellBeforeUpsertMu.Lock()
+		pvzCellBeforeUpsertHooks = append(pvzCellBeforeUpsertHooks, pvzCellHook)
+		pvzCellBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		pvzCellAfterUpsertMu.Lock()
+		pvzCellAfterUpsertHooks = append(pvzCellAfterUpsertHooks, pvzCellHook)
+		pvzCellAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single pvzCell record from the query.
+func (q pvzCellQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PVZCell, error) {
+	o := &PVZCell{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for pvz_cells")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PVZCell records from the query.
+func (q pvzCellQuery) All(ctx context.Context, exec boil.ContextExecutor) (PVZCellSlice, error) {
+	var o []*PVZCell
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PVZCell slice")
+	}
+
+	if len(pvzCellAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PVZCell records in the query.
+func (q pvzCellQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count pvz_cells rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pvzCellQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if pvz_cells exists")
+	}
+
+	return count > 0, nil
+}
+
+// PVZ pointed to by the foreign key.
+func (o *PVZCell) PVZ(mods ...qm.QueryMod) pvzQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PVZID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PVZS(queryMods...)
+}
+
+// CellProducts retrieves all the product's Products with an executor via cell_id column.
+func (o *PVZCell) CellProducts(mods ...qm.QueryMod) productQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"products\".\"cell_id\"=?", o.ID),
+	)
+
+	return Products(queryMods...)
+}
+
+// LoadPVZ allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pvzCellL) LoadPVZ(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZCell interface{}, mods queries.Applicator) error {
+	var slice []*PVZCell
+	var object *PVZCell
+
+	if singular {
+		var ok bool
+		object, ok = maybePVZCell.(*PVZCell)
+		if !ok {
+			object = new(PVZCell)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePVZCell)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePVZCell))
+			}
+		}
+	} else {
+		s, ok := maybePVZCell.(*[]*PVZCell)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePVZCell)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePVZCell))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pvzCellR{}
+		}
+		args[object.PVZID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pvzCellR{}
+			}
+
+			args[obj.PVZID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`pvz`),
+		qm.WhereIn(`pvz.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PVZ")
+	}
+
+	var resultSlice []*PVZ
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PVZ")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for pvz")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pvz")
+	}
+
+	if len(pvzAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PVZ = foreign
+		if foreign.R == nil {
+			foreign.R = &pvzR{}
+		}
+		foreign.R.PVZCells = append(foreign.R.PVZCells, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PVZID == foreign.ID {
+				local.R.PVZ = foreign
+				if foreign.R == nil {
+					foreign.R = &pvzR{}
+				}
+				foreign.R.PVZCells = append(foreign.R.PVZCells, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCellProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pvzCellL) LoadCellProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZCell interface{}, mods queries.Applicator) error {
+	var slice []*PVZCell
+	var object *PVZCell
+
+	if singular {
+		var ok bool
+		object, ok = maybePVZCell.(*PVZCell)
+		if !ok {
+			object = new(PVZCell)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePVZCell)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePVZCell))
+			}
+		}
+	} else {
+		s, ok := maybePVZCell.(*[]*PVZCell)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePVZCell)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePVZCell))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pvzCellR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pvzCellR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.cell_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load products")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice products")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CellProducts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.Cell = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CellID) {
+				local.R.CellProducts = append(local.R.CellProducts, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.Cell = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPVZ of the pvzCell to the related item.
+// Sets o.R.PVZ to related.
+// Adds o to related.R.PVZCells.
+func (o *PVZCell) SetPVZ(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PVZ) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"pvz_cells\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"pvz_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pvzCellPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PVZID = related.ID
+	if o.R == nil {
+		o.R = &pvzCellR{
+			PVZ: related,
+		}
+	} else {
+		o.R.PVZ = related
+	}
+
+	if related.R == nil {
+		related.R = &pvzR{
+			PVZCells: PVZCellSlice{o},
+		}
+	} else {
+		related.R.PVZCells = append(related.R.PVZCells, o)
+	}
+
+	return nil
+}
+
+// AddCellProducts adds the given related objects to the existing relationships
+// of the pvz_cell, optionally inserting them as new records.
+// Appends related to o.R.CellProducts.
+// Sets related.R.Cell appropriately.
+func (o *PVZCell) AddCellProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CellID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"products\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"cell_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CellID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pvzCellR{
+			CellProducts: related,
+		}
+	} else {
+		o.R.CellProducts = append(o.R.CellProducts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productR{
+				Cell: o,
+			}
+		} else {
+			rel.R.Cell = o
+		}
+	}
+	return nil
+}
+
+// SetCellProducts removes all previously related items of the
+// pvz_cell replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Cell's CellProducts accordingly.
+// Replaces o.R.CellProducts with related.
+// Sets related.R.Cell's CellProducts accordingly.
+func (o *PVZCell) SetCellProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	query := "update \"products\" set \"cell_id\" = null where \"cell_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CellProducts {
+			queries.SetScanner(&rel.CellID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Cell = nil
+		}
+		o.R.CellProducts = nil
+	}
+
+	return o.AddCellProducts(ctx, exec, insert, related...)
+}
+
+// RemoveCellProducts relationships from objects passed in.
+// Removes related items from R.CellProducts (uses pointer comparison, removal does not keep order)
+// Sets related.R.Cell.
+func (o *PVZCell) RemoveCellProducts(ctx context.Context, exec boil.ContextExecutor, related ...*Product) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CellID, nil)
+		if rel.R != nil {
+			rel.R.Cell = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("cell_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CellProducts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CellProducts)
+			if ln > 1 && i < ln-1 {
+				o.R.CellProducts[i] = o.R.CellProducts[ln-1]
+			}
+			o.R.CellProducts = o.R.CellProducts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// PVZCells retrieves all the records using an executor.
+func PVZCells(mods ...qm.QueryMod) pvzCellQuery {
+	mods = append(mods, qm.From("\"pvz_cells\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"pvz_cells\".*"})
+	}
+
+	return pvzCellQuery{q}
+}
+
+// FindPVZCell retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPVZCell(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PVZCell, error) {
+	pvzCellObj := &PVZCell{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"pvz_cells\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, pvzCellObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from pvz_cells")
+	}
+
+	if err = pvzCellObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return pvzCellObj, err
+	}
+
+	return pvzCellObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PVZCell) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no pvz_cells provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pvzCellColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pvzCellInsertCacheMut.RLock()
+	cache, cached := pvzCellInsertCache[key]
+	pvzCellInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pvzCellAllColumns,
+			pvzCellColumnsWithDefault,
+			pvzCellColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pvzCellType, pvzCellMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pvzCellType, pvzCellMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"pvz_cells\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"pvz_cells\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into pvz_cells")
+	}
+
+	if !cached {
+		pvzCellInsertCacheMut.Lock()
+		pvzCellInsertCache[key] = cache
+		pvzCellInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PVZCell.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PVZCell) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	pvzCellUpdateCacheMut.RLock()
+	cache, cached := pvzCellUpdateCache[key]
+	pvzCellUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pvzCellAllColumns,
+			pvzCellPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update pvz_cells, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"pvz_cells\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pvzCellPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pvzCellType, pvzCellMapping, append(wl, pvzCellPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update pvz_cells row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for pvz_cells")
+	}
+
+	if !cached {
+		pvzCellUpdateCacheMut.Lock()
+		pvzCellUpdateCache[key] = cache
+		pvzCellUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pvzCellQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for pvz_cells")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for pvz_cells")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PVZCellSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pvzCellPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"pvz_cells\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pvzCellPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in pvzCell slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all pvzCell")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PVZCell) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no pvz_cells provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pvzCellColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pvzCellUpsertCacheMut.RLock()
+	cache, cached := pvzCellUpsertCache[key]
+	pvzCellUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pvzCellAllColumns,
+			pvzCellColumnsWithDefault,
+			pvzCellColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pvzCellAllColumns,
+			pvzCellPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert pvz_cells, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pvzCellAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pvzCellPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert pvz_cells, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(pvzCellPrimaryKeyColumns))
+			copy(conflict, pvzCellPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"pvz_cells\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(pvzCellType, pvzCellMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pvzCellType, pvzCellMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert pvz_cells")
+	}
+
+	if !cached {
+		pvzCellUpsertCacheMut.Lock()
+		pvzCellUpsertCache[key] = cache
+		pvzCellUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PVZCell record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PVZCell) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PVZCell provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pvzCellPrimaryKeyMapping)
+	sql := "DELETE FROM \"pvz_cells\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from pvz_cells")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for pvz_cells")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pvzCellQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pvzCellQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pvz_cells")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for pvz_cells")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PVZCellSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(pvzCellBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pvzCellPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"pvz_cells\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pvzCellPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pvzCell slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for pvz_cells")
+	}
+
+	if len(pvzCellAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PVZCell) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPVZCell(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PVZCellSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PVZCellSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pvzCellPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"pvz_cells\".* FROM \"pvz_cells\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pvzCellPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PVZCellSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PVZCellExists checks if the PVZCell row exists.
+func PVZCellExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"pvz_cells\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if pvz_cells exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PVZCell row exists.
+func (o *PVZCell) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PVZCellExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1179 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPVZCells(t *testing.T) {
+	t.Parallel()
+
+	query := PVZCells()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPVZCellsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPVZCellsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PVZCells().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPVZCellsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PVZCellSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPVZCellsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PVZCellExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PVZCell exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PVZCellExists to return true, but got false.")
+	}
+}
+
+func testPVZCellsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	pvzCellFound, err := FindPVZCell(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pvzCellFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPVZCellsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PVZCells().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPVZCellsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PVZCells().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPVZCellsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	pvzCellOne := &PVZCell{}
+	pvzCellTwo := &PVZCell{}
+	if err = randomize.Struct(seed, pvzCellOne, pvzCellDBTypes, false, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pvzCellTwo, pvzCellDBTypes, false, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pvzCellOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pvzCellTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PVZCells().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPVZCellsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	pvzCellOne := &PVZCell{}
+	pvzCellTwo := &PVZCell{}
+	if err = randomize.Struct(seed, pvzCellOne, pvzCellDBTypes, false, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pvzCellTwo, pvzCellDBTypes, false, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pvzCellOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pvzCellTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func pvzCellBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+	*o = PVZCell{}
+	return nil
+}
+
+func pvzCellAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+	*o = PVZCell{}
+	return nil
+}
+
+func pvzCellAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+	*o = PVZCell{}
+	return nil
+}
+
+func pvzCellBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+	*o = PVZCell{}
+	return nil
+}
+
+func pvzCellAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+	*o = PVZCell{}
+	return nil
+}
+
+func pvzCellBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+	*o = PVZCell{}
+	return nil
+}
+
+func pvzCellAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+	*o = PVZCell{}
+	return nil
+}
+
+func pvzCellBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+	*o = PVZCell{}
+	return nil
+}
+
+func pvzCellAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PVZCell) error {
+	*o = PVZCell{}
+	return nil
+}
+
+func testPVZCellsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PVZCell{}
+	o := &PVZCell{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PVZCell object: %s", err)
+	}
+
+	AddPVZCellHook(boil.BeforeInsertHook, pvzCellBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	pvzCellBeforeInsertHooks = []PVZCellHook{}
+
+	AddPVZCellHook(boil.AfterInsertHook, pvzCellAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	pvzCellAfterInsertHooks = []PVZCellHook{}
+
+	AddPVZCellHook(boil.AfterSelectHook, pvzCellAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	pvzCellAfterSelectHooks = []PVZCellHook{}
+
+	AddPVZCellHook(boil.BeforeUpdateHook, pvzCellBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	pvzCellBeforeUpdateHooks = []PVZCellHook{}
+
+	AddPVZCellHook(boil.AfterUpdateHook, pvzCellAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	pvzCellAfterUpdateHooks = []PVZCellHook{}
+
+	AddPVZCellHook(boil.BeforeDeleteHook, pvzCellBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	pvzCellBeforeDeleteHooks = []PVZCellHook{}
+
+	AddPVZCellHook(boil.AfterDeleteHook, pvzCellAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	pvzCellAfterDeleteHooks = []PVZCellHook{}
+
+	AddPVZCellHook(boil.BeforeUpsertHook, pvzCellBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	pvzCellBeforeUpsertHooks = []PVZCellHook{}
+
+	AddPVZCellHook(boil.AfterUpsertHook, pvzCellAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	pvzCellAfterUpsertHooks = []PVZCellHook{}
+}
+
+func testPVZCellsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPVZCellsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(pvzCellPrimaryKeyColumns, pvzCellColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPVZCellToManyCellProducts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZCell
+	var b, c Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, productDBTypes, false, productColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.CellID, a.ID)
+	queries.Assign(&c.CellID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.CellProducts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.CellID, b.CellID) {
+			bFound = true
+		}
+		if queries.Equal(v.CellID, c.CellID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PVZCellSlice{&a}
+	if err = a.L.LoadCellProducts(ctx, tx, false, (*[]*PVZCell)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CellProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.CellProducts = nil
+	if err = a.L.LoadCellProducts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.CellProducts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testPVZCellToManyAddOpCellProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZCell
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzCellDBTypes, false, strmangle.SetComplement(pvzCellPrimaryKeyColumns, pvzCellColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Product{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddCellProducts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.CellID) {
+			t.Error("foreign key was wrong value", a.ID, first.CellID)
+		}
+		if !queries.Equal(a.ID, second.CellID) {
+			t.Error("foreign key was wrong value", a.ID, second.CellID)
+		}
+
+		if first.R.Cell != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Cell != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.CellProducts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.CellProducts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.CellProducts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testPVZCellToManySetOpCellProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZCell
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzCellDBTypes, false, strmangle.SetComplement(pvzCellPrimaryKeyColumns, pvzCellColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetCellProducts(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CellProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetCellProducts(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CellProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CellID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CellID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.CellID) {
+		t.Error("foreign key was wrong value", a.ID, d.CellID)
+	}
+	if !queries.Equal(a.ID, e.CellID) {
+		t.Error("foreign key was wrong value", a.ID, e.CellID)
+	}
+
+	if b.R.Cell != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Cell != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Cell != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Cell != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.CellProducts[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.CellProducts[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testPVZCellToManyRemoveOpCellProducts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZCell
+	var b, c, d, e Product
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzCellDBTypes, false, strmangle.SetComplement(pvzCellPrimaryKeyColumns, pvzCellColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddCellProducts(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.CellProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveCellProducts(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.CellProducts().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.CellID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.CellID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Cell != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Cell != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Cell != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Cell != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.CellProducts) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.CellProducts[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.CellProducts[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testPVZCellToOnePVZUsingPVZ(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PVZCell
+	var foreign PVZ
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pvzCellDBTypes, false, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, pvzDBTypes, false, pvzColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZ struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PVZID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.PVZ().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddPVZHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *PVZ) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PVZCellSlice{&local}
+	if err = local.L.LoadPVZ(ctx, tx, false, (*[]*PVZCell)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZ == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.PVZ = nil
+	if err = local.L.LoadPVZ(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZ == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPVZCellToOneSetOpPVZUsingPVZ(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZCell
+	var b, c PVZ
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzCellDBTypes, false, strmangle.SetComplement(pvzCellPrimaryKeyColumns, pvzCellColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*PVZ{&b, &c} {
+		err = a.SetPVZ(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.PVZ != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PVZCells[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PVZID != x.ID {
+			t.Error("foreign key was wrong value", a.PVZID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PVZID))
+		reflect.Indirect(reflect.ValueOf(&a.PVZID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.PVZID != x.ID {
+			t.Error("foreign key was wrong value", a.PVZID, x.ID)
+		}
+	}
+}
+
+func testPVZCellsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPVZCellsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PVZCellSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPVZCellsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PVZCells().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	pvzCellDBTypes = map[string]string{`ID`: `uuid`, `PVZID`: `bigint`, `Code`: `character varying`, `Capacity`: `integer`, `CreatedAt`: `timestamp without time zone`}
+	_              = bytes.MinRead
+)
+
+func testPVZCellsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(pvzCellPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(pvzCellAllColumns) == len(pvzCellPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPVZCellsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(pvzCellAllColumns) == len(pvzCellPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZCell{}
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pvzCellDBTypes, true, pvzCellPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(pvzCellAllColumns, pvzCellPrimaryKeyColumns) {
+		fields = pvzCellAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			pvzCellAllColumns,
+			pvzCellPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PVZCellSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPVZCellsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(pvzCellAllColumns) == len(pvzCellPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PVZCell{}
+	if err = randomize.Struct(seed, &o, pvzCellDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PVZCell: %s", err)
+	}
+
+	count, err := PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, pvzCellDBTypes, false, pvzCellPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PVZCell struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PVZCell: %s", err)
+	}
+
+	count, err = PVZCells().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1586 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// PVZStaff is an object representing the database table.
+type PVZStaff struct {
+	PVZID      int64       `boil:"pvz_id" json:"pvz_id" toml:"pvz_id" yaml:"pvz_id"`
+	UserID     string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	AssignedBy null.String `boil:"assigned_by" json:"assigned_by,omitempty" toml:"assigned_by" yaml:"assigned_by,omitempty"`
+	CreatedAt  time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *pvzStaffR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pvzStaffL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PVZStaffColumns = struct {
+	PVZID      string
+	UserID     string
+	AssignedBy string
+	CreatedAt  string
+}{
+	PVZID:      "pvz_id",
+	UserID:     "user_id",
+	AssignedBy: "assigned_by",
+	CreatedAt:  "created_at",
+}
+
+var PVZStaffTableColumns = struct {
+	PVZID      string
+	UserID     string
+	AssignedBy string
+	CreatedAt  string
+}{
+	PVZID:      "pvz_staff.pvz_id",
+	UserID:     "pvz_staff.user_id",
+	AssignedBy: "pvz_staff.assigned_by",
+	CreatedAt:  "pvz_staff.created_at",
+}
+
+// Generated where
+
+var PVZStaffWhere = struct {
+	PVZID      whereHelperint64
+	UserID     whereHelperstring
+	AssignedBy whereHelpernull_String
+	CreatedAt  whereHelpertime_Time
+}{
+	PVZID:      whereHelperint64{field: "\"pvz_staff\".\"pvz_id\""},
+	UserID:     whereHelperstring{field: "\"pvz_staff\".\"user_id\""},
+	AssignedBy: whereHelpernull_String{field: "\"pvz_staff\".\"assigned_by\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"pvz_staff\".\"created_at\""},
+}
+
+// PVZStaffRels is where relationship names are stored.
+var PVZStaffRels = struct {
+	PVZ            string
+	User           string
+	AssignedByUser string
+}{
+	PVZ:            "PVZ",
+	User:           "User",
+	AssignedByUser: "AssignedByUser",
+}
+
+// pvzStaffR is where relationships are stored.
+type pvzStaffR struct {
+	PVZ            *PVZ  `boil:"PVZ" json:"PVZ" toml:"PVZ" yaml:"PVZ"`
+	User           *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	AssignedByUser *User `boil:"AssignedByUser" json:"AssignedByUser" toml:"AssignedByUser" yaml:"AssignedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pvzStaffR) NewStruct() *pvzStaffR {
+	return &pvzStaffR{}
+}
+
+func (o *PVZStaff) GetPVZ() *PVZ {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPVZ()
+}
+
+func (r *pvzStaffR) GetPVZ() *PVZ {
+	if r == nil {
+		return nil
+	}
+
+	return r.PVZ
+}
+
+func (o *PVZStaff) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *pvzStaffR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+func (o *PVZStaff) GetAssignedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAssignedByUser()
+}
+
+func (r *pvzStaffR) GetAssignedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.AssignedByUser
+}
+
+// pvzStaffL is where Load methods for each relationship are stored.
+type pvzStaffL struct{}
+
+var (
+	pvzStaffAllColumns            = []string{"pvz_id", "user_id", "assigned_by", "created_at"}
+	pvzStaffColumnsWithoutDefault = []string{"pvz_id", "user_id"}
+	pvzStaffColumnsWithDefault    = []string{"assigned_by", "created_at"}
+	pvzStaffPrimaryKeyColumns     = []string{"pvz_id", "user_id"}
+	pvzStaffGeneratedColumns      = []string{}
+)
+
+type (
+	// PVZStaffSlice is an alias for a slice of pointers to PVZStaff.
+	// This should almost always be used instead of []PVZStaff.
+	PVZStaffSlice []*PVZStaff
+	// PVZStaffHook is the signature for custom PVZStaff hook methods
+	PVZStaffHook func(context.Context, boil.ContextExecutor, *PVZStaff) error
+
+	pvzStaffQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pvzStaffType                 = reflect.TypeOf(&PVZStaff{})
+	pvzStaffMapping              = queries.MakeStructMapping(pvzStaffType)
+	pvzStaffPrimaryKeyMapping, _ = queries.BindMapping(pvzStaffType, pvzStaffMapping, pvzStaffPrimaryKeyColumns)
+	pvzStaffInsertCacheMut       sync.RWMutex
+	pvzStaffInsertCache          = make(map[string]insertCache)
+	pvzStaffUpdateCacheMut       sync.RWMutex
+	pvzStaffUpdateCache          = make(map[string]updateCache)
+	pvzStaffUpsertCacheMut       sync.RWMutex
+	pvzStaffUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var pvzStaffAfterSelectMu sync.Mutex
+var pvzStaffAfterSelectHooks []PVZStaffHook
+
+var pvzStaffBeforeInsertMu sync.Mutex
+var pvzStaffBeforeInsertHooks []PVZStaffHook
+var pvzStaffAfterInsertMu sync.Mutex
+var pvzStaffAfterInsertHooks []PVZStaffHook
+
+var pvzStaffBeforeUpdateMu sync.Mutex
+var pvzStaffBeforeUpdateHooks []PVZStaffHook
+var pvzStaffAfterUpdateMu sync.Mutex
+var pvzStaffAfterUpdateHooks []PVZStaffHook
+
+var pvzStaffBeforeDeleteMu sync.Mutex
+var pvzStaffBeforeDeleteHooks []PVZStaffHook
+var pvzStaffAfterDeleteMu sync.Mutex
+var pvzStaffAfterDeleteHooks []PVZStaffHook
+
+var pvzStaffBeforeUpsertMu sync.Mutex
+var pvzStaffBeforeUpsertHooks []PVZStaffHook
+var pvzStaffAfterUpsertMu sync.Mutex
+var pvzStaffAfterUpsertHooks []PVZStaffHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PVZStaff) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzStaffAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PVZStaff) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzStaffBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PVZStaff) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzStaffAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PVZStaff) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzStaffBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PVZStaff) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzStaffAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PVZStaff) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzStaffBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PVZStaff) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzStaffAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PVZStaff) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzStaffBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PVZStaff) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range pvzStaffAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPVZStaffHook registers your hook function for all future operations.
+func AddPVZStaffHook(hookPoint boil.HookPoint, pvzStaffHook PVZStaffHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		pvzStaffAfterSelectMu.Lock()
+		pvzStaffAfterSelectHooks = append(pvzStaffAfterSelectHooks, pvzStaffHook)
+		pvzStaffAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		pvzStaffBeforeInsertMu.Lock()
+		pvzStaffBeforeInsertHooks = append(pvzStaffBeforeInsertHooks, pvzStaffHook)
+		pvzStaffBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		pvzStaffAfterInsertMu.Lock()
+		pvzStaffAfterInsertHooks = append(pvzStaffAfterInsertHooks, pvzStaffHook)
+		pvzStaffAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		pvzStaffBeforeUpdateMu.Lock()
+		pvzStaffBeforeUpdateHooks = append(pvzStaffBeforeUpdateHooks, pvzStaffHook)
+		pvzStaffBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		pvzStaffAfterUpdateMu.Lock()
+		pvzStaffAfterUpdateHooks = append(pvzStaffAfterUpdateHooks, pvzStaffHook)
+		pvzStaffAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		pvzStaffBeforeDeleteMu.Lock()
+		pvzStaffBeforeDeleteHooks = append(pvzStaffBeforeDeleteHooks, pvzStaffHook)
+		pvzStaffBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		pvzStaffAfterDeleteMu.Lock()
+		pvzStaffAfterDeleteHooks = append(pvzStaffAfterDeleteHooks, pvzStaffHook)
+		pvzStaffAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		pvzStaffBeforeUpsertMu.Lock()
+		pvzStaffBeforeUpsertHooks = append(pvzStaffBeforeUpsertHooks, pvzStaffHook)
+		pvzStaffBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		pvzStaffAfterUpsertMu.Lock()
+		pvzStaffAfterUpsertHooks = append(pvzStaffAfterUpsertHooks, pvzStaffHook)
+		pvzStaffAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single pvzStaff record from the query.
+func (q pvzStaffQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PVZStaff, error) {
+	o := &PVZStaff{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for pvz_staff")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PVZStaff records from the query.
+func (q pvzStaffQuery) All(ctx context.Context, exec boil.ContextExecutor) (PVZStaffSlice, error) {
+	var o []*PVZStaff
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PVZStaff slice")
+	}
+
+	if len(pvzStaffAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PVZStaff records in the query.
+func (q pvzStaffQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count pvz_staff rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pvzStaffQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if pvz_staff exists")
+	}
+
+	return count > 0, nil
+}
+
+// PVZ pointed to by the foreign key.
+func (o *PVZStaff) PVZ(mods ...qm.QueryMod) pvzQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PVZID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PVZS(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *PVZStaff) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// AssignedByUser pointed to by the foreign key.
+func (o *PVZStaff) AssignedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AssignedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadPVZ allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pvzStaffL) LoadPVZ(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZStaff interface{}, mods queries.Applicator) error {
+	var slice []*PVZStaff
+	var object *PVZStaff
+
+	if singular {
+		var ok bool
+		object, ok = maybePVZStaff.(*PVZStaff)
+		if !ok {
+			object = new(PVZStaff)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePVZStaff)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePVZStaff))
+			}
+		}
+	} else {
+		s, ok := maybePVZStaff.(*[]*PVZStaff)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePVZStaff)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePVZStaff))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pvzStaffR{}
+		}
+		args[object.PVZID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pvzStaffR{}
+			}
+
+			args[obj.PVZID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`pvz`),
+		qm.WhereIn(`pvz.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PVZ")
+	}
+
+	var resultSlice []*PVZ
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PVZ")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for pvz")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pvz")
+	}
+
+	if len(pvzAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PVZ = foreign
+		if foreign.R == nil {
+			foreign.R = &pvzR{}
+		}
+		foreign.R.PVZStaffs = append(foreign.R.PVZStaffs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PVZID == foreign.ID {
+				local.R.PVZ = foreign
+				if foreign.R == nil {
+					foreign.R = &pvzR{}
+				}
+				foreign.R.PVZStaffs = append(foreign.R.PVZStaffs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pvzStaffL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZStaff interface{}, mods queries.Applicator) error {
+	var slice []*PVZStaff
+	var object *PVZStaff
+
+	if singular {
+		var ok bool
+		object, ok = maybePVZStaff.(*PVZStaff)
+		if !ok {
+			object = new(PVZStaff)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePVZStaff)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePVZStaff))
+			}
+		}
+	} else {
+		s, ok := maybePVZStaff.(*[]*PVZStaff)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePVZStaff)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePVZStaff))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pvzStaffR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pvzStaffR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PVZStaffs = append(foreign.R.PVZStaffs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PVZStaffs = append(foreign.R.PVZStaffs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssignedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pvzStaffL) LoadAssignedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePVZStaff interface{}, mods queries.Applicator) error {
+	var slice []*PVZStaff
+	var object *PVZStaff
+
+	if singular {
+		var ok bool
+		object, ok = maybePVZStaff.(*PVZStaff)
+		if !ok {
+			object = new(PVZStaff)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePVZStaff)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePVZStaff))
+			}
+		}
+	} else {
+		s, ok := maybePVZStaff.(*[]*PVZStaff)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePVZStaff)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePVZStaff))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &pvzStaffR{}
+		}
+		if !queries.IsNil(object.AssignedBy) {
+			args[object.AssignedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pvzStaffR{}
+			}
+
+			if !queries.IsNil(obj.AssignedBy) {
+				args[obj.AssignedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AssignedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AssignedByPVZStaffs = append(foreign.R.AssignedByPVZStaffs, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AssignedBy, foreign.ID) {
+				local.R.AssignedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AssignedByPVZStaffs = append(foreign.R.AssignedByPVZStaffs, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPVZ of the pvzStaff to the related item.
+// Sets o.R.PVZ to related.
+// Adds o to related.R.PVZStaffs.
+func (o *PVZStaff) SetPVZ(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PVZ) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"pvz_staff\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"pvz_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pvzStaffPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PVZID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PVZID = related.ID
+	if o.R == nil {
+		o.R = &pvzStaffR{
+			PVZ: related,
+		}
+	} else {
+		o.R.PVZ = related
+	}
+
+	if related.R == nil {
+		related.R = &pvzR{
+			PVZStaffs: PVZStaffSlice{o},
+		}
+	} else {
+		related.R.PVZStaffs = append(related.R.PVZStaffs, o)
+	}
+
+	return nil
+}
+
+// SetUser of the pvzStaff to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PVZStaffs.
+func (o *PVZStaff) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"pvz_staff\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pvzStaffPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PVZID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &pvzStaffR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PVZStaffs: PVZStaffSlice{o},
+		}
+	} else {
+		related.R.PVZStaffs = append(related.R.PVZStaffs, o)
+	}
+
+	return nil
+}
+
+// SetAssignedByUser of the pvzStaff to the related item.
+// Sets o.R.AssignedByUser to related.
+// Adds o to related.R.AssignedByPVZStaffs.
+func (o *PVZStaff) SetAssignedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"pvz_staff\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"assigned_by"}),
+		strmangle.WhereClause("\"", "\"", 2, pvzStaffPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.PVZID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AssignedBy, related.ID)
+	if o.R == nil {
+		o.R = &pvzStaffR{
+			AssignedByUser: related,
+		}
+	} else {
+		o.R.AssignedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AssignedByPVZStaffs: PVZStaffSlice{o},
+		}
+	} else {
+		related.R.AssignedByPVZStaffs = append(related.R.AssignedByPVZStaffs, o)
+	}
+
+	return nil
+}
+
+// RemoveAssignedByUser relationship.
+// Sets o.R.AssignedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *PVZStaff) RemoveAssignedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AssignedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("assigned_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AssignedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AssignedByPVZStaffs {
+		if queries.Equal(o.AssignedBy, ri.AssignedBy) {
+			continue
+		}
+
+		ln := len(related.R.AssignedByPVZStaffs)
+		if ln > 1 && i < ln-1 {
+			related.R.AssignedByPVZStaffs[i] = related.R.AssignedByPVZStaffs[ln-1]
+		}
+		related.R.AssignedByPVZStaffs = related.R.AssignedByPVZStaffs[:ln-1]
+		break
+	}
+	return nil
+}
+
+// PVZStaffs retrieves all the records using an executor.
+func PVZStaffs(mods ...qm.QueryMod) pvzStaffQuery {
+	mods = append(mods, qm.From("\"pvz_staff\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"pvz_staff\".*"})
+	}
+
+	return pvzStaffQuery{q}
+}
+
+// FindPVZStaff retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPVZStaff(ctx context.Context, exec boil.ContextExecutor, pVZID int64, userID string, selectCols ...string) (*PVZStaff, error) {
+	pvzStaffObj := &PVZStaff{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"pvz_staff\" where \"pvz_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, pVZID, userID)
+
+	err := q.Bind(ctx, exec, pvzStaffObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from pvz_staff")
+	}
+
+	if err = pvzStaffObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return pvzStaffObj, err
+	}
+
+	return pvzStaffObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PVZStaff) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no pvz_staff provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pvzStaffColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pvzStaffInsertCacheMut.RLock()
+	cache, cached := pvzStaffInsertCache[key]
+	pvzStaffInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pvzStaffAllColumns,
+			pvzStaffColumnsWithDefault,
+			pvzStaffColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pvzStaffType, pvzStaffMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pvzStaffType, pvzStaffMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"pvz_staff\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"pvz_staff\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into pvz_staff")
+	}
+
+	if !cached {
+		pvzStaffInsertCacheMut.Lock()
+		pvzStaffInsertCache[key] = cache
+		pvzStaffInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PVZStaff.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PVZStaff) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	pvzStaffUpdateCacheMut.RLock()
+	cache, cached := pvzStaffUpdateCache[key]
+	pvzStaffUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pvzStaffAllColumns,
+			pvzStaffPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update pvz_staff, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"pvz_staff\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pvzStaffPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pvzStaffType, pvzStaffMapping, append(wl, pvzStaffPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update pvz_staff row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for pvz_staff")
+	}
+
+	if !cached {
+		pvzStaffUpdateCacheMut.Lock()
+		pvzStaffUpdateCache[key] = cache
+		pvzStaffUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pvzStaffQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for pvz_staff")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for pvz_staff")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PVZStaffSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pvzStaffPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"pvz_staff\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pvzStaffPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in pvzStaff slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all pvzStaff")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PVZStaff) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no pvz_staff provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pvzStaffColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pvzStaffUpsertCacheMut.RLock()
+	cache, cached := pvzStaffUpsertCache[key]
+	pvzStaffUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			pvzStaffAllColumns,
+			pvzStaffColumnsWithDefault,
+			pvzStaffColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pvzStaffAllColumns,
+			pvzStaffPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert pvz_staff, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(pvzStaffAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(pvzStaffPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert pvz_staff, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(pvzStaffPrimaryKeyColumns))
+			copy(conflict, pvzStaffPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"pvz_staff\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(pvzStaffType, pvzStaffMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pvzStaffType, pvzStaffMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert pvz_staff")
+	}
+
+	if !cached {
+		pvzStaffUpsertCacheMut.Lock()
+		pvzStaffUpsertCache[key] = cache
+		pvzStaffUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PVZStaff record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PVZStaff) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PVZStaff provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pvzStaffPrimaryKeyMapping)
+	sql := "DELETE FROM \"pvz_staff\" WHERE \"pvz_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from pvz_staff")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for pvz_staff")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pvzStaffQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pvzStaffQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pvz_staff")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for pvz_staff")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PVZStaffSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(pvzStaffBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pvzStaffPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"pvz_staff\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pvzStaffPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pvzStaff slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for pvz_staff")
+	}
+
+	if len(pvzStaffAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PVZStaff) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPVZStaff(ctx, exec, o.PVZID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PVZStaffSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PVZStaffSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pvzStaffPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"pvz_staff\".* FROM \"pvz_staff\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pvzStaffPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PVZStaffSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PVZStaffExists checks if the PVZStaff row exists.
+func PVZStaffExists(ctx context.Context, exec boil.ContextExecutor, pVZID int64, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"pvz_staff\" where \"pvz_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, pVZID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, pVZID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if pvz_staff exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PVZStaff row exists.
+func (o *PVZStaff) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PVZStaffExists(ctx, exec, o.PVZID, o.UserID)
+}
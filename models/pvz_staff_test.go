@@ -0,0 +1,1130 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPVZStaffs(t *testing.T) {
+	t.Parallel()
+
+	query := PVZStaffs()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPVZStaffsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPVZStaffsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PVZStaffs().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPVZStaffsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PVZStaffSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPVZStaffsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PVZStaffExists(ctx, tx, o.PVZID, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if PVZStaff exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PVZStaffExists to return true, but got false.")
+	}
+}
+
+func testPVZStaffsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	pvzStaffFound, err := FindPVZStaff(ctx, tx, o.PVZID, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pvzStaffFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPVZStaffsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PVZStaffs().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPVZStaffsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PVZStaffs().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPVZStaffsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	pvzStaffOne := &PVZStaff{}
+	pvzStaffTwo := &PVZStaff{}
+	if err = randomize.Struct(seed, pvzStaffOne, pvzStaffDBTypes, false, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pvzStaffTwo, pvzStaffDBTypes, false, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pvzStaffOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pvzStaffTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PVZStaffs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPVZStaffsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	pvzStaffOne := &PVZStaff{}
+	pvzStaffTwo := &PVZStaff{}
+	if err = randomize.Struct(seed, pvzStaffOne, pvzStaffDBTypes, false, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pvzStaffTwo, pvzStaffDBTypes, false, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pvzStaffOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pvzStaffTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func pvzStaffBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PVZStaff) error {
+	*o = PVZStaff{}
+	return nil
+}
+
+func pvzStaffAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PVZStaff) error {
+	*o = PVZStaff{}
+	return nil
+}
+
+func pvzStaffAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PVZStaff) error {
+	*o = PVZStaff{}
+	return nil
+}
+
+func pvzStaffBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PVZStaff) error {
+	*o = PVZStaff{}
+	return nil
+}
+
+func pvzStaffAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PVZStaff) error {
+	*o = PVZStaff{}
+	return nil
+}
+
+func pvzStaffBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PVZStaff) error {
+	*o = PVZStaff{}
+	return nil
+}
+
+func pvzStaffAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PVZStaff) error {
+	*o = PVZStaff{}
+	return nil
+}
+
+func pvzStaffBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PVZStaff) error {
+	*o = PVZStaff{}
+	return nil
+}
+
+func pvzStaffAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PVZStaff) error {
+	*o = PVZStaff{}
+	return nil
+}
+
+func testPVZStaffsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PVZStaff{}
+	o := &PVZStaff{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PVZStaff object: %s", err)
+	}
+
+	AddPVZStaffHook(boil.BeforeInsertHook, pvzStaffBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	pvzStaffBeforeInsertHooks = []PVZStaffHook{}
+
+	AddPVZStaffHook(boil.AfterInsertHook, pvzStaffAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	pvzStaffAfterInsertHooks = []PVZStaffHook{}
+
+	AddPVZStaffHook(boil.AfterSelectHook, pvzStaffAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	pvzStaffAfterSelectHooks = []PVZStaffHook{}
+
+	AddPVZStaffHook(boil.BeforeUpdateHook, pvzStaffBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	pvzStaffBeforeUpdateHooks = []PVZStaffHook{}
+
+	AddPVZStaffHook(boil.AfterUpdateHook, pvzStaffAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	pvzStaffAfterUpdateHooks = []PVZStaffHook{}
+
+	AddPVZStaffHook(boil.BeforeDeleteHook, pvzStaffBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	pvzStaffBeforeDeleteHooks = []PVZStaffHook{}
+
+	AddPVZStaffHook(boil.AfterDeleteHook, pvzStaffAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	pvzStaffAfterDeleteHooks = []PVZStaffHook{}
+
+	AddPVZStaffHook(boil.BeforeUpsertHook, pvzStaffBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	pvzStaffBeforeUpsertHooks = []PVZStaffHook{}
+
+	AddPVZStaffHook(boil.AfterUpsertHook, pvzStaffAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	pvzStaffAfterUpsertHooks = []PVZStaffHook{}
+}
+
+func testPVZStaffsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPVZStaffsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(pvzStaffPrimaryKeyColumns, pvzStaffColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPVZStaffToOnePVZUsingPVZ(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PVZStaff
+	var foreign PVZ
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pvzStaffDBTypes, false, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, pvzDBTypes, false, pvzColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZ struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PVZID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.PVZ().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddPVZHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *PVZ) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PVZStaffSlice{&local}
+	if err = local.L.LoadPVZ(ctx, tx, false, (*[]*PVZStaff)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZ == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.PVZ = nil
+	if err = local.L.LoadPVZ(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZ == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPVZStaffToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PVZStaff
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pvzStaffDBTypes, false, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PVZStaffSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*PVZStaff)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPVZStaffToOneUserUsingAssignedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PVZStaff
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.AssignedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.AssignedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PVZStaffSlice{&local}
+	if err = local.L.LoadAssignedByUser(ctx, tx, false, (*[]*PVZStaff)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.AssignedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.AssignedByUser = nil
+	if err = local.L.LoadAssignedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.AssignedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPVZStaffToOneSetOpPVZUsingPVZ(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZStaff
+	var b, c PVZ
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzStaffDBTypes, false, strmangle.SetComplement(pvzStaffPrimaryKeyColumns, pvzStaffColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*PVZ{&b, &c} {
+		err = a.SetPVZ(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.PVZ != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PVZStaffs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.PVZID != x.ID {
+			t.Error("foreign key was wrong value", a.PVZID)
+		}
+
+		if exists, err := PVZStaffExists(ctx, tx, a.PVZID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testPVZStaffToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZStaff
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzStaffDBTypes, false, strmangle.SetComplement(pvzStaffPrimaryKeyColumns, pvzStaffColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PVZStaffs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := PVZStaffExists(ctx, tx, a.PVZID, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testPVZStaffToOneSetOpUserUsingAssignedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZStaff
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzStaffDBTypes, false, strmangle.SetComplement(pvzStaffPrimaryKeyColumns, pvzStaffColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetAssignedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.AssignedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.AssignedByPVZStaffs[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.AssignedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.AssignedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.AssignedBy))
+		reflect.Indirect(reflect.ValueOf(&a.AssignedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.AssignedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.AssignedBy, x.ID)
+		}
+	}
+}
+
+func testPVZStaffToOneRemoveOpUserUsingAssignedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZStaff
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzStaffDBTypes, false, strmangle.SetComplement(pvzStaffPrimaryKeyColumns, pvzStaffColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetAssignedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveAssignedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.AssignedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.AssignedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.AssignedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.AssignedByPVZStaffs) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testPVZStaffsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPVZStaffsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PVZStaffSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPVZStaffsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PVZStaffs().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	pvzStaffDBTypes = map[string]string{`PVZID`: `bigint`, `UserID`: `uuid`, `AssignedBy`: `uuid`, `CreatedAt`: `timestamp without time zone`}
+	_               = bytes.MinRead
+)
+
+func testPVZStaffsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(pvzStaffPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(pvzStaffAllColumns) == len(pvzStaffPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPVZStaffsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(pvzStaffAllColumns) == len(pvzStaffPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PVZStaff{}
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pvzStaffDBTypes, true, pvzStaffPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(pvzStaffAllColumns, pvzStaffPrimaryKeyColumns) {
+		fields = pvzStaffAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			pvzStaffAllColumns,
+			pvzStaffPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PVZStaffSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPVZStaffsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(pvzStaffAllColumns) == len(pvzStaffPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PVZStaff{}
+	if err = randomize.Struct(seed, &o, pvzStaffDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PVZStaff: %s", err)
+	}
+
+	count, err := PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, pvzStaffDBTypes, false, pvzStaffPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PVZStaff struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PVZStaff: %s", err)
+	}
+
+	count, err = PVZStaffs().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
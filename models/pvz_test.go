@@ -494,6 +494,162 @@ func testPVZSInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testPVZToManyPVZCells(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZ
+	var b, c PVZCell
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzDBTypes, true, pvzColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZ struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, pvzCellDBTypes, false, pvzCellColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pvzCellDBTypes, false, pvzCellColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.PVZID = a.ID
+	c.PVZID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PVZCells().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.PVZID == b.PVZID {
+			bFound = true
+		}
+		if v.PVZID == c.PVZID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PVZSlice{&a}
+	if err = a.L.LoadPVZCells(ctx, tx, false, (*[]*PVZ)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PVZCells); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PVZCells = nil
+	if err = a.L.LoadPVZCells(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PVZCells); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testPVZToManyPVZStaffs(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZ
+	var b, c PVZStaff
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzDBTypes, true, pvzColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZ struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, pvzStaffDBTypes, false, pvzStaffColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pvzStaffDBTypes, false, pvzStaffColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.PVZID = a.ID
+	c.PVZID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PVZStaffs().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.PVZID == b.PVZID {
+			bFound = true
+		}
+		if v.PVZID == c.PVZID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PVZSlice{&a}
+	if err = a.L.LoadPVZStaffs(ctx, tx, false, (*[]*PVZ)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PVZStaffs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PVZStaffs = nil
+	if err = a.L.LoadPVZStaffs(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PVZStaffs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testPVZToManyReceptions(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -572,7 +728,84 @@ func testPVZToManyReceptions(t *testing.T) {
 	}
 }
 
-func testPVZToManyAddOpReceptions(t *testing.T) {
+func testPVZToManyWebhooks(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZ
+	var b, c Webhook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzDBTypes, true, pvzColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZ struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, webhookDBTypes, false, webhookColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, webhookDBTypes, false, webhookColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.PVZID, a.ID)
+	queries.Assign(&c.PVZID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Webhooks().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.PVZID, b.PVZID) {
+			bFound = true
+		}
+		if queries.Equal(v.PVZID, c.PVZID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PVZSlice{&a}
+	if err = a.L.LoadWebhooks(ctx, tx, false, (*[]*PVZ)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Webhooks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Webhooks = nil
+	if err = a.L.LoadWebhooks(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Webhooks); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testPVZToManyAddOpPVZCells(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -580,15 +813,15 @@ func testPVZToManyAddOpReceptions(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a PVZ
-	var b, c, d, e Reception
+	var b, c, d, e PVZCell
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Reception{&b, &c, &d, &e}
+	foreigners := []*PVZCell{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, pvzCellDBTypes, false, strmangle.SetComplement(pvzCellPrimaryKeyColumns, pvzCellColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -603,13 +836,13 @@ func testPVZToManyAddOpReceptions(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Reception{
+	foreignersSplitByInsertion := [][]*PVZCell{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddReceptions(ctx, tx, i != 0, x...)
+		err = a.AddPVZCells(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -631,14 +864,14 @@ func testPVZToManyAddOpReceptions(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.Receptions[i*2] != first {
+		if a.R.PVZCells[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.Receptions[i*2+1] != second {
+		if a.R.PVZCells[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.Receptions().Count(ctx, tx)
+		count, err := a.PVZCells().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -647,6 +880,525 @@ func testPVZToManyAddOpReceptions(t *testing.T) {
 		}
 	}
 }
+func testPVZToManyAddOpPVZStaffs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZ
+	var b, c, d, e PVZStaff
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PVZStaff{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, pvzStaffDBTypes, false, strmangle.SetComplement(pvzStaffPrimaryKeyColumns, pvzStaffColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*PVZStaff{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPVZStaffs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.PVZID {
+			t.Error("foreign key was wrong value", a.ID, first.PVZID)
+		}
+		if a.ID != second.PVZID {
+			t.Error("foreign key was wrong value", a.ID, second.PVZID)
+		}
+
+		if first.R.PVZ != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.PVZ != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PVZStaffs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PVZStaffs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PVZStaffs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testPVZToManyAddOpReceptions(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZ
+	var b, c, d, e Reception
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Reception{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Reception{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddReceptions(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.PVZID {
+			t.Error("foreign key was wrong value", a.ID, first.PVZID)
+		}
+		if a.ID != second.PVZID {
+			t.Error("foreign key was wrong value", a.ID, second.PVZID)
+		}
+
+		if first.R.PVZ != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.PVZ != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Receptions[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Receptions[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Receptions().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testPVZToManyAddOpWebhooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZ
+	var b, c, d, e Webhook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Webhook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookDBTypes, false, strmangle.SetComplement(webhookPrimaryKeyColumns, webhookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Webhook{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWebhooks(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.PVZID) {
+			t.Error("foreign key was wrong value", a.ID, first.PVZID)
+		}
+		if !queries.Equal(a.ID, second.PVZID) {
+			t.Error("foreign key was wrong value", a.ID, second.PVZID)
+		}
+
+		if first.R.PVZ != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.PVZ != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Webhooks[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Webhooks[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Webhooks().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testPVZToManySetOpWebhooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZ
+	var b, c, d, e Webhook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Webhook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookDBTypes, false, strmangle.SetComplement(webhookPrimaryKeyColumns, webhookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetWebhooks(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetWebhooks(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.PVZID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.PVZID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.PVZID) {
+		t.Error("foreign key was wrong value", a.ID, d.PVZID)
+	}
+	if !queries.Equal(a.ID, e.PVZID) {
+		t.Error("foreign key was wrong value", a.ID, e.PVZID)
+	}
+
+	if b.R.PVZ != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.PVZ != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.PVZ != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.PVZ != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Webhooks[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Webhooks[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testPVZToManyRemoveOpWebhooks(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZ
+	var b, c, d, e Webhook
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Webhook{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookDBTypes, false, strmangle.SetComplement(webhookPrimaryKeyColumns, webhookColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddWebhooks(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveWebhooks(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.PVZID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.PVZID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.PVZ != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.PVZ != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.PVZ != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.PVZ != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Webhooks) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Webhooks[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Webhooks[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testPVZToOneCityUsingPVZCity(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PVZ
+	var foreign City
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pvzDBTypes, false, pvzColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZ struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, cityDBTypes, false, cityColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize City struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.City = foreign.Name
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.PVZCity().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.Name != foreign.Name {
+		t.Errorf("want: %v, got %v", foreign.Name, check.Name)
+	}
+
+	ranAfterSelectHook := false
+	AddCityHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *City) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := PVZSlice{&local}
+	if err = local.L.LoadPVZCity(ctx, tx, false, (*[]*PVZ)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZCity == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.PVZCity = nil
+	if err = local.L.LoadPVZCity(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZCity == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testPVZToOneSetOpCityUsingPVZCity(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PVZ
+	var b, c City
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, cityDBTypes, false, strmangle.SetComplement(cityPrimaryKeyColumns, cityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, cityDBTypes, false, strmangle.SetComplement(cityPrimaryKeyColumns, cityColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*City{&b, &c} {
+		err = a.SetPVZCity(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.PVZCity != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PVZS[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.City != x.Name {
+			t.Error("foreign key was wrong value", a.City)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.City))
+		reflect.Indirect(reflect.ValueOf(&a.City)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.City != x.Name {
+			t.Error("foreign key was wrong value", a.City, x.Name)
+		}
+	}
+}
 
 func testPVZSReload(t *testing.T) {
 	t.Parallel()
@@ -722,7 +1474,7 @@ func testPVZSSelect(t *testing.T) {
 }
 
 var (
-	pvzDBTypes = map[string]string{`ID`: `bigint`, `Name`: `character varying`, `City`: `character varying`, `CreatedAt`: `timestamp without time zone`}
+	pvzDBTypes = map[string]string{`ID`: `bigint`, `Name`: `character varying`, `City`: `character varying`, `CreatedAt`: `timestamp without time zone`, `Capacity`: `integer`, `CapacityPolicy`: `character varying`}
 	_          = bytes.MinRead
 )
 
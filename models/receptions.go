@@ -13,106 +13,124 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
-	"github.com/aarondl/sqlboiler/v4/types"
 	"github.com/aarondl/strmangle"
 	"github.com/friendsofgo/errors"
 )
 
-
 // Reception is an object representing the database table.
 type Reception struct {
-	ID         string     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	PVZID      int64      `boil:"pvz_id" json:"pvz_id" toml:"pvz_id" yaml:"pvz_id"`
-	Status     string     `boil:"status" json:"status" toml:"status" yaml:"status"`
-	ProductIds types.JSON `boil:"product_ids" json:"product_ids" toml:"product_ids" yaml:"product_ids"`
-	DateTime   time.Time  `boil:"date_time" json:"date_time" toml:"date_time" yaml:"date_time"`
-
-	R          *receptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
-	L          receptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+	ID         string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PVZID      int64       `boil:"pvz_id" json:"pvz_id" toml:"pvz_id" yaml:"pvz_id"`
+	Status     string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	DateTime   time.Time   `boil:"date_time" json:"date_time" toml:"date_time" yaml:"date_time"`
+	CreatedBy  null.String `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	ClosedBy   null.String `boil:"closed_by" json:"closed_by,omitempty" toml:"closed_by" yaml:"closed_by,omitempty"`
+	ClosedAt   null.Time   `boil:"closed_at" json:"closed_at,omitempty" toml:"closed_at" yaml:"closed_at,omitempty"`
+	Kind       string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	ReopenedAt null.Time   `boil:"reopened_at" json:"reopened_at,omitempty" toml:"reopened_at" yaml:"reopened_at,omitempty"`
+
+	R *receptionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L receptionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ReceptionColumns = struct {
 	ID         string
 	PVZID      string
 	Status     string
-	ProductIds string
 	DateTime   string
+	CreatedBy  string
+	ClosedBy   string
+	ClosedAt   string
+	Kind       string
+	ReopenedAt string
 }{
 	ID:         "id",
 	PVZID:      "pvz_id",
 	Status:     "status",
-	ProductIds: "product_ids",
 	DateTime:   "date_time",
+	CreatedBy:  "created_by",
+	ClosedBy:   "closed_by",
+	ClosedAt:   "closed_at",
+	Kind:       "kind",
+	ReopenedAt: "reopened_at",
 }
 
 var ReceptionTableColumns = struct {
 	ID         string
 	PVZID      string
 	Status     string
-	ProductIds string
 	DateTime   string
+	CreatedBy  string
+	ClosedBy   string
+	ClosedAt   string
+	Kind       string
+	ReopenedAt string
 }{
 	ID:         "receptions.id",
 	PVZID:      "receptions.pvz_id",
 	Status:     "receptions.status",
-	ProductIds: "receptions.product_ids",
 	DateTime:   "receptions.date_time",
+	CreatedBy:  "receptions.created_by",
+	ClosedBy:   "receptions.closed_by",
+	ClosedAt:   "receptions.closed_at",
+	Kind:       "receptions.kind",
+	ReopenedAt: "receptions.reopened_at",
 }
 
 // Generated where
 
-type whereHelpertypes_JSON struct{ field string }
-
-func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var ReceptionWhere = struct {
 	ID         whereHelperstring
 	PVZID      whereHelperint64
 	Status     whereHelperstring
-	ProductIds whereHelpertypes_JSON
 	DateTime   whereHelpertime_Time
+	CreatedBy  whereHelpernull_String
+	ClosedBy   whereHelpernull_String
+	ClosedAt   whereHelpernull_Time
+	Kind       whereHelperstring
+	ReopenedAt whereHelpernull_Time
 }{
 	ID:         whereHelperstring{field: "\"receptions\".\"id\""},
 	PVZID:      whereHelperint64{field: "\"receptions\".\"pvz_id\""},
 	Status:     whereHelperstring{field: "\"receptions\".\"status\""},
-	ProductIds: whereHelpertypes_JSON{field: "\"receptions\".\"product_ids\""},
 	DateTime:   whereHelpertime_Time{field: "\"receptions\".\"date_time\""},
+	CreatedBy:  whereHelpernull_String{field: "\"receptions\".\"created_by\""},
+	ClosedBy:   whereHelpernull_String{field: "\"receptions\".\"closed_by\""},
+	ClosedAt:   whereHelpernull_Time{field: "\"receptions\".\"closed_at\""},
+	Kind:       whereHelperstring{field: "\"receptions\".\"kind\""},
+	ReopenedAt: whereHelpernull_Time{field: "\"receptions\".\"reopened_at\""},
 }
 
 // ReceptionRels is where relationship names are stored.
 var ReceptionRels = struct {
-	PVZ      string
-	Products string
+	PVZ              string
+	CreatedByUser    string
+	ClosedByUser     string
+	ProductAuditLogs string
+	Products         string
+	StatusHistories  string
 }{
-	PVZ:      "PVZ",
-	Products: "Products",
+	PVZ:              "PVZ",
+	CreatedByUser:    "CreatedByUser",
+	ClosedByUser:     "ClosedByUser",
+	ProductAuditLogs: "ProductAuditLogs",
+	Products:         "Products",
+	StatusHistories:  "StatusHistories",
 }
 
 // receptionR is where relationships are stored.
 type receptionR struct {
-	PVZ      *PVZ         `boil:"PVZ" json:"PVZ" toml:"PVZ" yaml:"PVZ"`
-	Products ProductSlice `boil:"Products" json:"Products" toml:"Products" yaml:"Products"`
+	PVZ              *PVZ                 `boil:"PVZ" json:"PVZ" toml:"PVZ" yaml:"PVZ"`
+	CreatedByUser    *User                `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	ClosedByUser     *User                `boil:"ClosedByUser" json:"ClosedByUser" toml:"ClosedByUser" yaml:"ClosedByUser"`
+	ProductAuditLogs ProductAuditLogSlice `boil:"ProductAuditLogs" json:"ProductAuditLogs" toml:"ProductAuditLogs" yaml:"ProductAuditLogs"`
+	Products         ProductSlice         `boil:"Products" json:"Products" toml:"Products" yaml:"Products"`
+	StatusHistories  StatusHistorySlice   `boil:"StatusHistories" json:"StatusHistories" toml:"StatusHistories" yaml:"StatusHistories"`
 }
 
 // NewStruct creates a new relationship struct
@@ -136,6 +154,54 @@ func (r *receptionR) GetPVZ() *PVZ {
 	return r.PVZ
 }
 
+func (o *Reception) GetCreatedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByUser()
+}
+
+func (r *receptionR) GetCreatedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByUser
+}
+
+func (o *Reception) GetClosedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetClosedByUser()
+}
+
+func (r *receptionR) GetClosedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.ClosedByUser
+}
+
+func (o *Reception) GetProductAuditLogs() ProductAuditLogSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetProductAuditLogs()
+}
+
+func (r *receptionR) GetProductAuditLogs() ProductAuditLogSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ProductAuditLogs
+}
+
 func (o *Reception) GetProducts() ProductSlice {
 	if o == nil {
 		return nil
@@ -152,13 +218,29 @@ func (r *receptionR) GetProducts() ProductSlice {
 	return r.Products
 }
 
+func (o *Reception) GetStatusHistories() StatusHistorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetStatusHistories()
+}
+
+func (r *receptionR) GetStatusHistories() StatusHistorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.StatusHistories
+}
+
 // receptionL is where Load methods for each relationship are stored.
 type receptionL struct{}
 
 var (
-	receptionAllColumns            = []string{"id", "pvz_id", "status", "product_ids", "date_time"}
+	receptionAllColumns            = []string{"id", "pvz_id", "status", "date_time", "created_by", "closed_by", "closed_at", "kind", "reopened_at"}
 	receptionColumnsWithoutDefault = []string{"id", "pvz_id", "status"}
-	receptionColumnsWithDefault    = []string{"product_ids", "date_time"}
+	receptionColumnsWithDefault    = []string{"date_time", "created_by", "closed_by", "closed_at", "kind", "reopened_at"}
 	receptionPrimaryKeyColumns     = []string{"id"}
 	receptionGeneratedColumns      = []string{}
 )
@@ -479,6 +561,42 @@ func (o *Reception) PVZ(mods ...qm.QueryMod) pvzQuery {
 	return PVZS(queryMods...)
 }
 
+// CreatedByUser pointed to by the foreign key.
+func (o *Reception) CreatedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// ClosedByUser pointed to by the foreign key.
+func (o *Reception) ClosedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ClosedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// ProductAuditLogs retrieves all the product_audit_log's ProductAuditLogs with an executor.
+func (o *Reception) ProductAuditLogs(mods ...qm.QueryMod) productAuditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_audit_log\".\"reception_id\"=?", o.ID),
+	)
+
+	return ProductAuditLogs(queryMods...)
+}
+
 // Products retrieves all the product's Products with an executor.
 func (o *Reception) Products(mods ...qm.QueryMod) productQuery {
 	var queryMods []qm.QueryMod
@@ -493,6 +611,20 @@ func (o *Reception) Products(mods ...qm.QueryMod) productQuery {
 	return Products(queryMods...)
 }
 
+// StatusHistories retrieves all the status_history's StatusHistories with an executor.
+func (o *Reception) StatusHistories(mods ...qm.QueryMod) statusHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"status_history\".\"reception_id\"=?", o.ID),
+	)
+
+	return StatusHistories(queryMods...)
+}
+
 // LoadPVZ allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (receptionL) LoadPVZ(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReception interface{}, mods queries.Applicator) error {
@@ -613,9 +745,9 @@ func (receptionL) LoadPVZ(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadProducts allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (receptionL) LoadProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReception interface{}, mods queries.Applicator) error {
+// LoadCreatedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (receptionL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReception interface{}, mods queries.Applicator) error {
 	var slice []*Reception
 	var object *Reception
 
@@ -646,13 +778,20 @@ func (receptionL) LoadProducts(ctx context.Context, e boil.ContextExecutor, sing
 		if object.R == nil {
 			object.R = &receptionR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.CreatedBy) {
+			args[object.CreatedBy] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &receptionR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.CreatedBy) {
+				args[obj.CreatedBy] = struct{}{}
+			}
+
 		}
 	}
 
@@ -668,8 +807,8 @@ func (receptionL) LoadProducts(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`products`),
-		qm.WhereIn(`products.reception_id in ?`, argsSlice...),
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -677,47 +816,51 @@ func (receptionL) LoadProducts(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load products")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	var resultSlice []*Product
+	var resultSlice []*User
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice products")
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on products")
+		return errors.Wrap(err, "failed to close results of eager load for users")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
 	}
 
-	if len(productAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Products = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &productR{}
-			}
-			foreign.R.Reception = object
+		foreign := resultSlice[0]
+		object.R.CreatedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
 		}
+		foreign.R.CreatedByReceptions = append(foreign.R.CreatedByReceptions, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.ReceptionID {
-				local.R.Products = append(local.R.Products, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CreatedBy, foreign.ID) {
+				local.R.CreatedByUser = foreign
 				if foreign.R == nil {
-					foreign.R = &productR{}
+					foreign.R = &userR{}
 				}
-				foreign.R.Reception = local
+				foreign.R.CreatedByReceptions = append(foreign.R.CreatedByReceptions, local)
 				break
 			}
 		}
@@ -726,61 +869,737 @@ func (receptionL) LoadProducts(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// SetPVZ of the reception to the related item.
-// Sets o.R.PVZ to related.
-// Adds o to related.R.Receptions.
-func (o *Reception) SetPVZ(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PVZ) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadClosedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (receptionL) LoadClosedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReception interface{}, mods queries.Applicator) error {
+	var slice []*Reception
+	var object *Reception
+
+	if singular {
+		var ok bool
+		object, ok = maybeReception.(*Reception)
+		if !ok {
+			object = new(Reception)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReception)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReception))
+			}
+		}
+	} else {
+		s, ok := maybeReception.(*[]*Reception)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReception)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReception))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"receptions\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"pvz_id"}),
-		strmangle.WhereClause("\"", "\"", 2, receptionPrimaryKeyColumns),
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &receptionR{}
+		}
+		if !queries.IsNil(object.ClosedBy) {
+			args[object.ClosedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &receptionR{}
+			}
+
+			if !queries.IsNil(obj.ClosedBy) {
+				args[obj.ClosedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
-	o.PVZID = related.ID
-	if o.R == nil {
-		o.R = &receptionR{
-			PVZ: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.PVZ = related
 	}
 
-	if related.R == nil {
-		related.R = &pvzR{
-			Receptions: ReceptionSlice{o},
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ClosedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ClosedByReceptions = append(foreign.R.ClosedByReceptions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ClosedBy, foreign.ID) {
+				local.R.ClosedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ClosedByReceptions = append(foreign.R.ClosedByReceptions, local)
+				break
+			}
 		}
-	} else {
-		related.R.Receptions = append(related.R.Receptions, o)
 	}
 
 	return nil
 }
 
-// AddProducts adds the given related objects to the existing relationships
-// of the reception, optionally inserting them as new records.
-// Appends related to o.R.Products.
-// Sets related.R.Reception appropriately.
-func (o *Reception) AddProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
-	var err error
-	for _, rel := range related {
-		if insert {
+// LoadProductAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (receptionL) LoadProductAuditLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReception interface{}, mods queries.Applicator) error {
+	var slice []*Reception
+	var object *Reception
+
+	if singular {
+		var ok bool
+		object, ok = maybeReception.(*Reception)
+		if !ok {
+			object = new(Reception)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReception)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReception))
+			}
+		}
+	} else {
+		s, ok := maybeReception.(*[]*Reception)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReception)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReception))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &receptionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &receptionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`product_audit_log`),
+		qm.WhereIn(`product_audit_log.reception_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_audit_log")
+	}
+
+	var resultSlice []*ProductAuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_audit_log")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_audit_log")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_audit_log")
+	}
+
+	if len(productAuditLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ProductAuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productAuditLogR{}
+			}
+			foreign.R.Reception = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ReceptionID {
+				local.R.ProductAuditLogs = append(local.R.ProductAuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &productAuditLogR{}
+				}
+				foreign.R.Reception = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (receptionL) LoadProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReception interface{}, mods queries.Applicator) error {
+	var slice []*Reception
+	var object *Reception
+
+	if singular {
+		var ok bool
+		object, ok = maybeReception.(*Reception)
+		if !ok {
+			object = new(Reception)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReception)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReception))
+			}
+		}
+	} else {
+		s, ok := maybeReception.(*[]*Reception)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReception)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReception))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &receptionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &receptionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.reception_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load products")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice products")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Products = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.Reception = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ReceptionID {
+				local.R.Products = append(local.R.Products, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.Reception = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadStatusHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (receptionL) LoadStatusHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReception interface{}, mods queries.Applicator) error {
+	var slice []*Reception
+	var object *Reception
+
+	if singular {
+		var ok bool
+		object, ok = maybeReception.(*Reception)
+		if !ok {
+			object = new(Reception)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReception)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReception))
+			}
+		}
+	} else {
+		s, ok := maybeReception.(*[]*Reception)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReception)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReception))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &receptionR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &receptionR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`status_history`),
+		qm.WhereIn(`status_history.reception_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load status_history")
+	}
+
+	var resultSlice []*StatusHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice status_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on status_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for status_history")
+	}
+
+	if len(statusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.StatusHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &statusHistoryR{}
+			}
+			foreign.R.Reception = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ReceptionID {
+				local.R.StatusHistories = append(local.R.StatusHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &statusHistoryR{}
+				}
+				foreign.R.Reception = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPVZ of the reception to the related item.
+// Sets o.R.PVZ to related.
+// Adds o to related.R.Receptions.
+func (o *Reception) SetPVZ(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PVZ) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"receptions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"pvz_id"}),
+		strmangle.WhereClause("\"", "\"", 2, receptionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PVZID = related.ID
+	if o.R == nil {
+		o.R = &receptionR{
+			PVZ: related,
+		}
+	} else {
+		o.R.PVZ = related
+	}
+
+	if related.R == nil {
+		related.R = &pvzR{
+			Receptions: ReceptionSlice{o},
+		}
+	} else {
+		related.R.Receptions = append(related.R.Receptions, o)
+	}
+
+	return nil
+}
+
+// SetCreatedByUser of the reception to the related item.
+// Sets o.R.CreatedByUser to related.
+// Adds o to related.R.CreatedByReceptions.
+func (o *Reception) SetCreatedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"receptions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+		strmangle.WhereClause("\"", "\"", 2, receptionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreatedBy, related.ID)
+	if o.R == nil {
+		o.R = &receptionR{
+			CreatedByUser: related,
+		}
+	} else {
+		o.R.CreatedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByReceptions: ReceptionSlice{o},
+		}
+	} else {
+		related.R.CreatedByReceptions = append(related.R.CreatedByReceptions, o)
+	}
+
+	return nil
+}
+
+// RemoveCreatedByUser relationship.
+// Sets o.R.CreatedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Reception) RemoveCreatedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CreatedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CreatedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreatedByReceptions {
+		if queries.Equal(o.CreatedBy, ri.CreatedBy) {
+			continue
+		}
+
+		ln := len(related.R.CreatedByReceptions)
+		if ln > 1 && i < ln-1 {
+			related.R.CreatedByReceptions[i] = related.R.CreatedByReceptions[ln-1]
+		}
+		related.R.CreatedByReceptions = related.R.CreatedByReceptions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetClosedByUser of the reception to the related item.
+// Sets o.R.ClosedByUser to related.
+// Adds o to related.R.ClosedByReceptions.
+func (o *Reception) SetClosedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"receptions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"closed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, receptionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ClosedBy, related.ID)
+	if o.R == nil {
+		o.R = &receptionR{
+			ClosedByUser: related,
+		}
+	} else {
+		o.R.ClosedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ClosedByReceptions: ReceptionSlice{o},
+		}
+	} else {
+		related.R.ClosedByReceptions = append(related.R.ClosedByReceptions, o)
+	}
+
+	return nil
+}
+
+// RemoveClosedByUser relationship.
+// Sets o.R.ClosedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Reception) RemoveClosedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ClosedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("closed_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ClosedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ClosedByReceptions {
+		if queries.Equal(o.ClosedBy, ri.ClosedBy) {
+			continue
+		}
+
+		ln := len(related.R.ClosedByReceptions)
+		if ln > 1 && i < ln-1 {
+			related.R.ClosedByReceptions[i] = related.R.ClosedByReceptions[ln-1]
+		}
+		related.R.ClosedByReceptions = related.R.ClosedByReceptions[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddProductAuditLogs adds the given related objects to the existing relationships
+// of the reception, optionally inserting them as new records.
+// Appends related to o.R.ProductAuditLogs.
+// Sets related.R.Reception appropriately.
+func (o *Reception) AddProductAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductAuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ReceptionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_audit_log\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"reception_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productAuditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ReceptionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &receptionR{
+			ProductAuditLogs: related,
+		}
+	} else {
+		o.R.ProductAuditLogs = append(o.R.ProductAuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productAuditLogR{
+				Reception: o,
+			}
+		} else {
+			rel.R.Reception = o
+		}
+	}
+	return nil
+}
+
+// AddProducts adds the given related objects to the existing relationships
+// of the reception, optionally inserting them as new records.
+// Appends related to o.R.Products.
+// Sets related.R.Reception appropriately.
+func (o *Reception) AddProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	var err error
+	for _, rel := range related {
+		if insert {
 			rel.ReceptionID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
@@ -826,6 +1645,59 @@ func (o *Reception) AddProducts(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddStatusHistories adds the given related objects to the existing relationships
+// of the reception, optionally inserting them as new records.
+// Appends related to o.R.StatusHistories.
+// Sets related.R.Reception appropriately.
+func (o *Reception) AddStatusHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StatusHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ReceptionID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"status_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"reception_id"}),
+				strmangle.WhereClause("\"", "\"", 2, statusHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ReceptionID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &receptionR{
+			StatusHistories: related,
+		}
+	} else {
+		o.R.StatusHistories = append(o.R.StatusHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &statusHistoryR{
+				Reception: o,
+			}
+		} else {
+			rel.R.Reception = o
+		}
+	}
+	return nil
+}
+
 // Receptions retrieves all the records using an executor.
 func Receptions(mods ...qm.QueryMod) receptionQuery {
 	mods = append(mods, qm.From("\"receptions\""))
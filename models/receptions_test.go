@@ -494,6 +494,84 @@ func testReceptionsInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testReceptionToManyProductAuditLogs(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Reception
+	var b, c ProductAuditLog
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receptionDBTypes, true, receptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Reception struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, productAuditLogDBTypes, false, productAuditLogColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, productAuditLogDBTypes, false, productAuditLogColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ReceptionID = a.ID
+	c.ReceptionID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ProductAuditLogs().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ReceptionID == b.ReceptionID {
+			bFound = true
+		}
+		if v.ReceptionID == c.ReceptionID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ReceptionSlice{&a}
+	if err = a.L.LoadProductAuditLogs(ctx, tx, false, (*[]*Reception)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ProductAuditLogs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ProductAuditLogs = nil
+	if err = a.L.LoadProductAuditLogs(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ProductAuditLogs); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testReceptionToManyProducts(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -572,7 +650,85 @@ func testReceptionToManyProducts(t *testing.T) {
 	}
 }
 
-func testReceptionToManyAddOpProducts(t *testing.T) {
+func testReceptionToManyStatusHistories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Reception
+	var b, c StatusHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receptionDBTypes, true, receptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Reception struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, statusHistoryDBTypes, false, statusHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, statusHistoryDBTypes, false, statusHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ReceptionID = a.ID
+	c.ReceptionID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.StatusHistories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ReceptionID == b.ReceptionID {
+			bFound = true
+		}
+		if v.ReceptionID == c.ReceptionID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ReceptionSlice{&a}
+	if err = a.L.LoadStatusHistories(ctx, tx, false, (*[]*Reception)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.StatusHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.StatusHistories = nil
+	if err = a.L.LoadStatusHistories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.StatusHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testReceptionToManyAddOpProductAuditLogs(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -580,15 +736,15 @@ func testReceptionToManyAddOpProducts(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Reception
-	var b, c, d, e Product
+	var b, c, d, e ProductAuditLog
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Product{&b, &c, &d, &e}
+	foreigners := []*ProductAuditLog{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, productAuditLogDBTypes, false, strmangle.SetComplement(productAuditLogPrimaryKeyColumns, productAuditLogColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -603,13 +759,13 @@ func testReceptionToManyAddOpProducts(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Product{
+	foreignersSplitByInsertion := [][]*ProductAuditLog{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddProducts(ctx, tx, i != 0, x...)
+		err = a.AddProductAuditLogs(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -631,14 +787,14 @@ func testReceptionToManyAddOpProducts(t *testing.T) {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.Products[i*2] != first {
+		if a.R.ProductAuditLogs[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.Products[i*2+1] != second {
+		if a.R.ProductAuditLogs[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.Products().Count(ctx, tx)
+		count, err := a.ProductAuditLogs().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -647,68 +803,82 @@ func testReceptionToManyAddOpProducts(t *testing.T) {
 		}
 	}
 }
-func testReceptionToOnePVZUsingPVZ(t *testing.T) {
+func testReceptionToManyAddOpProducts(t *testing.T) {
+	var err error
+
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
-	var local Reception
-	var foreign PVZ
+	var a Reception
+	var b, c, d, e Product
 
 	seed := randomize.NewSeed()
-	if err := randomize.Struct(seed, &local, receptionDBTypes, false, receptionColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize Reception struct: %s", err)
+	if err = randomize.Struct(seed, &a, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
 	}
-	if err := randomize.Struct(seed, &foreign, pvzDBTypes, false, pvzColumnsWithDefault...); err != nil {
-		t.Errorf("Unable to randomize PVZ struct: %s", err)
+	foreigners := []*Product{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, productDBTypes, false, strmangle.SetComplement(productPrimaryKeyColumns, productColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
 
-	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	local.PVZID = foreign.ID
-	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	check, err := local.PVZ().One(ctx, tx)
-	if err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	if check.ID != foreign.ID {
-		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	foreignersSplitByInsertion := [][]*Product{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	ranAfterSelectHook := false
-	AddPVZHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *PVZ) error {
-		ranAfterSelectHook = true
-		return nil
-	})
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddProducts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	slice := ReceptionSlice{&local}
-	if err = local.L.LoadPVZ(ctx, tx, false, (*[]*Reception)(&slice), nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.PVZ == nil {
-		t.Error("struct should have been eager loaded")
-	}
+		first := x[0]
+		second := x[1]
 
-	local.R.PVZ = nil
-	if err = local.L.LoadPVZ(ctx, tx, true, &local, nil); err != nil {
-		t.Fatal(err)
-	}
-	if local.R.PVZ == nil {
-		t.Error("struct should have been eager loaded")
-	}
+		if a.ID != first.ReceptionID {
+			t.Error("foreign key was wrong value", a.ID, first.ReceptionID)
+		}
+		if a.ID != second.ReceptionID {
+			t.Error("foreign key was wrong value", a.ID, second.ReceptionID)
+		}
 
-	if !ranAfterSelectHook {
-		t.Error("failed to run AfterSelect hook for relationship")
+		if first.R.Reception != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Reception != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Products[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Products[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Products().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testReceptionToOneSetOpPVZUsingPVZ(t *testing.T) {
+func testReceptionToManyAddOpStatusHistories(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -716,17 +886,17 @@ func testReceptionToOneSetOpPVZUsingPVZ(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Reception
-	var b, c PVZ
+	var b, c, d, e StatusHistory
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &b, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	if err = randomize.Struct(seed, &c, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
+	foreigners := []*StatusHistory{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, statusHistoryDBTypes, false, strmangle.SetComplement(statusHistoryPrimaryKeyColumns, statusHistoryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
@@ -735,14 +905,272 @@ func testReceptionToOneSetOpPVZUsingPVZ(t *testing.T) {
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
 
-	for i, x := range []*PVZ{&b, &c} {
-		err = a.SetPVZ(ctx, tx, i != 0, x)
+	foreignersSplitByInsertion := [][]*StatusHistory{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddStatusHistories(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		if a.R.PVZ != x {
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ReceptionID {
+			t.Error("foreign key was wrong value", a.ID, first.ReceptionID)
+		}
+		if a.ID != second.ReceptionID {
+			t.Error("foreign key was wrong value", a.ID, second.ReceptionID)
+		}
+
+		if first.R.Reception != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Reception != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.StatusHistories[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.StatusHistories[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.StatusHistories().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testReceptionToOnePVZUsingPVZ(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Reception
+	var foreign PVZ
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, receptionDBTypes, false, receptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Reception struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, pvzDBTypes, false, pvzColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZ struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.PVZID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.PVZ().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddPVZHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *PVZ) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ReceptionSlice{&local}
+	if err = local.L.LoadPVZ(ctx, tx, false, (*[]*Reception)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZ == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.PVZ = nil
+	if err = local.L.LoadPVZ(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZ == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testReceptionToOneUserUsingCreatedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Reception
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, receptionDBTypes, true, receptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Reception struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CreatedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.CreatedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ReceptionSlice{&local}
+	if err = local.L.LoadCreatedByUser(ctx, tx, false, (*[]*Reception)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreatedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.CreatedByUser = nil
+	if err = local.L.LoadCreatedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreatedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testReceptionToOneUserUsingClosedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Reception
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, receptionDBTypes, true, receptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Reception struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ClosedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ClosedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := ReceptionSlice{&local}
+	if err = local.L.LoadClosedByUser(ctx, tx, false, (*[]*Reception)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ClosedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ClosedByUser = nil
+	if err = local.L.LoadClosedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ClosedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testReceptionToOneSetOpPVZUsingPVZ(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Reception
+	var b, c PVZ
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*PVZ{&b, &c} {
+		err = a.SetPVZ(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.PVZ != x {
 			t.Error("relationship struct not set to correct value")
 		}
 
@@ -765,6 +1193,223 @@ func testReceptionToOneSetOpPVZUsingPVZ(t *testing.T) {
 		}
 	}
 }
+func testReceptionToOneSetOpUserUsingCreatedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Reception
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetCreatedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.CreatedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CreatedByReceptions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CreatedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.CreatedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CreatedBy))
+		reflect.Indirect(reflect.ValueOf(&a.CreatedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CreatedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.CreatedBy, x.ID)
+		}
+	}
+}
+
+func testReceptionToOneRemoveOpUserUsingCreatedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Reception
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCreatedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCreatedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.CreatedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.CreatedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CreatedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CreatedByReceptions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testReceptionToOneSetOpUserUsingClosedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Reception
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetClosedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ClosedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ClosedByReceptions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ClosedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.ClosedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ClosedBy))
+		reflect.Indirect(reflect.ValueOf(&a.ClosedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ClosedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.ClosedBy, x.ID)
+		}
+	}
+}
+
+func testReceptionToOneRemoveOpUserUsingClosedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Reception
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetClosedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveClosedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.ClosedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.ClosedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ClosedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ClosedByReceptions) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testReceptionsReload(t *testing.T) {
 	t.Parallel()
@@ -840,7 +1485,7 @@ func testReceptionsSelect(t *testing.T) {
 }
 
 var (
-	receptionDBTypes = map[string]string{`ID`: `uuid`, `PVZID`: `bigint`, `Status`: `character varying`, `ProductIds`: `jsonb`, `DateTime`: `timestamp without time zone`}
+	receptionDBTypes = map[string]string{`ID`: `uuid`, `PVZID`: `bigint`, `Status`: `character varying`, `DateTime`: `timestamp without time zone`, `CreatedBy`: `uuid`, `ClosedBy`: `uuid`, `ClosedAt`: `timestamp without time zone`, `Kind`: `character varying`, `ReopenedAt`: `timestamp without time zone`}
 	_                = bytes.MinRead
 )
 
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRevokedTokens(t *testing.T) {
+	t.Parallel()
+
+	query := RevokedTokens()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRevokedTokensDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRevokedTokensQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RevokedTokens().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRevokedTokensSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RevokedTokenSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRevokedTokensExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RevokedTokenExists(ctx, tx, o.Jti)
+	if err != nil {
+		t.Errorf("Unable to check if RevokedToken exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RevokedTokenExists to return true, but got false.")
+	}
+}
+
+func testRevokedTokensFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	revokedTokenFound, err := FindRevokedToken(ctx, tx, o.Jti)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if revokedTokenFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRevokedTokensBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RevokedTokens().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRevokedTokensOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RevokedTokens().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRevokedTokensAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	revokedTokenOne := &RevokedToken{}
+	revokedTokenTwo := &RevokedToken{}
+	if err = randomize.Struct(seed, revokedTokenOne, revokedTokenDBTypes, false, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, revokedTokenTwo, revokedTokenDBTypes, false, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = revokedTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = revokedTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RevokedTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRevokedTokensCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	revokedTokenOne := &RevokedToken{}
+	revokedTokenTwo := &RevokedToken{}
+	if err = randomize.Struct(seed, revokedTokenOne, revokedTokenDBTypes, false, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, revokedTokenTwo, revokedTokenDBTypes, false, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = revokedTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = revokedTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func revokedTokenBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *RevokedToken) error {
+	*o = RevokedToken{}
+	return nil
+}
+
+func revokedTokenAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *RevokedToken) error {
+	*o = RevokedToken{}
+	return nil
+}
+
+func revokedTokenAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *RevokedToken) error {
+	*o = RevokedToken{}
+	return nil
+}
+
+func revokedTokenBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RevokedToken) error {
+	*o = RevokedToken{}
+	return nil
+}
+
+func revokedTokenAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *RevokedToken) error {
+	*o = RevokedToken{}
+	return nil
+}
+
+func revokedTokenBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RevokedToken) error {
+	*o = RevokedToken{}
+	return nil
+}
+
+func revokedTokenAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *RevokedToken) error {
+	*o = RevokedToken{}
+	return nil
+}
+
+func revokedTokenBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RevokedToken) error {
+	*o = RevokedToken{}
+	return nil
+}
+
+func revokedTokenAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *RevokedToken) error {
+	*o = RevokedToken{}
+	return nil
+}
+
+func testRevokedTokensHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &RevokedToken{}
+	o := &RevokedToken{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize RevokedToken object: %s", err)
+	}
+
+	AddRevokedTokenHook(boil.BeforeInsertHook, revokedTokenBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	revokedTokenBeforeInsertHooks = []RevokedTokenHook{}
+
+	AddRevokedTokenHook(boil.AfterInsertHook, revokedTokenAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	revokedTokenAfterInsertHooks = []RevokedTokenHook{}
+
+	AddRevokedTokenHook(boil.AfterSelectHook, revokedTokenAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	revokedTokenAfterSelectHooks = []RevokedTokenHook{}
+
+	AddRevokedTokenHook(boil.BeforeUpdateHook, revokedTokenBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	revokedTokenBeforeUpdateHooks = []RevokedTokenHook{}
+
+	AddRevokedTokenHook(boil.AfterUpdateHook, revokedTokenAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	revokedTokenAfterUpdateHooks = []RevokedTokenHook{}
+
+	AddRevokedTokenHook(boil.BeforeDeleteHook, revokedTokenBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	revokedTokenBeforeDeleteHooks = []RevokedTokenHook{}
+
+	AddRevokedTokenHook(boil.AfterDeleteHook, revokedTokenAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	revokedTokenAfterDeleteHooks = []RevokedTokenHook{}
+
+	AddRevokedTokenHook(boil.BeforeUpsertHook, revokedTokenBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	revokedTokenBeforeUpsertHooks = []RevokedTokenHook{}
+
+	AddRevokedTokenHook(boil.AfterUpsertHook, revokedTokenAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	revokedTokenAfterUpsertHooks = []RevokedTokenHook{}
+}
+
+func testRevokedTokensInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRevokedTokensInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(revokedTokenPrimaryKeyColumns, revokedTokenColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRevokedTokensReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRevokedTokensReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RevokedTokenSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRevokedTokensSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RevokedTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	revokedTokenDBTypes = map[string]string{`Jti`: `uuid`, `ExpiresAt`: `timestamp without time zone`, `RevokedAt`: `timestamp without time zone`}
+	_                   = bytes.MinRead
+)
+
+func testRevokedTokensUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(revokedTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(revokedTokenAllColumns) == len(revokedTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRevokedTokensSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(revokedTokenAllColumns) == len(revokedTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RevokedToken{}
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, revokedTokenDBTypes, true, revokedTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(revokedTokenAllColumns, revokedTokenPrimaryKeyColumns) {
+		fields = revokedTokenAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			revokedTokenAllColumns,
+			revokedTokenPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RevokedTokenSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRevokedTokensUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(revokedTokenAllColumns) == len(revokedTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RevokedToken{}
+	if err = randomize.Struct(seed, &o, revokedTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RevokedToken: %s", err)
+	}
+
+	count, err := RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, revokedTokenDBTypes, false, revokedTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RevokedToken struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RevokedToken: %s", err)
+	}
+
+	count, err = RevokedTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1389 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// StatusHistory is an object representing the database table.
+type StatusHistory struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ReceptionID string      `boil:"reception_id" json:"reception_id" toml:"reception_id" yaml:"reception_id"`
+	FromStatus  null.String `boil:"from_status" json:"from_status,omitempty" toml:"from_status" yaml:"from_status,omitempty"`
+	ToStatus    string      `boil:"to_status" json:"to_status" toml:"to_status" yaml:"to_status"`
+	ChangedBy   null.String `boil:"changed_by" json:"changed_by,omitempty" toml:"changed_by" yaml:"changed_by,omitempty"`
+	ChangedAt   time.Time   `boil:"changed_at" json:"changed_at" toml:"changed_at" yaml:"changed_at"`
+
+	R *statusHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L statusHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var StatusHistoryColumns = struct {
+	ID          string
+	ReceptionID string
+	FromStatus  string
+	ToStatus    string
+	ChangedBy   string
+	ChangedAt   string
+}{
+	ID:          "id",
+	ReceptionID: "reception_id",
+	FromStatus:  "from_status",
+	ToStatus:    "to_status",
+	ChangedBy:   "changed_by",
+	ChangedAt:   "changed_at",
+}
+
+var StatusHistoryTableColumns = struct {
+	ID          string
+	ReceptionID string
+	FromStatus  string
+	ToStatus    string
+	ChangedBy   string
+	ChangedAt   string
+}{
+	ID:          "status_history.id",
+	ReceptionID: "status_history.reception_id",
+	FromStatus:  "status_history.from_status",
+	ToStatus:    "status_history.to_status",
+	ChangedBy:   "status_history.changed_by",
+	ChangedAt:   "status_history.changed_at",
+}
+
+// Generated where
+
+var StatusHistoryWhere = struct {
+	ID          whereHelperstring
+	ReceptionID whereHelperstring
+	FromStatus  whereHelpernull_String
+	ToStatus    whereHelperstring
+	ChangedBy   whereHelpernull_String
+	ChangedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"status_history\".\"id\""},
+	ReceptionID: whereHelperstring{field: "\"status_history\".\"reception_id\""},
+	FromStatus:  whereHelpernull_String{field: "\"status_history\".\"from_status\""},
+	ToStatus:    whereHelperstring{field: "\"status_history\".\"to_status\""},
+	ChangedBy:   whereHelpernull_String{field: "\"status_history\".\"changed_by\""},
+	ChangedAt:   whereHelpertime_Time{field: "\"status_history\".\"changed_at\""},
+}
+
+// StatusHistoryRels is where relationship names are stored.
+var StatusHistoryRels = struct {
+	Reception     string
+	ChangedByUser string
+}{
+	Reception:     "Reception",
+	ChangedByUser: "ChangedByUser",
+}
+
+// statusHistoryR is where relationships are stored.
+type statusHistoryR struct {
+	Reception     *Reception `boil:"Reception" json:"Reception" toml:"Reception" yaml:"Reception"`
+	ChangedByUser *User      `boil:"ChangedByUser" json:"ChangedByUser" toml:"ChangedByUser" yaml:"ChangedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*statusHistoryR) NewStruct() *statusHistoryR {
+	return &statusHistoryR{}
+}
+
+func (o *StatusHistory) GetReception() *Reception {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetReception()
+}
+
+func (r *statusHistoryR) GetReception() *Reception {
+	if r == nil {
+		return nil
+	}
+
+	return r.Reception
+}
+
+func (o *StatusHistory) GetChangedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetChangedByUser()
+}
+
+func (r *statusHistoryR) GetChangedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.ChangedByUser
+}
+
+// statusHistoryL is where Load methods for each relationship are stored.
+type statusHistoryL struct{}
+
+var (
+	statusHistoryAllColumns            = []string{"id", "reception_id", "from_status", "to_status", "changed_by", "changed_at"}
+	statusHistoryColumnsWithoutDefault = []string{"id", "reception_id", "to_status"}
+	statusHistoryColumnsWithDefault    = []string{"from_status", "changed_by", "changed_at"}
+	statusHistoryPrimaryKeyColumns     = []string{"id"}
+	statusHistoryGeneratedColumns      = []string{}
+)
+
+type (
+	// StatusHistorySlice is an alias for a slice of pointers to StatusHistory.
+	// This should almost always be used instead of []StatusHistory.
+	StatusHistorySlice []*StatusHistory
+	// StatusHistoryHook is the signature for custom StatusHistory hook methods
+	StatusHistoryHook func(context.Context, boil.ContextExecutor, *StatusHistory) error
+
+	statusHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	statusHistoryType                 = reflect.TypeOf(&StatusHistory{})
+	statusHistoryMapping              = queries.MakeStructMapping(statusHistoryType)
+	statusHistoryPrimaryKeyMapping, _ = queries.BindMapping(statusHistoryType, statusHistoryMapping, statusHistoryPrimaryKeyColumns)
+	statusHistoryInsertCacheMut       sync.RWMutex
+	statusHistoryInsertCache          = make(map[string]insertCache)
+	statusHistoryUpdateCacheMut       sync.RWMutex
+	statusHistoryUpdateCache          = make(map[string]updateCache)
+	statusHistoryUpsertCacheMut       sync.RWMutex
+	statusHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var statusHistoryAfterSelectMu sync.Mutex
+var statusHistoryAfterSelectHooks []StatusHistoryHook
+
+var statusHistoryBeforeInsertMu sync.Mutex
+var statusHistoryBeforeInsertHooks []StatusHistoryHook
+var statusHistoryAfterInsertMu sync.Mutex
+var statusHistoryAfterInsertHooks []StatusHistoryHook
+
+var statusHistoryBeforeUpdateMu sync.Mutex
+var statusHistoryBeforeUpdateHooks []StatusHistoryHook
+var statusHistoryAfterUpdateMu sync.Mutex
+var statusHistoryAfterUpdateHooks []StatusHistoryHook
+
+var statusHistoryBeforeDeleteMu sync.Mutex
+var statusHistoryBeforeDeleteHooks []StatusHistoryHook
+var statusHistoryAfterDeleteMu sync.Mutex
+var statusHistoryAfterDeleteHooks []StatusHistoryHook
+
+var statusHistoryBeforeUpsertMu sync.Mutex
+var statusHistoryBeforeUpsertHooks []StatusHistoryHook
+var statusHistoryAfterUpsertMu sync.Mutex
+var statusHistoryAfterUpsertHooks []StatusHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *StatusHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range statusHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *StatusHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range statusHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *StatusHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range statusHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *StatusHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range statusHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *StatusHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range statusHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *StatusHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range statusHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *StatusHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range statusHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *StatusHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range statusHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *StatusHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range statusHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddStatusHistoryHook registers your hook function for all future operations.
+func AddStatusHistoryHook(hookPoint boil.HookPoint, statusHistoryHook StatusHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		statusHistoryAfterSelectMu.Lock()
+		statusHistoryAfterSelectHooks = append(statusHistoryAfterSelectHooks, statusHistoryHook)
+		statusHistoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		statusHistoryBeforeInsertMu.Lock()
+		statusHistoryBeforeInsertHooks = append(statusHistoryBeforeInsertHooks, statusHistoryHook)
+		statusHistoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		statusHistoryAfterInsertMu.Lock()
+		statusHistoryAfterInsertHooks = append(statusHistoryAfterInsertHooks, statusHistoryHook)
+		statusHistoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		statusHistoryBeforeUpdateMu.Lock()
+		statusHistoryBeforeUpdateHooks = append(statusHistoryBeforeUpdateHooks, statusHistoryHook)
+		statusHistoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		statusHistoryAfterUpdateMu.Lock()
+		statusHistoryAfterUpdateHooks = append(statusHistoryAfterUpdateHooks, statusHistoryHook)
+		statusHistoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		statusHistoryBeforeDeleteMu.Lock()
+		statusHistoryBeforeDeleteHooks = append(statusHistoryBeforeDeleteHooks, statusHistoryHook)
+		statusHistoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		statusHistoryAfterDeleteMu.Lock()
+		statusHistoryAfterDeleteHooks = append(statusHistoryAfterDeleteHooks, statusHistoryHook)
+		statusHistoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		statusHistoryBeforeUpsertMu.Lock()
+		statusHistoryBeforeUpsertHooks = append(statusHistoryBeforeUpsertHooks, statusHistoryHook)
+		statusHistoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		statusHistoryAfterUpsertMu.Lock()
+		statusHistoryAfterUpsertHooks = append(statusHistoryAfterUpsertHooks, statusHistoryHook)
+		statusHistoryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single statusHistory record from the query.
+func (q statusHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*StatusHistory, error) {
+	o := &StatusHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for status_history")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all StatusHistory records from the query.
+func (q statusHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (StatusHistorySlice, error) {
+	var o []*StatusHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to StatusHistory slice")
+	}
+
+	if len(statusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all StatusHistory records in the query.
+func (q statusHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count status_history rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q statusHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if status_history exists")
+	}
+
+	return count > 0, nil
+}
+
+// Reception pointed to by the foreign key.
+func (o *StatusHistory) Reception(mods ...qm.QueryMod) receptionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReceptionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Receptions(queryMods...)
+}
+
+// ChangedByUser pointed to by the foreign key.
+func (o *StatusHistory) ChangedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChangedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadReception allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (statusHistoryL) LoadReception(ctx context.Context, e boil.ContextExecutor, singular bool, maybeStatusHistory interface{}, mods queries.Applicator) error {
+	var slice []*StatusHistory
+	var object *StatusHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybeStatusHistory.(*StatusHistory)
+		if !ok {
+			object = new(StatusHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeStatusHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeStatusHistory))
+			}
+		}
+	} else {
+		s, ok := maybeStatusHistory.(*[]*StatusHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeStatusHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeStatusHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &statusHistoryR{}
+		}
+		args[object.ReceptionID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &statusHistoryR{}
+			}
+
+			args[obj.ReceptionID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`receptions`),
+		qm.WhereIn(`receptions.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Reception")
+	}
+
+	var resultSlice []*Reception
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Reception")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for receptions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for receptions")
+	}
+
+	if len(receptionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Reception = foreign
+		if foreign.R == nil {
+			foreign.R = &receptionR{}
+		}
+		foreign.R.StatusHistories = append(foreign.R.StatusHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ReceptionID == foreign.ID {
+				local.R.Reception = foreign
+				if foreign.R == nil {
+					foreign.R = &receptionR{}
+				}
+				foreign.R.StatusHistories = append(foreign.R.StatusHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (statusHistoryL) LoadChangedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeStatusHistory interface{}, mods queries.Applicator) error {
+	var slice []*StatusHistory
+	var object *StatusHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybeStatusHistory.(*StatusHistory)
+		if !ok {
+			object = new(StatusHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeStatusHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeStatusHistory))
+			}
+		}
+	} else {
+		s, ok := maybeStatusHistory.(*[]*StatusHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeStatusHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeStatusHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &statusHistoryR{}
+		}
+		if !queries.IsNil(object.ChangedBy) {
+			args[object.ChangedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &statusHistoryR{}
+			}
+
+			if !queries.IsNil(obj.ChangedBy) {
+				args[obj.ChangedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ChangedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ChangedByStatusHistories = append(foreign.R.ChangedByStatusHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ChangedBy, foreign.ID) {
+				local.R.ChangedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ChangedByStatusHistories = append(foreign.R.ChangedByStatusHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetReception of the statusHistory to the related item.
+// Sets o.R.Reception to related.
+// Adds o to related.R.StatusHistories.
+func (o *StatusHistory) SetReception(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Reception) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"status_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"reception_id"}),
+		strmangle.WhereClause("\"", "\"", 2, statusHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ReceptionID = related.ID
+	if o.R == nil {
+		o.R = &statusHistoryR{
+			Reception: related,
+		}
+	} else {
+		o.R.Reception = related
+	}
+
+	if related.R == nil {
+		related.R = &receptionR{
+			StatusHistories: StatusHistorySlice{o},
+		}
+	} else {
+		related.R.StatusHistories = append(related.R.StatusHistories, o)
+	}
+
+	return nil
+}
+
+// SetChangedByUser of the statusHistory to the related item.
+// Sets o.R.ChangedByUser to related.
+// Adds o to related.R.ChangedByStatusHistories.
+func (o *StatusHistory) SetChangedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"status_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"changed_by"}),
+		strmangle.WhereClause("\"", "\"", 2, statusHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ChangedBy, related.ID)
+	if o.R == nil {
+		o.R = &statusHistoryR{
+			ChangedByUser: related,
+		}
+	} else {
+		o.R.ChangedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ChangedByStatusHistories: StatusHistorySlice{o},
+		}
+	} else {
+		related.R.ChangedByStatusHistories = append(related.R.ChangedByStatusHistories, o)
+	}
+
+	return nil
+}
+
+// RemoveChangedByUser relationship.
+// Sets o.R.ChangedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *StatusHistory) RemoveChangedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ChangedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("changed_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ChangedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ChangedByStatusHistories {
+		if queries.Equal(o.ChangedBy, ri.ChangedBy) {
+			continue
+		}
+
+		ln := len(related.R.ChangedByStatusHistories)
+		if ln > 1 && i < ln-1 {
+			related.R.ChangedByStatusHistories[i] = related.R.ChangedByStatusHistories[ln-1]
+		}
+		related.R.ChangedByStatusHistories = related.R.ChangedByStatusHistories[:ln-1]
+		break
+	}
+	return nil
+}
+
+// StatusHistories retrieves all the records using an executor.
+func StatusHistories(mods ...qm.QueryMod) statusHistoryQuery {
+	mods = append(mods, qm.From("\"status_history\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"status_history\".*"})
+	}
+
+	return statusHistoryQuery{q}
+}
+
+// FindStatusHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindStatusHistory(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*StatusHistory, error) {
+	statusHistoryObj := &StatusHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"status_history\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, statusHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from status_history")
+	}
+
+	if err = statusHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return statusHistoryObj, err
+	}
+
+	return statusHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *StatusHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no status_history provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(statusHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	statusHistoryInsertCacheMut.RLock()
+	cache, cached := statusHistoryInsertCache[key]
+	statusHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			statusHistoryAllColumns,
+			statusHistoryColumnsWithDefault,
+			statusHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(statusHistoryType, statusHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(statusHistoryType, statusHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"status_history\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"status_history\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into status_history")
+	}
+
+	if !cached {
+		statusHistoryInsertCacheMut.Lock()
+		statusHistoryInsertCache[key] = cache
+		statusHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the StatusHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *StatusHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	statusHistoryUpdateCacheMut.RLock()
+	cache, cached := statusHistoryUpdateCache[key]
+	statusHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			statusHistoryAllColumns,
+			statusHistoryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update status_history, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"status_history\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, statusHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(statusHistoryType, statusHistoryMapping, append(wl, statusHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update status_history row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for status_history")
+	}
+
+	if !cached {
+		statusHistoryUpdateCacheMut.Lock()
+		statusHistoryUpdateCache[key] = cache
+		statusHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q statusHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for status_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for status_history")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o StatusHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), statusHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"status_history\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, statusHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in statusHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all statusHistory")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *StatusHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no status_history provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(statusHistoryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	statusHistoryUpsertCacheMut.RLock()
+	cache, cached := statusHistoryUpsertCache[key]
+	statusHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			statusHistoryAllColumns,
+			statusHistoryColumnsWithDefault,
+			statusHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			statusHistoryAllColumns,
+			statusHistoryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert status_history, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(statusHistoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(statusHistoryPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert status_history, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(statusHistoryPrimaryKeyColumns))
+			copy(conflict, statusHistoryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"status_history\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(statusHistoryType, statusHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(statusHistoryType, statusHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert status_history")
+	}
+
+	if !cached {
+		statusHistoryUpsertCacheMut.Lock()
+		statusHistoryUpsertCache[key] = cache
+		statusHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single StatusHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *StatusHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no StatusHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), statusHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM \"status_history\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from status_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for status_history")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q statusHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no statusHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from status_history")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for status_history")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o StatusHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(statusHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), statusHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"status_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, statusHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from statusHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for status_history")
+	}
+
+	if len(statusHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *StatusHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindStatusHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *StatusHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := StatusHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), statusHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"status_history\".* FROM \"status_history\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, statusHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in StatusHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// StatusHistoryExists checks if the StatusHistory row exists.
+func StatusHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"status_history\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if status_history exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the StatusHistory row exists.
+func (o *StatusHistory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return StatusHistoryExists(ctx, exec, o.ID)
+}
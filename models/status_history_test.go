@@ -0,0 +1,1020 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testStatusHistories(t *testing.T) {
+	t.Parallel()
+
+	query := StatusHistories()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testStatusHistoriesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testStatusHistoriesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := StatusHistories().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testStatusHistoriesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := StatusHistorySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testStatusHistoriesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := StatusHistoryExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if StatusHistory exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected StatusHistoryExists to return true, but got false.")
+	}
+}
+
+func testStatusHistoriesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	statusHistoryFound, err := FindStatusHistory(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if statusHistoryFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testStatusHistoriesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = StatusHistories().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testStatusHistoriesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := StatusHistories().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testStatusHistoriesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	statusHistoryOne := &StatusHistory{}
+	statusHistoryTwo := &StatusHistory{}
+	if err = randomize.Struct(seed, statusHistoryOne, statusHistoryDBTypes, false, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, statusHistoryTwo, statusHistoryDBTypes, false, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = statusHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = statusHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := StatusHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testStatusHistoriesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	statusHistoryOne := &StatusHistory{}
+	statusHistoryTwo := &StatusHistory{}
+	if err = randomize.Struct(seed, statusHistoryOne, statusHistoryDBTypes, false, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+	if err = randomize.Struct(seed, statusHistoryTwo, statusHistoryDBTypes, false, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = statusHistoryOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = statusHistoryTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func statusHistoryBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *StatusHistory) error {
+	*o = StatusHistory{}
+	return nil
+}
+
+func statusHistoryAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *StatusHistory) error {
+	*o = StatusHistory{}
+	return nil
+}
+
+func statusHistoryAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *StatusHistory) error {
+	*o = StatusHistory{}
+	return nil
+}
+
+func statusHistoryBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *StatusHistory) error {
+	*o = StatusHistory{}
+	return nil
+}
+
+func statusHistoryAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *StatusHistory) error {
+	*o = StatusHistory{}
+	return nil
+}
+
+func statusHistoryBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *StatusHistory) error {
+	*o = StatusHistory{}
+	return nil
+}
+
+func statusHistoryAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *StatusHistory) error {
+	*o = StatusHistory{}
+	return nil
+}
+
+func statusHistoryBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *StatusHistory) error {
+	*o = StatusHistory{}
+	return nil
+}
+
+func statusHistoryAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *StatusHistory) error {
+	*o = StatusHistory{}
+	return nil
+}
+
+func testStatusHistoriesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &StatusHistory{}
+	o := &StatusHistory{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize StatusHistory object: %s", err)
+	}
+
+	AddStatusHistoryHook(boil.BeforeInsertHook, statusHistoryBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	statusHistoryBeforeInsertHooks = []StatusHistoryHook{}
+
+	AddStatusHistoryHook(boil.AfterInsertHook, statusHistoryAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	statusHistoryAfterInsertHooks = []StatusHistoryHook{}
+
+	AddStatusHistoryHook(boil.AfterSelectHook, statusHistoryAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	statusHistoryAfterSelectHooks = []StatusHistoryHook{}
+
+	AddStatusHistoryHook(boil.BeforeUpdateHook, statusHistoryBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	statusHistoryBeforeUpdateHooks = []StatusHistoryHook{}
+
+	AddStatusHistoryHook(boil.AfterUpdateHook, statusHistoryAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	statusHistoryAfterUpdateHooks = []StatusHistoryHook{}
+
+	AddStatusHistoryHook(boil.BeforeDeleteHook, statusHistoryBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	statusHistoryBeforeDeleteHooks = []StatusHistoryHook{}
+
+	AddStatusHistoryHook(boil.AfterDeleteHook, statusHistoryAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	statusHistoryAfterDeleteHooks = []StatusHistoryHook{}
+
+	AddStatusHistoryHook(boil.BeforeUpsertHook, statusHistoryBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	statusHistoryBeforeUpsertHooks = []StatusHistoryHook{}
+
+	AddStatusHistoryHook(boil.AfterUpsertHook, statusHistoryAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	statusHistoryAfterUpsertHooks = []StatusHistoryHook{}
+}
+
+func testStatusHistoriesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testStatusHistoriesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(statusHistoryPrimaryKeyColumns, statusHistoryColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testStatusHistoryToOneReceptionUsingReception(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local StatusHistory
+	var foreign Reception
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, statusHistoryDBTypes, false, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, receptionDBTypes, false, receptionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Reception struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ReceptionID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Reception().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddReceptionHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Reception) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := StatusHistorySlice{&local}
+	if err = local.L.LoadReception(ctx, tx, false, (*[]*StatusHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Reception == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Reception = nil
+	if err = local.L.LoadReception(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Reception == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testStatusHistoryToOneUserUsingChangedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local StatusHistory
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ChangedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ChangedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := StatusHistorySlice{&local}
+	if err = local.L.LoadChangedByUser(ctx, tx, false, (*[]*StatusHistory)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ChangedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ChangedByUser = nil
+	if err = local.L.LoadChangedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ChangedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testStatusHistoryToOneSetOpReceptionUsingReception(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a StatusHistory
+	var b, c Reception
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, statusHistoryDBTypes, false, strmangle.SetComplement(statusHistoryPrimaryKeyColumns, statusHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, receptionDBTypes, false, strmangle.SetComplement(receptionPrimaryKeyColumns, receptionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Reception{&b, &c} {
+		err = a.SetReception(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Reception != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.StatusHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ReceptionID != x.ID {
+			t.Error("foreign key was wrong value", a.ReceptionID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ReceptionID))
+		reflect.Indirect(reflect.ValueOf(&a.ReceptionID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ReceptionID != x.ID {
+			t.Error("foreign key was wrong value", a.ReceptionID, x.ID)
+		}
+	}
+}
+func testStatusHistoryToOneSetOpUserUsingChangedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a StatusHistory
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, statusHistoryDBTypes, false, strmangle.SetComplement(statusHistoryPrimaryKeyColumns, statusHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetChangedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ChangedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ChangedByStatusHistories[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ChangedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.ChangedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ChangedBy))
+		reflect.Indirect(reflect.ValueOf(&a.ChangedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ChangedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.ChangedBy, x.ID)
+		}
+	}
+}
+
+func testStatusHistoryToOneRemoveOpUserUsingChangedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a StatusHistory
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, statusHistoryDBTypes, false, strmangle.SetComplement(statusHistoryPrimaryKeyColumns, statusHistoryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetChangedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveChangedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.ChangedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.ChangedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ChangedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ChangedByStatusHistories) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testStatusHistoriesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testStatusHistoriesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := StatusHistorySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testStatusHistoriesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := StatusHistories().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	statusHistoryDBTypes = map[string]string{`ID`: `uuid`, `ReceptionID`: `uuid`, `FromStatus`: `character varying`, `ToStatus`: `character varying`, `ChangedBy`: `uuid`, `ChangedAt`: `timestamp without time zone`}
+	_                    = bytes.MinRead
+)
+
+func testStatusHistoriesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(statusHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(statusHistoryAllColumns) == len(statusHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testStatusHistoriesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(statusHistoryAllColumns) == len(statusHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &StatusHistory{}
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, statusHistoryDBTypes, true, statusHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(statusHistoryAllColumns, statusHistoryPrimaryKeyColumns) {
+		fields = statusHistoryAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			statusHistoryAllColumns,
+			statusHistoryPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := StatusHistorySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testStatusHistoriesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(statusHistoryAllColumns) == len(statusHistoryPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := StatusHistory{}
+	if err = randomize.Struct(seed, &o, statusHistoryDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert StatusHistory: %s", err)
+	}
+
+	count, err := StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, statusHistoryDBTypes, false, statusHistoryPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize StatusHistory struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert StatusHistory: %s", err)
+	}
+
+	count, err = StatusHistories().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -79,10 +79,41 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-}{}
+	ActorProductAuditLogs    string
+	CreatedByProducts        string
+	IssuedByProducts         string
+	PVZStaffs                string
+	AssignedByPVZStaffs      string
+	CreatedByReceptions      string
+	ClosedByReceptions       string
+	RefreshTokens            string
+	ChangedByStatusHistories string
+	CreatedByWebhooks        string
+}{
+	ActorProductAuditLogs:    "ActorProductAuditLogs",
+	CreatedByProducts:        "CreatedByProducts",
+	IssuedByProducts:         "IssuedByProducts",
+	PVZStaffs:                "PVZStaffs",
+	AssignedByPVZStaffs:      "AssignedByPVZStaffs",
+	CreatedByReceptions:      "CreatedByReceptions",
+	ClosedByReceptions:       "ClosedByReceptions",
+	RefreshTokens:            "RefreshTokens",
+	ChangedByStatusHistories: "ChangedByStatusHistories",
+	CreatedByWebhooks:        "CreatedByWebhooks",
+}
 
 // userR is where relationships are stored.
 type userR struct {
+	ActorProductAuditLogs    ProductAuditLogSlice `boil:"ActorProductAuditLogs" json:"ActorProductAuditLogs" toml:"ActorProductAuditLogs" yaml:"ActorProductAuditLogs"`
+	CreatedByProducts        ProductSlice         `boil:"CreatedByProducts" json:"CreatedByProducts" toml:"CreatedByProducts" yaml:"CreatedByProducts"`
+	IssuedByProducts         ProductSlice         `boil:"IssuedByProducts" json:"IssuedByProducts" toml:"IssuedByProducts" yaml:"IssuedByProducts"`
+	PVZStaffs                PVZStaffSlice        `boil:"PVZStaffs" json:"PVZStaffs" toml:"PVZStaffs" yaml:"PVZStaffs"`
+	AssignedByPVZStaffs      PVZStaffSlice        `boil:"AssignedByPVZStaffs" json:"AssignedByPVZStaffs" toml:"AssignedByPVZStaffs" yaml:"AssignedByPVZStaffs"`
+	CreatedByReceptions      ReceptionSlice       `boil:"CreatedByReceptions" json:"CreatedByReceptions" toml:"CreatedByReceptions" yaml:"CreatedByReceptions"`
+	ClosedByReceptions       ReceptionSlice       `boil:"ClosedByReceptions" json:"ClosedByReceptions" toml:"ClosedByReceptions" yaml:"ClosedByReceptions"`
+	RefreshTokens            RefreshTokenSlice    `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+	ChangedByStatusHistories StatusHistorySlice   `boil:"ChangedByStatusHistories" json:"ChangedByStatusHistories" toml:"ChangedByStatusHistories" yaml:"ChangedByStatusHistories"`
+	CreatedByWebhooks        WebhookSlice         `boil:"CreatedByWebhooks" json:"CreatedByWebhooks" toml:"CreatedByWebhooks" yaml:"CreatedByWebhooks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -90,6 +121,166 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (o *User) GetActorProductAuditLogs() ProductAuditLogSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetActorProductAuditLogs()
+}
+
+func (r *userR) GetActorProductAuditLogs() ProductAuditLogSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ActorProductAuditLogs
+}
+
+func (o *User) GetCreatedByProducts() ProductSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByProducts()
+}
+
+func (r *userR) GetCreatedByProducts() ProductSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByProducts
+}
+
+func (o *User) GetIssuedByProducts() ProductSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetIssuedByProducts()
+}
+
+func (r *userR) GetIssuedByProducts() ProductSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.IssuedByProducts
+}
+
+func (o *User) GetPVZStaffs() PVZStaffSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPVZStaffs()
+}
+
+func (r *userR) GetPVZStaffs() PVZStaffSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PVZStaffs
+}
+
+func (o *User) GetAssignedByPVZStaffs() PVZStaffSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAssignedByPVZStaffs()
+}
+
+func (r *userR) GetAssignedByPVZStaffs() PVZStaffSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AssignedByPVZStaffs
+}
+
+func (o *User) GetCreatedByReceptions() ReceptionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByReceptions()
+}
+
+func (r *userR) GetCreatedByReceptions() ReceptionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByReceptions
+}
+
+func (o *User) GetClosedByReceptions() ReceptionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetClosedByReceptions()
+}
+
+func (r *userR) GetClosedByReceptions() ReceptionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ClosedByReceptions
+}
+
+func (o *User) GetRefreshTokens() RefreshTokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRefreshTokens()
+}
+
+func (r *userR) GetRefreshTokens() RefreshTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RefreshTokens
+}
+
+func (o *User) GetChangedByStatusHistories() StatusHistorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetChangedByStatusHistories()
+}
+
+func (r *userR) GetChangedByStatusHistories() StatusHistorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ChangedByStatusHistories
+}
+
+func (o *User) GetCreatedByWebhooks() WebhookSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByWebhooks()
+}
+
+func (r *userR) GetCreatedByWebhooks() WebhookSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByWebhooks
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
@@ -406,6 +597,2398 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// ActorProductAuditLogs retrieves all the product_audit_log's ProductAuditLogs with an executor via actor_id column.
+func (o *User) ActorProductAuditLogs(mods ...qm.QueryMod) productAuditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"product_audit_log\".\"actor_id\"=?", o.ID),
+	)
+
+	return ProductAuditLogs(queryMods...)
+}
+
+// CreatedByProducts retrieves all the product's Products with an executor via created_by column.
+func (o *User) CreatedByProducts(mods ...qm.QueryMod) productQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"products\".\"created_by\"=?", o.ID),
+	)
+
+	return Products(queryMods...)
+}
+
+// IssuedByProducts retrieves all the product's Products with an executor via issued_by column.
+func (o *User) IssuedByProducts(mods ...qm.QueryMod) productQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"products\".\"issued_by\"=?", o.ID),
+	)
+
+	return Products(queryMods...)
+}
+
+// PVZStaffs retrieves all the pvz_staff's PVZStaffs with an executor.
+func (o *User) PVZStaffs(mods ...qm.QueryMod) pvzStaffQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"pvz_staff\".\"user_id\"=?", o.ID),
+	)
+
+	return PVZStaffs(queryMods...)
+}
+
+// AssignedByPVZStaffs retrieves all the pvz_staff's PVZStaffs with an executor via assigned_by column.
+func (o *User) AssignedByPVZStaffs(mods ...qm.QueryMod) pvzStaffQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"pvz_staff\".\"assigned_by\"=?", o.ID),
+	)
+
+	return PVZStaffs(queryMods...)
+}
+
+// CreatedByReceptions retrieves all the reception's Receptions with an executor via created_by column.
+func (o *User) CreatedByReceptions(mods ...qm.QueryMod) receptionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"receptions\".\"created_by\"=?", o.ID),
+	)
+
+	return Receptions(queryMods...)
+}
+
+// ClosedByReceptions retrieves all the reception's Receptions with an executor via closed_by column.
+func (o *User) ClosedByReceptions(mods ...qm.QueryMod) receptionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"receptions\".\"closed_by\"=?", o.ID),
+	)
+
+	return Receptions(queryMods...)
+}
+
+// RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
+func (o *User) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"refresh_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return RefreshTokens(queryMods...)
+}
+
+// ChangedByStatusHistories retrieves all the status_history's StatusHistories with an executor via changed_by column.
+func (o *User) ChangedByStatusHistories(mods ...qm.QueryMod) statusHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"status_history\".\"changed_by\"=?", o.ID),
+	)
+
+	return StatusHistories(queryMods...)
+}
+
+// CreatedByWebhooks retrieves all the webhook's Webhooks with an executor via created_by column.
+func (o *User) CreatedByWebhooks(mods ...qm.QueryMod) webhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhooks\".\"created_by\"=?", o.ID),
+	)
+
+	return Webhooks(queryMods...)
+}
+
+// LoadActorProductAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadActorProductAuditLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`product_audit_log`),
+		qm.WhereIn(`product_audit_log.actor_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load product_audit_log")
+	}
+
+	var resultSlice []*ProductAuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice product_audit_log")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on product_audit_log")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for product_audit_log")
+	}
+
+	if len(productAuditLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ActorProductAuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productAuditLogR{}
+			}
+			foreign.R.Actor = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ActorID) {
+				local.R.ActorProductAuditLogs = append(local.R.ActorProductAuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &productAuditLogR{}
+				}
+				foreign.R.Actor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load products")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice products")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByProducts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByProducts = append(local.R.CreatedByProducts, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIssuedByProducts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadIssuedByProducts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`products`),
+		qm.WhereIn(`products.issued_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load products")
+	}
+
+	var resultSlice []*Product
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice products")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on products")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for products")
+	}
+
+	if len(productAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.IssuedByProducts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &productR{}
+			}
+			foreign.R.IssuedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.IssuedBy) {
+				local.R.IssuedByProducts = append(local.R.IssuedByProducts, foreign)
+				if foreign.R == nil {
+					foreign.R = &productR{}
+				}
+				foreign.R.IssuedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPVZStaffs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPVZStaffs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`pvz_staff`),
+		qm.WhereIn(`pvz_staff.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pvz_staff")
+	}
+
+	var resultSlice []*PVZStaff
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pvz_staff")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pvz_staff")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pvz_staff")
+	}
+
+	if len(pvzStaffAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PVZStaffs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pvzStaffR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PVZStaffs = append(local.R.PVZStaffs, foreign)
+				if foreign.R == nil {
+					foreign.R = &pvzStaffR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssignedByPVZStaffs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAssignedByPVZStaffs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`pvz_staff`),
+		qm.WhereIn(`pvz_staff.assigned_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pvz_staff")
+	}
+
+	var resultSlice []*PVZStaff
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pvz_staff")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pvz_staff")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pvz_staff")
+	}
+
+	if len(pvzStaffAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssignedByPVZStaffs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pvzStaffR{}
+			}
+			foreign.R.AssignedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AssignedBy) {
+				local.R.AssignedByPVZStaffs = append(local.R.AssignedByPVZStaffs, foreign)
+				if foreign.R == nil {
+					foreign.R = &pvzStaffR{}
+				}
+				foreign.R.AssignedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByReceptions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByReceptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`receptions`),
+		qm.WhereIn(`receptions.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load receptions")
+	}
+
+	var resultSlice []*Reception
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice receptions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on receptions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for receptions")
+	}
+
+	if len(receptionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByReceptions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &receptionR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByReceptions = append(local.R.CreatedByReceptions, foreign)
+				if foreign.R == nil {
+					foreign.R = &receptionR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadClosedByReceptions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadClosedByReceptions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`receptions`),
+		qm.WhereIn(`receptions.closed_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load receptions")
+	}
+
+	var resultSlice []*Reception
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice receptions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on receptions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for receptions")
+	}
+
+	if len(receptionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ClosedByReceptions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &receptionR{}
+			}
+			foreign.R.ClosedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ClosedBy) {
+				local.R.ClosedByReceptions = append(local.R.ClosedByReceptions, foreign)
+				if foreign.R == nil {
+					foreign.R = &receptionR{}
+				}
+				foreign.R.ClosedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if len(refreshTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangedByStatusHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadChangedByStatusHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`status_history`),
+		qm.WhereIn(`status_history.changed_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load status_history")
+	}
+
+	var resultSlice []*StatusHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice status_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on status_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for status_history")
+	}
+
+	if len(statusHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChangedByStatusHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &statusHistoryR{}
+			}
+			foreign.R.ChangedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ChangedBy) {
+				local.R.ChangedByStatusHistories = append(local.R.ChangedByStatusHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &statusHistoryR{}
+				}
+				foreign.R.ChangedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByWebhooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhooks`),
+		qm.WhereIn(`webhooks.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhooks")
+	}
+
+	var resultSlice []*Webhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhooks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhooks")
+	}
+
+	if len(webhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByWebhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByWebhooks = append(local.R.CreatedByWebhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddActorProductAuditLogs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorProductAuditLogs.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorProductAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductAuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ActorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"product_audit_log\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"actor_id"}),
+				strmangle.WhereClause("\"", "\"", 2, productAuditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ActorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ActorProductAuditLogs: related,
+		}
+	} else {
+		o.R.ActorProductAuditLogs = append(o.R.ActorProductAuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productAuditLogR{
+				Actor: o,
+			}
+		} else {
+			rel.R.Actor = o
+		}
+	}
+	return nil
+}
+
+// SetActorProductAuditLogs removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Actor's ActorProductAuditLogs accordingly.
+// Replaces o.R.ActorProductAuditLogs with related.
+// Sets related.R.Actor's ActorProductAuditLogs accordingly.
+func (o *User) SetActorProductAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ProductAuditLog) error {
+	query := "update \"product_audit_log\" set \"actor_id\" = null where \"actor_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ActorProductAuditLogs {
+			queries.SetScanner(&rel.ActorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Actor = nil
+		}
+		o.R.ActorProductAuditLogs = nil
+	}
+
+	return o.AddActorProductAuditLogs(ctx, exec, insert, related...)
+}
+
+// RemoveActorProductAuditLogs relationships from objects passed in.
+// Removes related items from R.ActorProductAuditLogs (uses pointer comparison, removal does not keep order)
+// Sets related.R.Actor.
+func (o *User) RemoveActorProductAuditLogs(ctx context.Context, exec boil.ContextExecutor, related ...*ProductAuditLog) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ActorID, nil)
+		if rel.R != nil {
+			rel.R.Actor = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("actor_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ActorProductAuditLogs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ActorProductAuditLogs)
+			if ln > 1 && i < ln-1 {
+				o.R.ActorProductAuditLogs[i] = o.R.ActorProductAuditLogs[ln-1]
+			}
+			o.R.ActorProductAuditLogs = o.R.ActorProductAuditLogs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCreatedByProducts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByProducts.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"products\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByProducts: related,
+		}
+	} else {
+		o.R.CreatedByProducts = append(o.R.CreatedByProducts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByProducts removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByProducts accordingly.
+// Replaces o.R.CreatedByProducts with related.
+// Sets related.R.CreatedByUser's CreatedByProducts accordingly.
+func (o *User) SetCreatedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	query := "update \"products\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByProducts {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByProducts = nil
+	}
+
+	return o.AddCreatedByProducts(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByProducts relationships from objects passed in.
+// Removes related items from R.CreatedByProducts (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByProducts(ctx context.Context, exec boil.ContextExecutor, related ...*Product) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByProducts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByProducts)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByProducts[i] = o.R.CreatedByProducts[ln-1]
+			}
+			o.R.CreatedByProducts = o.R.CreatedByProducts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddIssuedByProducts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.IssuedByProducts.
+// Sets related.R.IssuedByUser appropriately.
+func (o *User) AddIssuedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.IssuedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"products\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"issued_by"}),
+				strmangle.WhereClause("\"", "\"", 2, productPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.IssuedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			IssuedByProducts: related,
+		}
+	} else {
+		o.R.IssuedByProducts = append(o.R.IssuedByProducts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &productR{
+				IssuedByUser: o,
+			}
+		} else {
+			rel.R.IssuedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetIssuedByProducts removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.IssuedByUser's IssuedByProducts accordingly.
+// Replaces o.R.IssuedByProducts with related.
+// Sets related.R.IssuedByUser's IssuedByProducts accordingly.
+func (o *User) SetIssuedByProducts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Product) error {
+	query := "update \"products\" set \"issued_by\" = null where \"issued_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.IssuedByProducts {
+			queries.SetScanner(&rel.IssuedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.IssuedByUser = nil
+		}
+		o.R.IssuedByProducts = nil
+	}
+
+	return o.AddIssuedByProducts(ctx, exec, insert, related...)
+}
+
+// RemoveIssuedByProducts relationships from objects passed in.
+// Removes related items from R.IssuedByProducts (uses pointer comparison, removal does not keep order)
+// Sets related.R.IssuedByUser.
+func (o *User) RemoveIssuedByProducts(ctx context.Context, exec boil.ContextExecutor, related ...*Product) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.IssuedBy, nil)
+		if rel.R != nil {
+			rel.R.IssuedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("issued_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.IssuedByProducts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.IssuedByProducts)
+			if ln > 1 && i < ln-1 {
+				o.R.IssuedByProducts[i] = o.R.IssuedByProducts[ln-1]
+			}
+			o.R.IssuedByProducts = o.R.IssuedByProducts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPVZStaffs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PVZStaffs.
+// Sets related.R.User appropriately.
+func (o *User) AddPVZStaffs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PVZStaff) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"pvz_staff\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pvzStaffPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PVZID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PVZStaffs: related,
+		}
+	} else {
+		o.R.PVZStaffs = append(o.R.PVZStaffs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pvzStaffR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAssignedByPVZStaffs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AssignedByPVZStaffs.
+// Sets related.R.AssignedByUser appropriately.
+func (o *User) AddAssignedByPVZStaffs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PVZStaff) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AssignedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"pvz_staff\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"assigned_by"}),
+				strmangle.WhereClause("\"", "\"", 2, pvzStaffPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.PVZID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AssignedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AssignedByPVZStaffs: related,
+		}
+	} else {
+		o.R.AssignedByPVZStaffs = append(o.R.AssignedByPVZStaffs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pvzStaffR{
+				AssignedByUser: o,
+			}
+		} else {
+			rel.R.AssignedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetAssignedByPVZStaffs removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AssignedByUser's AssignedByPVZStaffs accordingly.
+// Replaces o.R.AssignedByPVZStaffs with related.
+// Sets related.R.AssignedByUser's AssignedByPVZStaffs accordingly.
+func (o *User) SetAssignedByPVZStaffs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PVZStaff) error {
+	query := "update \"pvz_staff\" set \"assigned_by\" = null where \"assigned_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AssignedByPVZStaffs {
+			queries.SetScanner(&rel.AssignedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AssignedByUser = nil
+		}
+		o.R.AssignedByPVZStaffs = nil
+	}
+
+	return o.AddAssignedByPVZStaffs(ctx, exec, insert, related...)
+}
+
+// RemoveAssignedByPVZStaffs relationships from objects passed in.
+// Removes related items from R.AssignedByPVZStaffs (uses pointer comparison, removal does not keep order)
+// Sets related.R.AssignedByUser.
+func (o *User) RemoveAssignedByPVZStaffs(ctx context.Context, exec boil.ContextExecutor, related ...*PVZStaff) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AssignedBy, nil)
+		if rel.R != nil {
+			rel.R.AssignedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("assigned_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AssignedByPVZStaffs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AssignedByPVZStaffs)
+			if ln > 1 && i < ln-1 {
+				o.R.AssignedByPVZStaffs[i] = o.R.AssignedByPVZStaffs[ln-1]
+			}
+			o.R.AssignedByPVZStaffs = o.R.AssignedByPVZStaffs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCreatedByReceptions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByReceptions.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByReceptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reception) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"receptions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, receptionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByReceptions: related,
+		}
+	} else {
+		o.R.CreatedByReceptions = append(o.R.CreatedByReceptions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &receptionR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByReceptions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByReceptions accordingly.
+// Replaces o.R.CreatedByReceptions with related.
+// Sets related.R.CreatedByUser's CreatedByReceptions accordingly.
+func (o *User) SetCreatedByReceptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reception) error {
+	query := "update \"receptions\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByReceptions {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByReceptions = nil
+	}
+
+	return o.AddCreatedByReceptions(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByReceptions relationships from objects passed in.
+// Removes related items from R.CreatedByReceptions (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByReceptions(ctx context.Context, exec boil.ContextExecutor, related ...*Reception) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByReceptions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByReceptions)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByReceptions[i] = o.R.CreatedByReceptions[ln-1]
+			}
+			o.R.CreatedByReceptions = o.R.CreatedByReceptions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddClosedByReceptions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ClosedByReceptions.
+// Sets related.R.ClosedByUser appropriately.
+func (o *User) AddClosedByReceptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reception) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ClosedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"receptions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"closed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, receptionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ClosedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ClosedByReceptions: related,
+		}
+	} else {
+		o.R.ClosedByReceptions = append(o.R.ClosedByReceptions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &receptionR{
+				ClosedByUser: o,
+			}
+		} else {
+			rel.R.ClosedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetClosedByReceptions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ClosedByUser's ClosedByReceptions accordingly.
+// Replaces o.R.ClosedByReceptions with related.
+// Sets related.R.ClosedByUser's ClosedByReceptions accordingly.
+func (o *User) SetClosedByReceptions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Reception) error {
+	query := "update \"receptions\" set \"closed_by\" = null where \"closed_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ClosedByReceptions {
+			queries.SetScanner(&rel.ClosedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ClosedByUser = nil
+		}
+		o.R.ClosedByReceptions = nil
+	}
+
+	return o.AddClosedByReceptions(ctx, exec, insert, related...)
+}
+
+// RemoveClosedByReceptions relationships from objects passed in.
+// Removes related items from R.ClosedByReceptions (uses pointer comparison, removal does not keep order)
+// Sets related.R.ClosedByUser.
+func (o *User) RemoveClosedByReceptions(ctx context.Context, exec boil.ContextExecutor, related ...*Reception) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ClosedBy, nil)
+		if rel.R != nil {
+			rel.R.ClosedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("closed_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ClosedByReceptions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ClosedByReceptions)
+			if ln > 1 && i < ln-1 {
+				o.R.ClosedByReceptions[i] = o.R.ClosedByReceptions[ln-1]
+			}
+			o.R.ClosedByReceptions = o.R.ClosedByReceptions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddRefreshTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RefreshTokens: related,
+		}
+	} else {
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &refreshTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddChangedByStatusHistories adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChangedByStatusHistories.
+// Sets related.R.ChangedByUser appropriately.
+func (o *User) AddChangedByStatusHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StatusHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ChangedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"status_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"changed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, statusHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ChangedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ChangedByStatusHistories: related,
+		}
+	} else {
+		o.R.ChangedByStatusHistories = append(o.R.ChangedByStatusHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &statusHistoryR{
+				ChangedByUser: o,
+			}
+		} else {
+			rel.R.ChangedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetChangedByStatusHistories removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ChangedByUser's ChangedByStatusHistories accordingly.
+// Replaces o.R.ChangedByStatusHistories with related.
+// Sets related.R.ChangedByUser's ChangedByStatusHistories accordingly.
+func (o *User) SetChangedByStatusHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*StatusHistory) error {
+	query := "update \"status_history\" set \"changed_by\" = null where \"changed_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ChangedByStatusHistories {
+			queries.SetScanner(&rel.ChangedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ChangedByUser = nil
+		}
+		o.R.ChangedByStatusHistories = nil
+	}
+
+	return o.AddChangedByStatusHistories(ctx, exec, insert, related...)
+}
+
+// RemoveChangedByStatusHistories relationships from objects passed in.
+// Removes related items from R.ChangedByStatusHistories (uses pointer comparison, removal does not keep order)
+// Sets related.R.ChangedByUser.
+func (o *User) RemoveChangedByStatusHistories(ctx context.Context, exec boil.ContextExecutor, related ...*StatusHistory) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ChangedBy, nil)
+		if rel.R != nil {
+			rel.R.ChangedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("changed_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ChangedByStatusHistories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ChangedByStatusHistories)
+			if ln > 1 && i < ln-1 {
+				o.R.ChangedByStatusHistories[i] = o.R.ChangedByStatusHistories[ln-1]
+			}
+			o.R.ChangedByStatusHistories = o.R.ChangedByStatusHistories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCreatedByWebhooks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByWebhooks.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Webhook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhooks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByWebhooks: related,
+		}
+	} else {
+		o.R.CreatedByWebhooks = append(o.R.CreatedByWebhooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByWebhooks removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByWebhooks accordingly.
+// Replaces o.R.CreatedByWebhooks with related.
+// Sets related.R.CreatedByUser's CreatedByWebhooks accordingly.
+func (o *User) SetCreatedByWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Webhook) error {
+	query := "update \"webhooks\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByWebhooks {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByWebhooks = nil
+	}
+
+	return o.AddCreatedByWebhooks(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByWebhooks relationships from objects passed in.
+// Removes related items from R.CreatedByWebhooks (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByWebhooks(ctx context.Context, exec boil.ContextExecutor, related ...*Webhook) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByWebhooks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByWebhooks)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByWebhooks[i] = o.R.CreatedByWebhooks[ln-1]
+			}
+			o.R.CreatedByWebhooks = o.R.CreatedByWebhooks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
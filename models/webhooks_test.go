@@ -0,0 +1,1225 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWebhooks(t *testing.T) {
+	t.Parallel()
+
+	query := Webhooks()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWebhooksDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhooksQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Webhooks().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhooksSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWebhooksExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WebhookExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Webhook exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WebhookExists to return true, but got false.")
+	}
+}
+
+func testWebhooksFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	webhookFound, err := FindWebhook(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if webhookFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWebhooksBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Webhooks().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhooksOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Webhooks().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWebhooksAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	webhookOne := &Webhook{}
+	webhookTwo := &Webhook{}
+	if err = randomize.Struct(seed, webhookOne, webhookDBTypes, false, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookTwo, webhookDBTypes, false, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Webhooks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWebhooksCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	webhookOne := &Webhook{}
+	webhookTwo := &Webhook{}
+	if err = randomize.Struct(seed, webhookOne, webhookDBTypes, false, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+	if err = randomize.Struct(seed, webhookTwo, webhookDBTypes, false, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = webhookOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = webhookTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func webhookBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Webhook) error {
+	*o = Webhook{}
+	return nil
+}
+
+func webhookAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Webhook) error {
+	*o = Webhook{}
+	return nil
+}
+
+func webhookAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Webhook) error {
+	*o = Webhook{}
+	return nil
+}
+
+func webhookBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Webhook) error {
+	*o = Webhook{}
+	return nil
+}
+
+func webhookAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Webhook) error {
+	*o = Webhook{}
+	return nil
+}
+
+func webhookBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Webhook) error {
+	*o = Webhook{}
+	return nil
+}
+
+func webhookAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Webhook) error {
+	*o = Webhook{}
+	return nil
+}
+
+func webhookBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Webhook) error {
+	*o = Webhook{}
+	return nil
+}
+
+func webhookAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Webhook) error {
+	*o = Webhook{}
+	return nil
+}
+
+func testWebhooksHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Webhook{}
+	o := &Webhook{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, webhookDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Webhook object: %s", err)
+	}
+
+	AddWebhookHook(boil.BeforeInsertHook, webhookBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookBeforeInsertHooks = []WebhookHook{}
+
+	AddWebhookHook(boil.AfterInsertHook, webhookAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	webhookAfterInsertHooks = []WebhookHook{}
+
+	AddWebhookHook(boil.AfterSelectHook, webhookAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	webhookAfterSelectHooks = []WebhookHook{}
+
+	AddWebhookHook(boil.BeforeUpdateHook, webhookBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookBeforeUpdateHooks = []WebhookHook{}
+
+	AddWebhookHook(boil.AfterUpdateHook, webhookAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	webhookAfterUpdateHooks = []WebhookHook{}
+
+	AddWebhookHook(boil.BeforeDeleteHook, webhookBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookBeforeDeleteHooks = []WebhookHook{}
+
+	AddWebhookHook(boil.AfterDeleteHook, webhookAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	webhookAfterDeleteHooks = []WebhookHook{}
+
+	AddWebhookHook(boil.BeforeUpsertHook, webhookBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookBeforeUpsertHooks = []WebhookHook{}
+
+	AddWebhookHook(boil.AfterUpsertHook, webhookAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	webhookAfterUpsertHooks = []WebhookHook{}
+}
+
+func testWebhooksInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhooksInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(webhookPrimaryKeyColumns, webhookColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWebhookToManyWebhookDeliveries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Webhook
+	var b, c WebhookDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, webhookDeliveryDBTypes, false, webhookDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.WebhookID = a.ID
+	c.WebhookID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.WebhookDeliveries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.WebhookID == b.WebhookID {
+			bFound = true
+		}
+		if v.WebhookID == c.WebhookID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := WebhookSlice{&a}
+	if err = a.L.LoadWebhookDeliveries(ctx, tx, false, (*[]*Webhook)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WebhookDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.WebhookDeliveries = nil
+	if err = a.L.LoadWebhookDeliveries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.WebhookDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testWebhookToManyAddOpWebhookDeliveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Webhook
+	var b, c, d, e WebhookDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookDBTypes, false, strmangle.SetComplement(webhookPrimaryKeyColumns, webhookColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*WebhookDelivery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, webhookDeliveryDBTypes, false, strmangle.SetComplement(webhookDeliveryPrimaryKeyColumns, webhookDeliveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*WebhookDelivery{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddWebhookDeliveries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.WebhookID {
+			t.Error("foreign key was wrong value", a.ID, first.WebhookID)
+		}
+		if a.ID != second.WebhookID {
+			t.Error("foreign key was wrong value", a.ID, second.WebhookID)
+		}
+
+		if first.R.Webhook != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Webhook != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.WebhookDeliveries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.WebhookDeliveries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.WebhookDeliveries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testWebhookToOnePVZUsingPVZ(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Webhook
+	var foreign PVZ
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, pvzDBTypes, false, pvzColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PVZ struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.PVZID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.PVZ().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddPVZHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *PVZ) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := WebhookSlice{&local}
+	if err = local.L.LoadPVZ(ctx, tx, false, (*[]*Webhook)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZ == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.PVZ = nil
+	if err = local.L.LoadPVZ(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PVZ == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testWebhookToOneUserUsingCreatedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Webhook
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.CreatedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.CreatedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := WebhookSlice{&local}
+	if err = local.L.LoadCreatedByUser(ctx, tx, false, (*[]*Webhook)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreatedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.CreatedByUser = nil
+	if err = local.L.LoadCreatedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreatedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testWebhookToOneSetOpPVZUsingPVZ(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Webhook
+	var b, c PVZ
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookDBTypes, false, strmangle.SetComplement(webhookPrimaryKeyColumns, webhookColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*PVZ{&b, &c} {
+		err = a.SetPVZ(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.PVZ != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Webhooks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.PVZID, x.ID) {
+			t.Error("foreign key was wrong value", a.PVZID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PVZID))
+		reflect.Indirect(reflect.ValueOf(&a.PVZID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.PVZID, x.ID) {
+			t.Error("foreign key was wrong value", a.PVZID, x.ID)
+		}
+	}
+}
+
+func testWebhookToOneRemoveOpPVZUsingPVZ(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Webhook
+	var b PVZ
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookDBTypes, false, strmangle.SetComplement(webhookPrimaryKeyColumns, webhookColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, pvzDBTypes, false, strmangle.SetComplement(pvzPrimaryKeyColumns, pvzColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetPVZ(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemovePVZ(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.PVZ().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.PVZ != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.PVZID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Webhooks) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testWebhookToOneSetOpUserUsingCreatedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Webhook
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookDBTypes, false, strmangle.SetComplement(webhookPrimaryKeyColumns, webhookColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetCreatedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.CreatedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CreatedByWebhooks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.CreatedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.CreatedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CreatedBy))
+		reflect.Indirect(reflect.ValueOf(&a.CreatedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.CreatedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.CreatedBy, x.ID)
+		}
+	}
+}
+
+func testWebhookToOneRemoveOpUserUsingCreatedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Webhook
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, webhookDBTypes, false, strmangle.SetComplement(webhookPrimaryKeyColumns, webhookColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetCreatedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveCreatedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.CreatedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.CreatedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.CreatedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.CreatedByWebhooks) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testWebhooksReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhooksReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WebhookSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWebhooksSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Webhooks().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	webhookDBTypes = map[string]string{`ID`: `uuid`, `URL`: `text`, `Secret`: `character varying`, `EventTypes`: `jsonb`, `PVZID`: `bigint`, `Enabled`: `boolean`, `CreatedBy`: `uuid`, `CreatedAt`: `timestamp without time zone`}
+	_              = bytes.MinRead
+)
+
+func testWebhooksUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(webhookPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(webhookAllColumns) == len(webhookPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWebhooksSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(webhookAllColumns) == len(webhookPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Webhook{}
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, webhookDBTypes, true, webhookPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(webhookAllColumns, webhookPrimaryKeyColumns) {
+		fields = webhookAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			webhookAllColumns,
+			webhookPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WebhookSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testWebhooksUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(webhookAllColumns) == len(webhookPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Webhook{}
+	if err = randomize.Struct(seed, &o, webhookDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Webhook: %s", err)
+	}
+
+	count, err := Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, webhookDBTypes, false, webhookPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Webhook struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Webhook: %s", err)
+	}
+
+	count, err = Webhooks().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -1,8 +1,45 @@
 package auth
 
-import "github.com/dgrijalva/jwt-go"
+import (
+	"errors"
+	"strings"
 
+	"github.com/dgrijalva/jwt-go"
+)
+
+// UserClaims: Subject — ID пользователя, IssuedAt — время выпуска токена.
 type UserClaims struct {
-	Role string `json:"role"`
+	Role  string `json:"role"`
+	Email string `json:"email,omitempty"`
 	jwt.StandardClaims
 }
+
+// ParseJWT проверяет подпись токена (с префиксом "Bearer " или без) и
+// возвращает его claims.
+func ParseJWT(tokenString string, jwtKey []byte) (*UserClaims, error) {
+	if strings.HasPrefix(tokenString, "Bearer ") {
+		tokenString = strings.TrimPrefix(tokenString, "Bearer ")
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return jwtKey, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*UserClaims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token claims")
+	}
+
+	if claims.Role == "" {
+		return nil, errors.New("role not found in token")
+	}
+
+	return claims, nil
+}
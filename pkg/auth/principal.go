@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// Principal — пользователь, от имени которого выполняется запрос.
+// UserID пустой для токенов, выданных через /auth/dummy.
+type Principal struct {
+	UserID    string
+	Email     string
+	Role      string
+	TokenID   string
+	ExpiresAt time.Time
+}
+
+type principalKey struct{}
+
+func PrincipalFromClaims(claims *UserClaims) Principal {
+	return Principal{
+		UserID:    claims.Subject,
+		Email:     claims.Email,
+		Role:      claims.Role,
+		TokenID:   claims.Id,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	}
+}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// UserIDFromContext возвращает ID пользователя или пустую строку, если его нет.
+func UserIDFromContext(ctx context.Context) string {
+	p, _ := PrincipalFromContext(ctx)
+	return p.UserID
+}
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+)
+
+// leaderLockNamespace отделяет блокировки лидерства (двухаргументная форма
+// pg_advisory_lock) от транзакционных блокировок по одному bigint-ключу.
+const leaderLockNamespace = 1
+
+// Leader выбирает одну реплику для фоновой задачи через сессионную advisory-блокировку.
+// Блокировка живёт, пока открыто выделенное соединение: если реплика падает
+// или теряет соединение, Postgres снимает её сам и лидером становится другая.
+type Leader struct {
+	db   *sql.DB
+	name string
+
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+func NewLeader(db *sql.DB, name string) *Leader {
+	return &Leader{db: db, name: name}
+}
+
+// TryAcquire возвращает true, если реплика лидер. Уже полученная блокировка
+// проверяется пингом соединения; при обрыве лидерство считается потерянным.
+func (l *Leader) TryAcquire(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+		_ = l.conn.Close()
+		l.conn = nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx,
+		"SELECT pg_try_advisory_lock($1, hashtext($2))", leaderLockNamespace, l.name,
+	).Scan(&acquired)
+	if err != nil || !acquired {
+		_ = conn.Close()
+		if err != nil {
+			return false, fmt.Errorf("failed to try advisory lock: %w", err)
+		}
+		return false, nil
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// Release снимает блокировку, чтобы другая реплика стала лидером без ожидания.
+func (l *Leader) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+
+	_, err := l.conn.ExecContext(ctx,
+		"SELECT pg_advisory_unlock($1, hashtext($2))", leaderLockNamespace, l.name,
+	)
+	closeErr := l.conn.Close()
+	l.conn = nil
+	if err != nil {
+		return fmt.Errorf("failed to release advisory lock: %w", err)
+	}
+	return closeErr
+}
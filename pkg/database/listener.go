@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// Listener получает уведомления канала Postgres (LISTEN/NOTIFY) на
+// выделенном соединении из пула.
+type Listener struct {
+	db      *sql.DB
+	channel string
+}
+
+func NewListener(db *sql.DB, channel string) *Listener {
+	return &Listener{db: db, channel: channel}
+}
+
+// Listen вызывает handle на каждое уведомление, пока не отменён ctx или не
+// оборвалось соединение. Уведомления, пришедшие между вызовами Listen,
+// теряются.
+func (l *Listener) Listen(ctx context.Context, handle func(payload string)) error {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver connection %T", driverConn)
+		}
+		pgConn := c.Conn()
+		// Соединение с LISTEN нельзя возвращать в пул: закрытое соединение
+		// пул отбросит сам.
+		defer pgConn.Close(context.Background())
+
+		if _, err := pgConn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+			return fmt.Errorf("failed to listen %s: %w", l.channel, err)
+		}
+
+		for {
+			n, err := pgConn.WaitForNotification(ctx)
+			if err != nil {
+				return err
+			}
+			handle(n.Payload)
+		}
+	})
+}
@@ -1,10 +1,15 @@
 package helper
 
 import (
+	"PVZ/pkg/auth"
+
 	"github.com/gin-gonic/gin"
 )
 
-const userRoleKey = "userRole"
+const (
+	userRoleKey  = "userRole"
+	principalKey = "principal"
+)
 
 func SetUserRole(c *gin.Context, role string) {
 	c.Set(userRoleKey, role)
@@ -18,3 +23,20 @@ func GetUserRole(c *gin.Context) string {
 	}
 	return ""
 }
+
+// SetPrincipal кладёт пользователя и в gin.Context, и в контекст запроса,
+// чтобы сервисы видели его через auth.PrincipalFromContext.
+func SetPrincipal(c *gin.Context, p auth.Principal) {
+	c.Set(principalKey, p)
+	SetUserRole(c, p.Role)
+	c.Request = c.Request.WithContext(auth.WithPrincipal(c.Request.Context(), p))
+}
+
+func GetPrincipal(c *gin.Context) (auth.Principal, bool) {
+	if val, exists := c.Get(principalKey); exists {
+		if p, ok := val.(auth.Principal); ok {
+			return p, true
+		}
+	}
+	return auth.Principal{}, false
+}
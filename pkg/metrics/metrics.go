@@ -33,6 +33,140 @@ var (
 			Help: "Total number of added products",
 		},
 	)
+
+	ProductsIssued = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "products_issued_total",
+			Help: "Total number of products issued to customers",
+		},
+	)
+
+	ProductsOverdue = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "products_overdue",
+			Help: "Number of parcels stored past their storage period by PVZ",
+		},
+		[]string{"pvz_id"},
+	)
+
+	ProductsReturnedToSender = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "products_returned_to_sender_total",
+			Help: "Total number of unclaimed parcels returned to the sender",
+		},
+	)
+
+	OverdueReported = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "products_overdue_reported_total",
+			Help: "Total number of parcels reported as overdue",
+		},
+	)
+
+	CapacityExceeded = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "pvz_capacity_exceeded_total",
+			Help: "Total number of product acceptances over PVZ capacity by policy",
+		},
+		[]string{"policy"},
+	)
+
+	ProductsMoved = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "products_moved_total",
+			Help: "Total number of products moved between storage cells",
+		},
+	)
+
+	ReturnsCreated = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "return_reception_created_total",
+			Help: "Total number of created return receptions",
+		},
+	)
+
+	ReturnItems = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "return_items_total",
+			Help: "Total number of products accepted as customer returns by reason",
+		},
+		[]string{"reason"},
+	)
+
+	ReceptionAutoClosed = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "reception_auto_closed_total",
+			Help: "Total number of receptions closed by the auto-close worker",
+		},
+	)
+
+	ReceptionStale = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "reception_stale",
+			Help: "Number of stale in-progress receptions left open after the last auto-close run",
+		},
+	)
+
+	AutoCloseRuns = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "reception_autoclose_runs_total",
+			Help: "Total number of auto-close runs by result",
+		},
+		[]string{"result"},
+	)
+
+	AutoCloseLeader = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "reception_autoclose_leader",
+			Help: "Whether this replica holds the auto-close leader lock (1) or not (0)",
+		},
+	)
+
+	OutboxPublished = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "outbox_published_total",
+			Help: "Total number of outbox events published",
+		},
+		[]string{"event_type"},
+	)
+
+	OutboxPublishFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "outbox_publish_failures_total",
+			Help: "Total number of failed outbox publish attempts",
+		},
+		[]string{"event_type"},
+	)
+
+	WebhookDeliveries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "webhook_delivery_attempts_total",
+			Help: "Total number of webhook delivery attempts by result",
+		},
+		[]string{"result"},
+	)
+
+	EventStreamClients = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "event_stream_clients",
+			Help: "Number of open PVZ event streams",
+		},
+	)
+
+	EventStreamDropped = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "event_stream_dropped_total",
+			Help: "Total number of events dropped for slow stream clients",
+		},
+	)
+
+	EventNotifyDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "event_notify_dropped_total",
+			Help: "Total number of events not sent to the live stream because the NOTIFY payload was too large",
+		},
+		[]string{"event_type"},
+	)
 )
 
 func RegisterMetrics() {
@@ -40,4 +174,22 @@ func RegisterMetrics() {
 	prometheus.MustRegister(PVZCreated)
 	prometheus.MustRegister(ReceptionCreated)
 	prometheus.MustRegister(ProductAdded)
+	prometheus.MustRegister(ProductsIssued)
+	prometheus.MustRegister(ProductsOverdue)
+	prometheus.MustRegister(OverdueReported)
+	prometheus.MustRegister(ProductsReturnedToSender)
+	prometheus.MustRegister(CapacityExceeded)
+	prometheus.MustRegister(ProductsMoved)
+	prometheus.MustRegister(ReturnsCreated)
+	prometheus.MustRegister(ReturnItems)
+	prometheus.MustRegister(ReceptionAutoClosed)
+	prometheus.MustRegister(ReceptionStale)
+	prometheus.MustRegister(AutoCloseRuns)
+	prometheus.MustRegister(AutoCloseLeader)
+	prometheus.MustRegister(OutboxPublished)
+	prometheus.MustRegister(OutboxPublishFailures)
+	prometheus.MustRegister(WebhookDeliveries)
+	prometheus.MustRegister(EventStreamClients)
+	prometheus.MustRegister(EventStreamDropped)
+	prometheus.MustRegister(EventNotifyDropped)
 }
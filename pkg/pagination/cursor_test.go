@@ -0,0 +1,30 @@
+package pagination
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	in := Cursor{
+		Time: time.Date(2025, 4, 1, 12, 30, 0, 123456000, time.UTC),
+		ID:   "0195f3a2-7c1d-7b2e-9f00-0a1b2c3d4e5f",
+	}
+
+	out, err := DecodeCursor(in.Encode())
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !out.Time.Equal(in.Time) || out.ID != in.ID {
+		t.Fatalf("got %+v, want %+v", *out, in)
+	}
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	for _, s := range []string{"", "not base64!", "bm8tc2VwYXJhdG9y", "eHx5"} {
+		if _, err := DecodeCursor(s); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("DecodeCursor(%q) = %v, want ErrInvalidCursor", s, err)
+		}
+	}
+}
@@ -9,3 +9,8 @@ func GenerateUUID7() (string, error) {
 	}
 	return u.String(), nil
 }
+
+func IsValid(s string) bool {
+	_, err := uuid.FromString(s)
+	return err == nil
+}